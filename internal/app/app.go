@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -15,6 +16,8 @@ import (
 	"app/internal/controllers/gen"
 	"app/internal/repository/cache/redis"
 	"app/internal/repository/storage/postgres"
+	"app/internal/usecase/availability_usecase"
+	"app/internal/usecase/code_owner_usecase"
 	"app/internal/usecase/pr_usecase"
 	"app/internal/usecase/stats_usecase"
 	"app/internal/usecase/team_usecase"
@@ -31,6 +34,7 @@ type Server struct {
 	config       *config.Config
 	httpServer   *http.Server
 	logger       logger.Logger
+	reviewerFiller *pr_usecase.ReviewerFiller
 }
 
 func NewServer(cfg *config.Config, logger logger.Logger) *Server {
@@ -77,19 +81,37 @@ func NewServer(cfg *config.Config, logger logger.Logger) *Server {
 	teamStorage := postgres.NewTeamStorage(txManager, logger)
 	userStorage := postgres.NewUserStorage(txManager, logger)
 	prStorage := postgres.NewPRStorage(txManager, logger)
+	codeOwnerStorage := postgres.NewCodeOwnerStorage(txManager, logger)
+	availabilityStorage := postgres.NewAvailabilityStorage(txManager, logger)
 	statsCache := redis.NewStatsCache(redisClient, logger)
 
-	prUseCase := pr_usecase.NewPRUseCase(prStorage, userStorage, statsCache, teamStorage, txManager, logger)
-	userUseCase := user_usecase.NewUserUseCase(userStorage, txManager, teamStorage, logger)
-	teamUseCase := team_usecase.NewTeamUseCase(teamStorage, userStorage, txManager, logger)
+	selectionConfig, err := SetupReviewerSelection(cfg.Reviewers)
+	if err != nil {
+		logger.Fatalw("Setup reviewer selection", "error", err)
+		return nil
+	}
+
+	prUseCase := pr_usecase.NewPRUseCase(prStorage, userStorage, statsCache, teamStorage, txManager, logger,
+		pr_usecase.WithSelectionConfig(selectionConfig), pr_usecase.WithRequiredApprovals(cfg.Reviewers.RequiredApprovals),
+		pr_usecase.WithCodeOwnerStorage(codeOwnerStorage), pr_usecase.WithAvailabilityStorage(availabilityStorage))
+	reviewerFiller := pr_usecase.NewReviewerFiller(prUseCase, time.Duration(cfg.Reviewers.FillInterval)*time.Second, logger)
+	userUseCase := user_usecase.NewUserUseCase(userStorage, txManager, teamStorage, logger,
+		user_usecase.WithPullRequestUseCase(prUseCase), user_usecase.WithReviewerFiller(reviewerFiller))
+	teamUseCase := team_usecase.NewTeamUseCase(teamStorage, userStorage, txManager, logger,
+		team_usecase.WithReviewerFiller(reviewerFiller), team_usecase.WithPullRequestUseCase(prUseCase))
 	statsUseCase := stats_usecase.NewStatsUseCase(statsCache, userStorage)
+	codeOwnerUseCase := code_owner_usecase.NewCodeOwnerUseCase(codeOwnerStorage, userStorage, teamStorage, txManager, logger)
+	availabilityUseCase := availability_usecase.NewAvailabilityUseCase(availabilityStorage, userStorage, txManager, logger)
 
 	pullRequestController := controllers.NewPullRequestController(prUseCase)
 	userController := controllers.NewUserController(userUseCase)
 	teamController := controllers.NewTeamController(teamUseCase)
 	statsController := controllers.NewStatsController(statsUseCase)
+	codeOwnerController := controllers.NewCodeOwnerController(codeOwnerUseCase)
+	availabilityController := controllers.NewAvailabilityController(availabilityUseCase)
 
-	controller := controllers.NewController(userController, teamController, statsController, pullRequestController)
+	controller := controllers.NewController(userController, teamController, statsController, pullRequestController,
+		codeOwnerController, availabilityController)
 
 	gen.RegisterHandlers(router, controller)
 
@@ -100,6 +122,7 @@ func NewServer(cfg *config.Config, logger logger.Logger) *Server {
 		config: 		cfg,
 		httpServer:   	httpServer,
 		logger: 		logger,
+		reviewerFiller: reviewerFiller,
 	}
 }
 
@@ -108,6 +131,15 @@ func (s *Server) Run(ctx context.Context) error {
 		s.logger.Infow("Shutting down HTTP server")
 		return s.httpServer.Shutdown(ctx)
 	})
+
+	fillerCtx, cancelFiller := context.WithCancel(ctx)
+	s.closer.Add(func(ctx context.Context) error {
+		s.logger.Infow("Stopping reviewer filler")
+		cancelFiller()
+		return nil
+	})
+
+	go s.reviewerFiller.Run(fillerCtx)
 	
 	go func() {
 		s.logger.Infow("Starting HTTP server",
@@ -0,0 +1,40 @@
+package app
+
+import (
+	"fmt"
+
+	"app/internal/config"
+	"app/internal/domain"
+	"app/internal/usecase/pr_usecase"
+)
+
+func SetupReviewerSelection(cfg config.ReviewersConfig) (pr_usecase.SelectionConfig, error) {
+	strategy := domain.ReviewerStrategyRandom
+	if cfg.Strategy != "" {
+		strategy = domain.ReviewerStrategy(cfg.Strategy)
+	}
+	if !strategy.IsValid() {
+		return pr_usecase.SelectionConfig{}, fmt.Errorf("unknown reviewer strategy %q", cfg.Strategy)
+	}
+
+	teamStrategies := make(map[string]domain.ReviewerStrategy, len(cfg.Teams))
+	for _, team := range cfg.Teams {
+		teamStrategy := domain.ReviewerStrategy(team.Strategy)
+		if !teamStrategy.IsValid() {
+			return pr_usecase.SelectionConfig{}, fmt.Errorf("unknown reviewer strategy %q for team %q", team.Strategy, team.TeamName)
+		}
+		teamStrategies[team.TeamName] = teamStrategy
+	}
+
+	weights := make(map[domain.UserID]int, len(cfg.Weights))
+	for _, w := range cfg.Weights {
+		weights[domain.UserID(w.UserID)] = w.Weight
+	}
+
+	return pr_usecase.SelectionConfig{
+		Strategy:       strategy,
+		TeamStrategies: teamStrategies,
+		Weights:        weights,
+		Seed:           cfg.Seed,
+	}, nil
+}
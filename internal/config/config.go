@@ -12,6 +12,7 @@ type Config struct {
 	Application  string             `mapstructure:"application"`
 	PublicServer PublicServerConfig `mapstructure:"public_server"`
 	Storage      StorageConfig      `mapstructure:"storage"`
+	Reviewers    ReviewersConfig    `mapstructure:"reviewers"`
 }
 
 func LoadConfig(configPath, envPath string) (*Config, error) {
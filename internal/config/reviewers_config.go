@@ -0,0 +1,22 @@
+package config
+
+type ReviewersConfig struct {
+	Strategy string                 `mapstructure:"strategy"`
+	Seed     int64                  `mapstructure:"seed"`
+	Teams    []TeamReviewersConfig  `mapstructure:"teams"`
+	Weights  []ReviewerWeightConfig `mapstructure:"weights"`
+	// FillInterval — период фонового добора ревьюверов в секундах; 0 — только по событиям.
+	FillInterval int `mapstructure:"fill_interval"`
+	// RequiredApprovals — минимум APPROVED для мержа во всех командах; merge_policy команды может требовать больше.
+	RequiredApprovals int `mapstructure:"required_approvals"`
+}
+
+type TeamReviewersConfig struct {
+	TeamName string `mapstructure:"team_name"`
+	Strategy string `mapstructure:"strategy"`
+}
+
+type ReviewerWeightConfig struct {
+	UserID string `mapstructure:"user_id"`
+	Weight int    `mapstructure:"weight"`
+}
@@ -0,0 +1,90 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"app/internal/controllers/gen"
+	"app/internal/domain"
+	"app/internal/mapper"
+	"app/internal/usecase/availability_usecase"
+	"app/internal/usecase/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AvailabilityController interface {
+	GetUsersUnavailability(c *gin.Context, params gen.GetUsersUnavailabilityParams)
+	PostUsersUnavailabilityAdd(c *gin.Context)
+	PostUsersUnavailabilityRemove(c *gin.Context)
+}
+
+type availabilityController struct {
+	availabilityUseCase availability_usecase.AvailabilityUseCase
+}
+
+func NewAvailabilityController(availabilityUseCase availability_usecase.AvailabilityUseCase) AvailabilityController {
+	return &availabilityController{
+		availabilityUseCase: availabilityUseCase,
+	}
+}
+
+func (s *availabilityController) GetUsersUnavailability(c *gin.Context, params gen.GetUsersUnavailabilityParams) {
+	windows, err := s.availabilityUseCase.ListUnavailability(c.Request.Context(), domain.UserID(params.UserId))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"user_id": params.UserId,
+		"windows": mapper.DomainUnavailabilitiesToDTOs(windows),
+	})
+}
+
+func (s *availabilityController) PostUsersUnavailabilityAdd(c *gin.Context) {
+	var req gen.PostUsersUnavailabilityAddJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var reason string
+	if req.Reason != nil {
+		reason = *req.Reason
+	}
+
+	window, err := s.availabilityUseCase.AddUnavailability(c.Request.Context(), domain.UserID(req.UserId),
+		req.StartsAt, req.EndsAt, reason)
+	if err != nil {
+		if errors.Is(err, errs.ErrUserNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"window": mapper.DomainUnavailabilityToDTO(*window)})
+}
+
+func (s *availabilityController) PostUsersUnavailabilityRemove(c *gin.Context) {
+	var req gen.PostUsersUnavailabilityRemoveJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	err := s.availabilityUseCase.RemoveUnavailability(c.Request.Context(), domain.UserID(req.UserId),
+		domain.UnavailabilityID(req.Id))
+	if err != nil {
+		if errors.Is(err, errs.ErrUnavailabilityNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
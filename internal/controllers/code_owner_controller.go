@@ -0,0 +1,89 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"app/internal/controllers/gen"
+	"app/internal/domain"
+	"app/internal/mapper"
+	"app/internal/usecase/code_owner_usecase"
+	"app/internal/usecase/errs"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CodeOwnerController interface {
+	GetCodeOwnersList(c *gin.Context)
+	PostCodeOwnersAdd(c *gin.Context)
+	PostCodeOwnersRemove(c *gin.Context)
+}
+
+type codeOwnerController struct {
+	codeOwnerUseCase code_owner_usecase.CodeOwnerUseCase
+}
+
+func NewCodeOwnerController(codeOwnerUseCase code_owner_usecase.CodeOwnerUseCase) CodeOwnerController {
+	return &codeOwnerController{
+		codeOwnerUseCase: codeOwnerUseCase,
+	}
+}
+
+func (s *codeOwnerController) GetCodeOwnersList(c *gin.Context) {
+	rules, err := s.codeOwnerUseCase.ListRules(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"rules": mapper.DomainCodeOwnerRulesToDTOs(rules)})
+}
+
+func (s *codeOwnerController) PostCodeOwnersAdd(c *gin.Context) {
+	var req gen.PostCodeOwnersAddJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var ownerUserID *domain.UserID
+	if req.UserId != nil {
+		id := domain.UserID(*req.UserId)
+		ownerUserID = &id
+	}
+
+	rule, err := s.codeOwnerUseCase.AddRule(c.Request.Context(), req.Pattern, ownerUserID, req.TeamName)
+	if err != nil {
+		if errors.Is(err, errs.ErrUserNotFound) || errors.Is(err, errs.ErrTeamNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		if errors.Is(err, errs.ErrCodeOwnerRuleAlreadyExists) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"rule": mapper.DomainCodeOwnerRuleToDTO(*rule)})
+}
+
+func (s *codeOwnerController) PostCodeOwnersRemove(c *gin.Context) {
+	var req gen.PostCodeOwnersRemoveJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := s.codeOwnerUseCase.RemoveRule(c.Request.Context(), domain.CodeOwnerID(req.Id)); err != nil {
+		if errors.Is(err, errs.ErrCodeOwnerRuleNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
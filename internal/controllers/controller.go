@@ -12,14 +12,19 @@ type Controller struct {
 	TeamController
 	StatsController
 	PullRequestController
+	CodeOwnerController
+	AvailabilityController
 }
 
 func NewController(userController UserController, teamController TeamController,
-	statsController StatsController, pullRequestController PullRequestController,) gen.ServerInterface {
+	statsController StatsController, pullRequestController PullRequestController,
+	codeOwnerController CodeOwnerController, availabilityController AvailabilityController,) gen.ServerInterface {
 	return &Controller{
 		UserController:        userController,
 		TeamController:        teamController,
 		StatsController:       statsController,
 		PullRequestController: pullRequestController,
+		CodeOwnerController:   codeOwnerController,
+		AvailabilityController: availabilityController,
 	}
 }
\ No newline at end of file
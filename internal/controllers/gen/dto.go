@@ -17,18 +17,90 @@ const (
 	TEAMEXISTS  ErrorResponseErrorCode = "TEAM_EXISTS"
 )
 
+// Defines values for PRStatusQuery.
+const (
+	PRStatusQueryCLOSED PRStatusQuery = "CLOSED"
+	PRStatusQueryDRAFT  PRStatusQuery = "DRAFT"
+	PRStatusQueryMERGED PRStatusQuery = "MERGED"
+	PRStatusQueryOPEN   PRStatusQuery = "OPEN"
+)
+
+// Defines values for SortOrderQuery.
+const (
+	Asc  SortOrderQuery = "asc"
+	Desc SortOrderQuery = "desc"
+)
+
 // Defines values for PullRequestStatus.
 const (
+	PullRequestStatusCLOSED PullRequestStatus = "CLOSED"
+	PullRequestStatusDRAFT  PullRequestStatus = "DRAFT"
 	PullRequestStatusMERGED PullRequestStatus = "MERGED"
 	PullRequestStatusOPEN   PullRequestStatus = "OPEN"
 )
 
+// Defines values for PullRequestDetailStatus.
+const (
+	PullRequestDetailStatusCLOSED PullRequestDetailStatus = "CLOSED"
+	PullRequestDetailStatusDRAFT  PullRequestDetailStatus = "DRAFT"
+	PullRequestDetailStatusMERGED PullRequestDetailStatus = "MERGED"
+	PullRequestDetailStatusOPEN   PullRequestDetailStatus = "OPEN"
+)
+
+// Defines values for PullRequestReviewerReviewState.
+const (
+	APPROVED         PullRequestReviewerReviewState = "APPROVED"
+	CHANGESREQUESTED PullRequestReviewerReviewState = "CHANGES_REQUESTED"
+	PENDING          PullRequestReviewerReviewState = "PENDING"
+)
+
 // Defines values for PullRequestShortStatus.
 const (
+	PullRequestShortStatusCLOSED PullRequestShortStatus = "CLOSED"
+	PullRequestShortStatusDRAFT  PullRequestShortStatus = "DRAFT"
 	PullRequestShortStatusMERGED PullRequestShortStatus = "MERGED"
 	PullRequestShortStatusOPEN   PullRequestShortStatus = "OPEN"
 )
 
+// Defines values for TeamSettingsReviewerStrategy.
+const (
+	LeastLoaded TeamSettingsReviewerStrategy = "least_loaded"
+	Random      TeamSettingsReviewerStrategy = "random"
+	RoundRobin  TeamSettingsReviewerStrategy = "round_robin"
+	Weighted    TeamSettingsReviewerStrategy = "weighted"
+)
+
+// Defines values for UnmetMergeConditionCondition.
+const (
+	ALLREVIEWERSRESPONDED UnmetMergeConditionCondition = "ALL_REVIEWERS_RESPONDED"
+	MINAGE                UnmetMergeConditionCondition = "MIN_AGE"
+	MINAPPROVALS          UnmetMergeConditionCondition = "MIN_APPROVALS"
+	NOCHANGESREQUESTED    UnmetMergeConditionCondition = "NO_CHANGES_REQUESTED"
+	NOSELFMERGE           UnmetMergeConditionCondition = "NO_SELF_MERGE"
+)
+
+// Defines values for PostPullRequestReviewJSONBodyVerdict.
+const (
+	APPROVE        PostPullRequestReviewJSONBodyVerdict = "APPROVE"
+	COMMENT        PostPullRequestReviewJSONBodyVerdict = "COMMENT"
+	REQUESTCHANGES PostPullRequestReviewJSONBodyVerdict = "REQUEST_CHANGES"
+)
+
+// CodeOwnerRule defines model for CodeOwnerRule.
+type CodeOwnerRule struct {
+	CreatedAt time.Time `json:"created_at"`
+	Id        int64     `json:"id"`
+
+	// Pattern Glob в духе CODEOWNERS (`*`, `**`, ведущий `/` привязывает к корню)
+	Pattern string `json:"pattern"`
+
+	// TeamName Владелец-команда
+	TeamName *string `json:"team_name,omitempty"`
+
+	// UserId Владелец-пользователь; задан ровно один из user_id и team_name
+	UserId *string `json:"user_id,omitempty"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Error struct {
@@ -40,21 +112,89 @@ type ErrorResponse struct {
 // ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
 type ErrorResponseErrorCode string
 
+// MergePolicy Условия, проверяемые перед мержем PR авторов команды
+type MergePolicy struct {
+	// ForbidSelfMerge Автор не может смержить свой PR (требует merged_by)
+	ForbidSelfMerge bool `json:"forbid_self_merge"`
+
+	// MinAgeSeconds Сколько секунд PR должен быть открыт (с выхода из черновика)
+	MinAgeSeconds int `json:"min_age_seconds"`
+
+	// MinApprovals Минимум APPROVED; при > 0 также блокирует мерж при CHANGES_REQUESTED
+	MinApprovals int `json:"min_approvals"`
+
+	// RequireAllResponded Каждый активный ревьювер должен оставить вердикт
+	RequireAllResponded bool `json:"require_all_responded"`
+}
+
 // PullRequest defines model for PullRequest.
 type PullRequest struct {
 	// AssignedReviewers user_id назначенных ревьюверов (0..2)
-	AssignedReviewers []string          `json:"assigned_reviewers"`
-	AuthorId          string            `json:"author_id"`
-	CreatedAt         *time.Time        `json:"createdAt"`
-	MergedAt          *time.Time        `json:"mergedAt"`
-	PullRequestId     string            `json:"pull_request_id"`
-	PullRequestName   string            `json:"pull_request_name"`
-	Status            PullRequestStatus `json:"status"`
+	AssignedReviewers []string `json:"assigned_reviewers"`
+	AuthorId          string   `json:"author_id"`
+
+	// CandidateLoads Число OPEN-ревью у каждого кандидата на момент выбора (только для стратегии least_loaded)
+	CandidateLoads *map[string]int `json:"candidate_loads,omitempty"`
+	CreatedAt      *time.Time      `json:"createdAt"`
+
+	// FallbackReviewers Ревьюверы, взятые из запасных команд, и имя команды каждого
+	FallbackReviewers *map[string]string `json:"fallback_reviewers,omitempty"`
+	MergedAt          *time.Time         `json:"mergedAt"`
+
+	// MergedBy Кто смержил PR
+	MergedBy *string `json:"merged_by"`
+
+	// NeedMoreReviewers Назначено меньше ревьюверов, чем min_reviewers команды
+	NeedMoreReviewers *bool  `json:"need_more_reviewers,omitempty"`
+	PullRequestId     string `json:"pull_request_id"`
+	PullRequestName   string `json:"pull_request_name"`
+
+	// ReadyAt Когда PR вышел из черновика (для созданных не черновиком совпадает с моментом создания)
+	ReadyAt *time.Time        `json:"ready_at"`
+	Status  PullRequestStatus `json:"status"`
 }
 
 // PullRequestStatus defines model for PullRequest.Status.
 type PullRequestStatus string
 
+// PullRequestDetail defines model for PullRequestDetail.
+type PullRequestDetail struct {
+	Author    TeamMember `json:"author"`
+	CreatedAt time.Time  `json:"created_at"`
+	MergedAt  *time.Time `json:"merged_at"`
+
+	// MergedBy Кто смержил PR
+	MergedBy *string `json:"merged_by"`
+
+	// NeedMoreReviewers Назначено меньше ревьюверов, чем min_reviewers команды
+	NeedMoreReviewers bool   `json:"need_more_reviewers"`
+	PullRequestId     string `json:"pull_request_id"`
+	PullRequestName   string `json:"pull_request_name"`
+
+	// ReadyAt Когда PR вышел из черновика (для созданных не черновиком совпадает с моментом создания)
+	ReadyAt   *time.Time              `json:"ready_at"`
+	Reviewers []PullRequestReviewer   `json:"reviewers"`
+	Status    PullRequestDetailStatus `json:"status"`
+}
+
+// PullRequestDetailStatus defines model for PullRequestDetail.Status.
+type PullRequestDetailStatus string
+
+// PullRequestReviewer defines model for PullRequestReviewer.
+type PullRequestReviewer struct {
+	AssignedAt  time.Time                      `json:"assigned_at"`
+	IsActive    bool                           `json:"is_active"`
+	ReviewState PullRequestReviewerReviewState `json:"review_state"`
+
+	// ReviewedAt Момент последнего вердикта ревьювера
+	ReviewedAt *time.Time `json:"reviewed_at"`
+	UserId     string     `json:"user_id"`
+	Username   string     `json:"username"`
+}
+
+// PullRequestReviewerReviewState defines model for PullRequestReviewer.ReviewState.
+type PullRequestReviewerReviewState string
+
 // PullRequestShort defines model for PullRequestShort.
 type PullRequestShort struct {
 	AuthorId        string                 `json:"author_id"`
@@ -66,6 +206,22 @@ type PullRequestShort struct {
 // PullRequestShortStatus defines model for PullRequestShort.Status.
 type PullRequestShortStatus string
 
+// ReassignmentReport defines model for ReassignmentReport.
+type ReassignmentReport struct {
+	Reassigned []ReviewerReassignment `json:"reassigned"`
+
+	// Unfilled PR, где замену найти не удалось; need_more_reviewers пересчитан
+	Unfilled []ReviewerReassignment `json:"unfilled"`
+}
+
+// ReviewerReassignment defines model for ReviewerReassignment.
+type ReviewerReassignment struct {
+	// NewReviewerId Отсутствует, если свободного ревьювера не нашлось
+	NewReviewerId *string `json:"new_reviewer_id,omitempty"`
+	OldReviewerId string  `json:"old_reviewer_id"`
+	PullRequestId string  `json:"pull_request_id"`
+}
+
 // Team defines model for Team.
 type Team struct {
 	Members  []TeamMember `json:"members"`
@@ -79,6 +235,80 @@ type TeamMember struct {
 	Username string `json:"username"`
 }
 
+// TeamMembership Членство пользователя в команде
+type TeamMembership struct {
+	// IsPrimary Основная команда: из неё выбираются ревьюверы PR без явно указанной команды
+	IsPrimary bool      `json:"is_primary"`
+	JoinedAt  time.Time `json:"joined_at"`
+	TeamName  string    `json:"team_name"`
+}
+
+// TeamSettings defines model for TeamSettings.
+type TeamSettings struct {
+	// FallbackTeams Команды, из которых добираются ревьюверы, если своих не хватает до min_reviewers; порядок задаёт приоритет
+	FallbackTeams *[]string `json:"fallback_teams,omitempty"`
+
+	// MaxReviewers Сколько ревьюверов назначать при создании PR
+	MaxReviewers int `json:"max_reviewers"`
+
+	// MergePolicy Условия, проверяемые перед мержем PR авторов команды
+	MergePolicy *MergePolicy `json:"merge_policy,omitempty"`
+
+	// MinReviewers Минимум ревьюверов; если кандидатов меньше, PR помечается need_more_reviewers
+	MinReviewers int `json:"min_reviewers"`
+
+	// ReviewerStrategy Стратегия выбора ревьюверов; если не задана, берётся из конфигурации сервиса
+	ReviewerStrategy *TeamSettingsReviewerStrategy `json:"reviewer_strategy,omitempty"`
+	TeamName         string                        `json:"team_name"`
+}
+
+// TeamSyncReport Изменения состава команды, внесённые синхронизацией (при dry_run — только вычисленные)
+type TeamSyncReport struct {
+	// Added Новые участники, в том числе переведённые из других команд
+	Added        []string           `json:"added"`
+	DryRun       bool               `json:"dry_run"`
+	Reassignment ReassignmentReport `json:"reassignment"`
+	Removed      []string           `json:"removed"`
+	TeamCreated  bool               `json:"team_created"`
+	TeamName     string             `json:"team_name"`
+
+	// Updated Участники, у которых изменились имя или активность
+	Updated []string `json:"updated"`
+}
+
+// TeamSummary defines model for TeamSummary.
+type TeamSummary struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// MemberCount Число участников команды
+	MemberCount int    `json:"member_count"`
+	TeamName    string `json:"team_name"`
+}
+
+// TeamSettingsReviewerStrategy Стратегия выбора ревьюверов; если не задана, берётся из конфигурации сервиса
+type TeamSettingsReviewerStrategy string
+
+// Unavailability Окно [starts_at, ends_at), в которое пользователь не назначается ревьювером
+type Unavailability struct {
+	EndsAt   time.Time `json:"ends_at"`
+	Id       int64     `json:"id"`
+	Reason   string    `json:"reason"`
+	StartsAt time.Time `json:"starts_at"`
+	UserId   string    `json:"user_id"`
+}
+
+// UnmetMergeCondition defines model for UnmetMergeCondition.
+type UnmetMergeCondition struct {
+	Condition UnmetMergeConditionCondition `json:"condition"`
+	Message   string                       `json:"message"`
+
+	// UserIds Ревьюверы, из-за которых условие не выполнено
+	UserIds *[]string `json:"user_ids,omitempty"`
+}
+
+// UnmetMergeConditionCondition defines model for UnmetMergeCondition.Condition.
+type UnmetMergeConditionCondition string
+
 // User defines model for User.
 type User struct {
 	IsActive bool   `json:"is_active"`
@@ -94,28 +324,136 @@ type UserAssignmentStats struct {
 	UserId        string `json:"user_id"`
 }
 
+// UserSkills defines model for UserSkills.
+type UserSkills struct {
+	Skills []string `json:"skills"`
+	UserId string   `json:"user_id"`
+}
+
+// UserTeams defines model for UserTeams.
+type UserTeams struct {
+	// Teams Все команды пользователя, основная — первая
+	Teams  []TeamMembership `json:"teams"`
+	UserId string           `json:"user_id"`
+}
+
+// CreatedAfterQuery defines model for CreatedAfterQuery.
+type CreatedAfterQuery = time.Time
+
+// CreatedBeforeQuery defines model for CreatedBeforeQuery.
+type CreatedBeforeQuery = time.Time
+
+// CursorQuery defines model for CursorQuery.
+type CursorQuery = string
+
+// LimitQuery defines model for LimitQuery.
+type LimitQuery = int
+
+// PRStatusQuery defines model for PRStatusQuery.
+type PRStatusQuery string
+
+// PullRequestIdQuery defines model for PullRequestIdQuery.
+type PullRequestIdQuery = string
+
+// SortOrderQuery defines model for SortOrderQuery.
+type SortOrderQuery string
+
 // TeamNameQuery defines model for TeamNameQuery.
 type TeamNameQuery = string
 
 // UserIdQuery defines model for UserIdQuery.
 type UserIdQuery = string
 
+// PostCodeOwnersAddJSONBody defines parameters for PostCodeOwnersAdd.
+type PostCodeOwnersAddJSONBody struct {
+	Pattern  string  `json:"pattern"`
+	TeamName *string `json:"team_name,omitempty"`
+	UserId   *string `json:"user_id,omitempty"`
+}
+
+// PostCodeOwnersRemoveJSONBody defines parameters for PostCodeOwnersRemove.
+type PostCodeOwnersRemoveJSONBody struct {
+	Id int64 `json:"id"`
+}
+
+// PostPullRequestCloseJSONBody defines parameters for PostPullRequestClose.
+type PostPullRequestCloseJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+}
+
 // PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
 type PostPullRequestCreateJSONBody struct {
-	AuthorId        string `json:"author_id"`
+	AuthorId string `json:"author_id"`
+
+	// ChangedFiles Изменённые файлы; сначала назначаются владельцы путей из /codeOwners, остальные места добираются из команды автора. Для черновика игнорируется
+	ChangedFiles *[]string `json:"changed_files,omitempty"`
+
+	// Draft Создать черновик (DRAFT) без назначения ревьюверов
+	Draft           *bool  `json:"draft,omitempty"`
 	PullRequestId   string `json:"pull_request_id"`
 	PullRequestName string `json:"pull_request_name"`
+
+	// RequiredSkills Теги навыков; кандидаты с большим числом совпадений выбираются раньше остальных
+	RequiredSkills *[]string `json:"required_skills,omitempty"`
+
+	// TeamName Команда автора, из которой выбираются ревьюверы; по умолчанию основная команда автора
+	TeamName *string `json:"team_name,omitempty"`
+}
+
+// GetPullRequestGetParams defines parameters for GetPullRequestGet.
+type GetPullRequestGetParams struct {
+	// PullRequestId Идентификатор PR
+	PullRequestId PullRequestIdQuery `form:"pull_request_id" json:"pull_request_id"`
 }
 
 // PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
 type PostPullRequestMergeJSONBody struct {
+	// MergedBy user_id того, кто мержит PR
+	MergedBy      *string `json:"merged_by,omitempty"`
+	PullRequestId string  `json:"pull_request_id"`
+}
+
+// PostPullRequestReadyJSONBody defines parameters for PostPullRequestReady.
+type PostPullRequestReadyJSONBody struct {
 	PullRequestId string `json:"pull_request_id"`
 }
 
 // PostPullRequestReassignJSONBody defines parameters for PostPullRequestReassign.
 type PostPullRequestReassignJSONBody struct {
-	OldUserId     string `json:"old_user_id"`
+	// NewReviewerId Активный участник команды автора, которого назначить вместо old_user_id; если не задан, замену выбирает стратегия команды
+	NewReviewerId *string `json:"new_reviewer_id,omitempty"`
+	OldUserId     string  `json:"old_user_id"`
+	PullRequestId string  `json:"pull_request_id"`
+}
+
+// PostPullRequestReopenJSONBody defines parameters for PostPullRequestReopen.
+type PostPullRequestReopenJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+
+	// ReselectReviewers Снять прежних ревьюверов и выбрать новых по стратегии команды
+	ReselectReviewers *bool `json:"reselect_reviewers,omitempty"`
+}
+
+// PostPullRequestReviewJSONBody defines parameters for PostPullRequestReview.
+type PostPullRequestReviewJSONBody struct {
+	PullRequestId string                               `json:"pull_request_id"`
+	ReviewerId    string                               `json:"reviewer_id"`
+	Verdict       PostPullRequestReviewJSONBodyVerdict `json:"verdict"`
+}
+
+// PostPullRequestReviewJSONBodyVerdict defines parameters for PostPullRequestReview.
+type PostPullRequestReviewJSONBodyVerdict string
+
+// PostPullRequestReviewersAddJSONBody defines parameters for PostPullRequestReviewersAdd.
+type PostPullRequestReviewersAddJSONBody struct {
 	PullRequestId string `json:"pull_request_id"`
+	ReviewerId    string `json:"reviewer_id"`
+}
+
+// PostPullRequestReviewersRemoveJSONBody defines parameters for PostPullRequestReviewersRemove.
+type PostPullRequestReviewersRemoveJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+	ReviewerId    string `json:"reviewer_id"`
 }
 
 // GetStatsAssignmentsParams defines parameters for GetStatsAssignments.
@@ -130,15 +468,137 @@ type GetTeamGetParams struct {
 	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
 }
 
+// PostTeamDeleteJSONBody defines parameters for PostTeamDelete.
+type PostTeamDeleteJSONBody struct {
+	// CloseOpenPrs Закрыть OPEN PR авторов команды вместо отказа в удалении
+	CloseOpenPrs *bool  `json:"close_open_prs,omitempty"`
+	TeamName     string `json:"team_name"`
+}
+
+// GetTeamListParams defines parameters for GetTeamList.
+type GetTeamListParams struct {
+	// Limit Размер страницы
+	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Курсор следующей страницы из поля next_cursor предыдущего ответа
+	Cursor *CursorQuery `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// PostTeamMembersAddJSONBody defines parameters for PostTeamMembersAdd.
+type PostTeamMembersAddJSONBody struct {
+	TeamName string `json:"team_name"`
+	UserId   string `json:"user_id"`
+
+	// Username Имя пользователя; обязательно, если пользователь ещё не существует
+	Username *string `json:"username,omitempty"`
+}
+
+// PostTeamMembersMoveJSONBody defines parameters for PostTeamMembersMove.
+type PostTeamMembersMoveJSONBody struct {
+	// TeamName Команда, в которую переводится пользователь
+	TeamName string `json:"team_name"`
+	UserId   string `json:"user_id"`
+}
+
+// PostTeamMembersRemoveJSONBody defines parameters for PostTeamMembersRemove.
+type PostTeamMembersRemoveJSONBody struct {
+	TeamName string `json:"team_name"`
+	UserId   string `json:"user_id"`
+}
+
+// PostTeamRenameJSONBody defines parameters for PostTeamRename.
+type PostTeamRenameJSONBody struct {
+	NewTeamName string `json:"new_team_name"`
+	TeamName    string `json:"team_name"`
+}
+
+// GetTeamSettingsParams defines parameters for GetTeamSettings.
+type GetTeamSettingsParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// PutTeamSyncJSONBody defines parameters for PutTeamSync.
+type PutTeamSyncJSONBody struct {
+	// DryRun Только вычислить изменения, ничего не применяя
+	DryRun *bool `json:"dry_run,omitempty"`
+
+	// Members Желаемый состав команды
+	Members  []TeamMember `json:"members"`
+	TeamName string       `json:"team_name"`
+}
+
 // PostUsersDeactivateTeamJSONBody defines parameters for PostUsersDeactivateTeam.
 type PostUsersDeactivateTeamJSONBody struct {
 	TeamName string `json:"team_name"`
 }
 
+// PostUsersDeleteJSONBody defines parameters for PostUsersDelete.
+type PostUsersDeleteJSONBody struct {
+	UserId string `json:"user_id"`
+}
+
+// GetUsersGetAuthoredParams defines parameters for GetUsersGetAuthored.
+type GetUsersGetAuthoredParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+
+	// Limit Размер страницы
+	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Курсор следующей страницы из поля next_cursor предыдущего ответа
+	Cursor *CursorQuery `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Status Фильтр по статусу PR
+	Status *PRStatusQuery `form:"status,omitempty" json:"status,omitempty"`
+
+	// CreatedAfter PR, созданные не раньше указанного момента
+	CreatedAfter *CreatedAfterQuery `form:"created_after,omitempty" json:"created_after,omitempty"`
+
+	// CreatedBefore PR, созданные раньше указанного момента
+	CreatedBefore *CreatedBeforeQuery `form:"created_before,omitempty" json:"created_before,omitempty"`
+
+	// Sort Порядок сортировки по created_at
+	Sort *SortOrderQuery `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
 // GetUsersGetReviewParams defines parameters for GetUsersGetReview.
 type GetUsersGetReviewParams struct {
 	// UserId Идентификатор пользователя
 	UserId UserIdQuery `form:"user_id" json:"user_id"`
+
+	// Limit Размер страницы
+	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Курсор следующей страницы из поля next_cursor предыдущего ответа
+	Cursor *CursorQuery `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Status Фильтр по статусу PR
+	Status *PRStatusQuery `form:"status,omitempty" json:"status,omitempty"`
+
+	// CreatedAfter PR, созданные не раньше указанного момента
+	CreatedAfter *CreatedAfterQuery `form:"created_after,omitempty" json:"created_after,omitempty"`
+
+	// CreatedBefore PR, созданные раньше указанного момента
+	CreatedBefore *CreatedBeforeQuery `form:"created_before,omitempty" json:"created_before,omitempty"`
+
+	// Sort Порядок сортировки по created_at
+	Sort *SortOrderQuery `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// GetUsersListParams defines parameters for GetUsersList.
+type GetUsersListParams struct {
+	// TeamName Только участники команды
+	TeamName *TeamNameQuery `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// IsActive Фильтр по активности
+	IsActive *bool `form:"is_active,omitempty" json:"is_active,omitempty"`
+
+	// Limit Размер страницы
+	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Курсор следующей страницы из поля next_cursor предыдущего ответа
+	Cursor *CursorQuery `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
 // PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
@@ -147,20 +607,144 @@ type PostUsersSetIsActiveJSONBody struct {
 	UserId   string `json:"user_id"`
 }
 
+// PostUsersSetPrimaryTeamJSONBody defines parameters for PostUsersSetPrimaryTeam.
+type PostUsersSetPrimaryTeamJSONBody struct {
+	TeamName string `json:"team_name"`
+	UserId   string `json:"user_id"`
+}
+
+// GetUsersSkillsParams defines parameters for GetUsersSkills.
+type GetUsersSkillsParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// PostUsersSkillsAddJSONBody defines parameters for PostUsersSkillsAdd.
+type PostUsersSkillsAddJSONBody struct {
+	Skills []string `json:"skills"`
+	UserId string   `json:"user_id"`
+}
+
+// PostUsersSkillsRemoveJSONBody defines parameters for PostUsersSkillsRemove.
+type PostUsersSkillsRemoveJSONBody struct {
+	Skills []string `json:"skills"`
+	UserId string   `json:"user_id"`
+}
+
+// GetUsersTeamsParams defines parameters for GetUsersTeams.
+type GetUsersTeamsParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// GetUsersUnavailabilityParams defines parameters for GetUsersUnavailability.
+type GetUsersUnavailabilityParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// PostUsersUnavailabilityAddJSONBody defines parameters for PostUsersUnavailabilityAdd.
+type PostUsersUnavailabilityAddJSONBody struct {
+	EndsAt time.Time `json:"ends_at"`
+
+	// Reason Например, отпуск или конференция
+	Reason   *string   `json:"reason,omitempty"`
+	StartsAt time.Time `json:"starts_at"`
+	UserId   string    `json:"user_id"`
+}
+
+// PostUsersUnavailabilityRemoveJSONBody defines parameters for PostUsersUnavailabilityRemove.
+type PostUsersUnavailabilityRemoveJSONBody struct {
+	Id     int64  `json:"id"`
+	UserId string `json:"user_id"`
+}
+
+// PostUsersUpdateJSONBody defines parameters for PostUsersUpdate.
+type PostUsersUpdateJSONBody struct {
+	UserId   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+// PostCodeOwnersAddJSONRequestBody defines body for PostCodeOwnersAdd for application/json ContentType.
+type PostCodeOwnersAddJSONRequestBody PostCodeOwnersAddJSONBody
+
+// PostCodeOwnersRemoveJSONRequestBody defines body for PostCodeOwnersRemove for application/json ContentType.
+type PostCodeOwnersRemoveJSONRequestBody PostCodeOwnersRemoveJSONBody
+
+// PostPullRequestCloseJSONRequestBody defines body for PostPullRequestClose for application/json ContentType.
+type PostPullRequestCloseJSONRequestBody PostPullRequestCloseJSONBody
+
 // PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
 type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
 
 // PostPullRequestMergeJSONRequestBody defines body for PostPullRequestMerge for application/json ContentType.
 type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
 
+// PostPullRequestReadyJSONRequestBody defines body for PostPullRequestReady for application/json ContentType.
+type PostPullRequestReadyJSONRequestBody PostPullRequestReadyJSONBody
+
 // PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
 type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
 
+// PostPullRequestReopenJSONRequestBody defines body for PostPullRequestReopen for application/json ContentType.
+type PostPullRequestReopenJSONRequestBody PostPullRequestReopenJSONBody
+
+// PostPullRequestReviewJSONRequestBody defines body for PostPullRequestReview for application/json ContentType.
+type PostPullRequestReviewJSONRequestBody PostPullRequestReviewJSONBody
+
+// PostPullRequestReviewersAddJSONRequestBody defines body for PostPullRequestReviewersAdd for application/json ContentType.
+type PostPullRequestReviewersAddJSONRequestBody PostPullRequestReviewersAddJSONBody
+
+// PostPullRequestReviewersRemoveJSONRequestBody defines body for PostPullRequestReviewersRemove for application/json ContentType.
+type PostPullRequestReviewersRemoveJSONRequestBody PostPullRequestReviewersRemoveJSONBody
+
 // PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
 type PostTeamAddJSONRequestBody = Team
 
+// PostTeamDeleteJSONRequestBody defines body for PostTeamDelete for application/json ContentType.
+type PostTeamDeleteJSONRequestBody PostTeamDeleteJSONBody
+
+// PostTeamMembersAddJSONRequestBody defines body for PostTeamMembersAdd for application/json ContentType.
+type PostTeamMembersAddJSONRequestBody PostTeamMembersAddJSONBody
+
+// PostTeamMembersMoveJSONRequestBody defines body for PostTeamMembersMove for application/json ContentType.
+type PostTeamMembersMoveJSONRequestBody PostTeamMembersMoveJSONBody
+
+// PostTeamMembersRemoveJSONRequestBody defines body for PostTeamMembersRemove for application/json ContentType.
+type PostTeamMembersRemoveJSONRequestBody PostTeamMembersRemoveJSONBody
+
+// PostTeamRenameJSONRequestBody defines body for PostTeamRename for application/json ContentType.
+type PostTeamRenameJSONRequestBody PostTeamRenameJSONBody
+
+// PostTeamSettingsJSONRequestBody defines body for PostTeamSettings for application/json ContentType.
+type PostTeamSettingsJSONRequestBody = TeamSettings
+
+// PutTeamSyncJSONRequestBody defines body for PutTeamSync for application/json ContentType.
+type PutTeamSyncJSONRequestBody PutTeamSyncJSONBody
+
 // PostUsersDeactivateTeamJSONRequestBody defines body for PostUsersDeactivateTeam for application/json ContentType.
 type PostUsersDeactivateTeamJSONRequestBody PostUsersDeactivateTeamJSONBody
 
+// PostUsersDeleteJSONRequestBody defines body for PostUsersDelete for application/json ContentType.
+type PostUsersDeleteJSONRequestBody PostUsersDeleteJSONBody
+
 // PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
 type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
+
+// PostUsersSetPrimaryTeamJSONRequestBody defines body for PostUsersSetPrimaryTeam for application/json ContentType.
+type PostUsersSetPrimaryTeamJSONRequestBody PostUsersSetPrimaryTeamJSONBody
+
+// PostUsersSkillsAddJSONRequestBody defines body for PostUsersSkillsAdd for application/json ContentType.
+type PostUsersSkillsAddJSONRequestBody PostUsersSkillsAddJSONBody
+
+// PostUsersSkillsRemoveJSONRequestBody defines body for PostUsersSkillsRemove for application/json ContentType.
+type PostUsersSkillsRemoveJSONRequestBody PostUsersSkillsRemoveJSONBody
+
+// PostUsersUnavailabilityAddJSONRequestBody defines body for PostUsersUnavailabilityAdd for application/json ContentType.
+type PostUsersUnavailabilityAddJSONRequestBody PostUsersUnavailabilityAddJSONBody
+
+// PostUsersUnavailabilityRemoveJSONRequestBody defines body for PostUsersUnavailabilityRemove for application/json ContentType.
+type PostUsersUnavailabilityRemoveJSONRequestBody PostUsersUnavailabilityRemoveJSONBody
+
+// PostUsersUpdateJSONRequestBody defines body for PostUsersUpdate for application/json ContentType.
+type PostUsersUpdateJSONRequestBody PostUsersUpdateJSONBody
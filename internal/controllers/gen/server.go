@@ -13,33 +13,126 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Добавить правило владения путями
+	// (POST /codeOwners/add)
+	PostCodeOwnersAdd(c *gin.Context)
+	// Получить таблицу владения путями
+	// (GET /codeOwners/list)
+	GetCodeOwnersList(c *gin.Context)
+	// Удалить правило владения путями
+	// (POST /codeOwners/remove)
+	PostCodeOwnersRemove(c *gin.Context)
+	// Закрыть PR без мержа (идемпотентная операция)
+	// (POST /pullRequest/close)
+	PostPullRequestClose(c *gin.Context)
 	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
 	// (POST /pullRequest/create)
 	PostPullRequestCreate(c *gin.Context)
+	// Получить PR с автором и ревьюверами
+	// (GET /pullRequest/get)
+	GetPullRequestGet(c *gin.Context, params GetPullRequestGetParams)
 	// Пометить PR как MERGED (идемпотентная операция)
 	// (POST /pullRequest/merge)
 	PostPullRequestMerge(c *gin.Context)
+	// Вывести PR из черновика и назначить ревьюверов (идемпотентная операция)
+	// (POST /pullRequest/ready)
+	PostPullRequestReady(c *gin.Context)
 	// Переназначить конкретного ревьювера на другого из его команды
 	// (POST /pullRequest/reassign)
 	PostPullRequestReassign(c *gin.Context)
+	// Переоткрыть закрытый PR (идемпотентная операция)
+	// (POST /pullRequest/reopen)
+	PostPullRequestReopen(c *gin.Context)
+	// Оставить вердикт ревьювера по OPEN PR
+	// (POST /pullRequest/review)
+	PostPullRequestReview(c *gin.Context)
+	// Вручную назначить участника команды автора ревьювером OPEN PR
+	// (POST /pullRequest/reviewers/add)
+	PostPullRequestReviewersAdd(c *gin.Context)
+	// Снять ревьювера с OPEN PR без замены
+	// (POST /pullRequest/reviewers/remove)
+	PostPullRequestReviewersRemove(c *gin.Context)
 	// Получить статистику назначений ревьюверов для пользователя
 	// (GET /stats/assignments)
 	GetStatsAssignments(c *gin.Context, params GetStatsAssignmentsParams)
 	// Создать команду с участниками (создаёт/обновляет пользователей)
 	// (POST /team/add)
 	PostTeamAdd(c *gin.Context)
+	// Удалить команду
+	// (POST /team/delete)
+	PostTeamDelete(c *gin.Context)
 	// Получить команду с участниками
 	// (GET /team/get)
 	GetTeamGet(c *gin.Context, params GetTeamGetParams)
+	// Список команд с числом участников
+	// (GET /team/list)
+	GetTeamList(c *gin.Context, params GetTeamListParams)
+	// Добавить пользователя в команду
+	// (POST /team/members/add)
+	PostTeamMembersAdd(c *gin.Context)
+	// Перевести пользователя в другую команду
+	// (POST /team/members/move)
+	PostTeamMembersMove(c *gin.Context)
+	// Исключить пользователя из команды
+	// (POST /team/members/remove)
+	PostTeamMembersRemove(c *gin.Context)
+	// Переименовать команду
+	// (POST /team/rename)
+	PostTeamRename(c *gin.Context)
+	// Получить настройки назначения ревьюверов команды
+	// (GET /team/settings)
+	GetTeamSettings(c *gin.Context, params GetTeamSettingsParams)
+	// Изменить настройки назначения ревьюверов команды
+	// (POST /team/settings)
+	PostTeamSettings(c *gin.Context)
+	// Синхронизировать состав команды с желаемым списком
+	// (PUT /team/sync)
+	PutTeamSync(c *gin.Context)
 	// Массово деактивировать всех пользователей команды
 	// (POST /users/deactivateTeam)
 	PostUsersDeactivateTeam(c *gin.Context)
+	// Удалить пользователя (мягко, история PR сохраняется)
+	// (POST /users/delete)
+	PostUsersDelete(c *gin.Context)
+	// Получить PR'ы, автором которых является пользователь
+	// (GET /users/getAuthored)
+	GetUsersGetAuthored(c *gin.Context, params GetUsersGetAuthoredParams)
 	// Получить PR'ы, где пользователь назначен ревьювером
 	// (GET /users/getReview)
 	GetUsersGetReview(c *gin.Context, params GetUsersGetReviewParams)
+	// Список пользователей с фильтрами
+	// (GET /users/list)
+	GetUsersList(c *gin.Context, params GetUsersListParams)
 	// Установить флаг активности пользователя
 	// (POST /users/setIsActive)
 	PostUsersSetIsActive(c *gin.Context)
+	// Выбрать основную команду пользователя
+	// (POST /users/setPrimaryTeam)
+	PostUsersSetPrimaryTeam(c *gin.Context)
+	// Получить теги навыков пользователя
+	// (GET /users/skills)
+	GetUsersSkills(c *gin.Context, params GetUsersSkillsParams)
+	// Добавить пользователю теги навыков
+	// (POST /users/skills/add)
+	PostUsersSkillsAdd(c *gin.Context)
+	// Снять с пользователя теги навыков
+	// (POST /users/skills/remove)
+	PostUsersSkillsRemove(c *gin.Context)
+	// Получить все команды пользователя
+	// (GET /users/teams)
+	GetUsersTeams(c *gin.Context, params GetUsersTeamsParams)
+	// Получить действующие и будущие окна недоступности пользователя
+	// (GET /users/unavailability)
+	GetUsersUnavailability(c *gin.Context, params GetUsersUnavailabilityParams)
+	// Зарегистрировать окно недоступности (отпуск, OOO)
+	// (POST /users/unavailability/add)
+	PostUsersUnavailabilityAdd(c *gin.Context)
+	// Удалить окно недоступности
+	// (POST /users/unavailability/remove)
+	PostUsersUnavailabilityRemove(c *gin.Context)
+	// Изменить имя пользователя
+	// (POST /users/update)
+	PostUsersUpdate(c *gin.Context)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -51,6 +144,58 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(c *gin.Context)
 
+// PostCodeOwnersAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostCodeOwnersAdd(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostCodeOwnersAdd(c)
+}
+
+// GetCodeOwnersList operation middleware
+func (siw *ServerInterfaceWrapper) GetCodeOwnersList(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetCodeOwnersList(c)
+}
+
+// PostCodeOwnersRemove operation middleware
+func (siw *ServerInterfaceWrapper) PostCodeOwnersRemove(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostCodeOwnersRemove(c)
+}
+
+// PostPullRequestClose operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestClose(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPullRequestClose(c)
+}
+
 // PostPullRequestCreate operation middleware
 func (siw *ServerInterfaceWrapper) PostPullRequestCreate(c *gin.Context) {
 
@@ -64,6 +209,39 @@ func (siw *ServerInterfaceWrapper) PostPullRequestCreate(c *gin.Context) {
 	siw.Handler.PostPullRequestCreate(c)
 }
 
+// GetPullRequestGet operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestGet(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestGetParams
+
+	// ------------- Required query parameter "pull_request_id" -------------
+
+	if paramValue := c.Query("pull_request_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument pull_request_id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "pull_request_id", c.Request.URL.Query(), &params.PullRequestId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pull_request_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPullRequestGet(c, params)
+}
+
 // PostPullRequestMerge operation middleware
 func (siw *ServerInterfaceWrapper) PostPullRequestMerge(c *gin.Context) {
 
@@ -77,6 +255,19 @@ func (siw *ServerInterfaceWrapper) PostPullRequestMerge(c *gin.Context) {
 	siw.Handler.PostPullRequestMerge(c)
 }
 
+// PostPullRequestReady operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReady(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPullRequestReady(c)
+}
+
 // PostPullRequestReassign operation middleware
 func (siw *ServerInterfaceWrapper) PostPullRequestReassign(c *gin.Context) {
 
@@ -90,6 +281,58 @@ func (siw *ServerInterfaceWrapper) PostPullRequestReassign(c *gin.Context) {
 	siw.Handler.PostPullRequestReassign(c)
 }
 
+// PostPullRequestReopen operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReopen(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPullRequestReopen(c)
+}
+
+// PostPullRequestReview operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReview(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPullRequestReview(c)
+}
+
+// PostPullRequestReviewersAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReviewersAdd(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPullRequestReviewersAdd(c)
+}
+
+// PostPullRequestReviewersRemove operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReviewersRemove(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPullRequestReviewersRemove(c)
+}
+
 // GetStatsAssignments operation middleware
 func (siw *ServerInterfaceWrapper) GetStatsAssignments(c *gin.Context) {
 
@@ -136,6 +379,19 @@ func (siw *ServerInterfaceWrapper) PostTeamAdd(c *gin.Context) {
 	siw.Handler.PostTeamAdd(c)
 }
 
+// PostTeamDelete operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamDelete(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTeamDelete(c)
+}
+
 // GetTeamGet operation middleware
 func (siw *ServerInterfaceWrapper) GetTeamGet(c *gin.Context) {
 
@@ -169,8 +425,29 @@ func (siw *ServerInterfaceWrapper) GetTeamGet(c *gin.Context) {
 	siw.Handler.GetTeamGet(c, params)
 }
 
-// PostUsersDeactivateTeam operation middleware
-func (siw *ServerInterfaceWrapper) PostUsersDeactivateTeam(c *gin.Context) {
+// GetTeamList operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamList(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamListParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -179,29 +456,81 @@ func (siw *ServerInterfaceWrapper) PostUsersDeactivateTeam(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostUsersDeactivateTeam(c)
+	siw.Handler.GetTeamList(c, params)
 }
 
-// GetUsersGetReview operation middleware
-func (siw *ServerInterfaceWrapper) GetUsersGetReview(c *gin.Context) {
+// PostTeamMembersAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamMembersAdd(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTeamMembersAdd(c)
+}
+
+// PostTeamMembersMove operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamMembersMove(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTeamMembersMove(c)
+}
+
+// PostTeamMembersRemove operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamMembersRemove(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTeamMembersRemove(c)
+}
+
+// PostTeamRename operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamRename(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTeamRename(c)
+}
+
+// GetTeamSettings operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamSettings(c *gin.Context) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetUsersGetReviewParams
+	var params GetTeamSettingsParams
 
-	// ------------- Required query parameter "user_id" -------------
+	// ------------- Required query parameter "team_name" -------------
 
-	if paramValue := c.Query("user_id"); paramValue != "" {
+	if paramValue := c.Query("team_name"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument user_id is required, but not found"), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Query argument team_name is required, but not found"), http.StatusBadRequest)
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "user_id", c.Request.URL.Query(), &params.UserId)
+	err = runtime.BindQueryParameter("form", true, true, "team_name", c.Request.URL.Query(), &params.TeamName)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter team_name: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -212,11 +541,11 @@ func (siw *ServerInterfaceWrapper) GetUsersGetReview(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetUsersGetReview(c, params)
+	siw.Handler.GetTeamSettings(c, params)
 }
 
-// PostUsersSetIsActive operation middleware
-func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(c *gin.Context) {
+// PostTeamSettings operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSettings(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -225,7 +554,448 @@ func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostUsersSetIsActive(c)
+	siw.Handler.PostTeamSettings(c)
+}
+
+// PutTeamSync operation middleware
+func (siw *ServerInterfaceWrapper) PutTeamSync(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutTeamSync(c)
+}
+
+// PostUsersDeactivateTeam operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersDeactivateTeam(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUsersDeactivateTeam(c)
+}
+
+// PostUsersDelete operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersDelete(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUsersDelete(c)
+}
+
+// GetUsersGetAuthored operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersGetAuthored(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersGetAuthoredParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := c.Query("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument user_id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", c.Request.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "created_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_after", c.Request.URL.Query(), &params.CreatedAfter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter created_after: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "created_before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_before", c.Request.URL.Query(), &params.CreatedBefore)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter created_before: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUsersGetAuthored(c, params)
+}
+
+// GetUsersGetReview operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersGetReview(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersGetReviewParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := c.Query("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument user_id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", c.Request.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "created_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_after", c.Request.URL.Query(), &params.CreatedAfter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter created_after: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "created_before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_before", c.Request.URL.Query(), &params.CreatedBefore)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter created_before: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUsersGetReview(c, params)
+}
+
+// GetUsersList operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersList(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersListParams
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", c.Request.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter team_name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "is_active" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "is_active", c.Request.URL.Query(), &params.IsActive)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter is_active: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUsersList(c, params)
+}
+
+// PostUsersSetIsActive operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUsersSetIsActive(c)
+}
+
+// PostUsersSetPrimaryTeam operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetPrimaryTeam(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUsersSetPrimaryTeam(c)
+}
+
+// GetUsersSkills operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersSkills(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersSkillsParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := c.Query("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument user_id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", c.Request.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUsersSkills(c, params)
+}
+
+// PostUsersSkillsAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSkillsAdd(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUsersSkillsAdd(c)
+}
+
+// PostUsersSkillsRemove operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSkillsRemove(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUsersSkillsRemove(c)
+}
+
+// GetUsersTeams operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersTeams(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersTeamsParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := c.Query("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument user_id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", c.Request.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUsersTeams(c, params)
+}
+
+// GetUsersUnavailability operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersUnavailability(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersUnavailabilityParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := c.Query("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument user_id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", c.Request.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUsersUnavailability(c, params)
+}
+
+// PostUsersUnavailabilityAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersUnavailabilityAdd(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUsersUnavailabilityAdd(c)
+}
+
+// PostUsersUnavailabilityRemove operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersUnavailabilityRemove(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUsersUnavailabilityRemove(c)
+}
+
+// PostUsersUpdate operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersUpdate(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUsersUpdate(c)
 }
 
 // GinServerOptions provides options for the Gin server.
@@ -255,13 +1025,44 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 		ErrorHandler:       errorHandler,
 	}
 
+	router.POST(options.BaseURL+"/codeOwners/add", wrapper.PostCodeOwnersAdd)
+	router.GET(options.BaseURL+"/codeOwners/list", wrapper.GetCodeOwnersList)
+	router.POST(options.BaseURL+"/codeOwners/remove", wrapper.PostCodeOwnersRemove)
+	router.POST(options.BaseURL+"/pullRequest/close", wrapper.PostPullRequestClose)
 	router.POST(options.BaseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
+	router.GET(options.BaseURL+"/pullRequest/get", wrapper.GetPullRequestGet)
 	router.POST(options.BaseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
+	router.POST(options.BaseURL+"/pullRequest/ready", wrapper.PostPullRequestReady)
 	router.POST(options.BaseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
+	router.POST(options.BaseURL+"/pullRequest/reopen", wrapper.PostPullRequestReopen)
+	router.POST(options.BaseURL+"/pullRequest/review", wrapper.PostPullRequestReview)
+	router.POST(options.BaseURL+"/pullRequest/reviewers/add", wrapper.PostPullRequestReviewersAdd)
+	router.POST(options.BaseURL+"/pullRequest/reviewers/remove", wrapper.PostPullRequestReviewersRemove)
 	router.GET(options.BaseURL+"/stats/assignments", wrapper.GetStatsAssignments)
 	router.POST(options.BaseURL+"/team/add", wrapper.PostTeamAdd)
+	router.POST(options.BaseURL+"/team/delete", wrapper.PostTeamDelete)
 	router.GET(options.BaseURL+"/team/get", wrapper.GetTeamGet)
+	router.GET(options.BaseURL+"/team/list", wrapper.GetTeamList)
+	router.POST(options.BaseURL+"/team/members/add", wrapper.PostTeamMembersAdd)
+	router.POST(options.BaseURL+"/team/members/move", wrapper.PostTeamMembersMove)
+	router.POST(options.BaseURL+"/team/members/remove", wrapper.PostTeamMembersRemove)
+	router.POST(options.BaseURL+"/team/rename", wrapper.PostTeamRename)
+	router.GET(options.BaseURL+"/team/settings", wrapper.GetTeamSettings)
+	router.POST(options.BaseURL+"/team/settings", wrapper.PostTeamSettings)
+	router.PUT(options.BaseURL+"/team/sync", wrapper.PutTeamSync)
 	router.POST(options.BaseURL+"/users/deactivateTeam", wrapper.PostUsersDeactivateTeam)
+	router.POST(options.BaseURL+"/users/delete", wrapper.PostUsersDelete)
+	router.GET(options.BaseURL+"/users/getAuthored", wrapper.GetUsersGetAuthored)
 	router.GET(options.BaseURL+"/users/getReview", wrapper.GetUsersGetReview)
+	router.GET(options.BaseURL+"/users/list", wrapper.GetUsersList)
 	router.POST(options.BaseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
+	router.POST(options.BaseURL+"/users/setPrimaryTeam", wrapper.PostUsersSetPrimaryTeam)
+	router.GET(options.BaseURL+"/users/skills", wrapper.GetUsersSkills)
+	router.POST(options.BaseURL+"/users/skills/add", wrapper.PostUsersSkillsAdd)
+	router.POST(options.BaseURL+"/users/skills/remove", wrapper.PostUsersSkillsRemove)
+	router.GET(options.BaseURL+"/users/teams", wrapper.GetUsersTeams)
+	router.GET(options.BaseURL+"/users/unavailability", wrapper.GetUsersUnavailability)
+	router.POST(options.BaseURL+"/users/unavailability/add", wrapper.PostUsersUnavailabilityAdd)
+	router.POST(options.BaseURL+"/users/unavailability/remove", wrapper.PostUsersUnavailabilityRemove)
+	router.POST(options.BaseURL+"/users/update", wrapper.PostUsersUpdate)
 }
@@ -1,11 +1,13 @@
 package controllers
 
 import (
+	"errors"
 	"net/http"
 
 	"app/internal/controllers/gen"
 	"app/internal/domain"
 	"app/internal/mapper"
+	"app/internal/usecase/errs"
 	"app/internal/usecase/pr_usecase"
 
 	"github.com/gin-gonic/gin"
@@ -14,8 +16,16 @@ import (
 type PullRequestController interface {
 	PostPullRequestCreate(c *gin.Context)
 	PostPullRequestMerge(c *gin.Context)
+	PostPullRequestClose(c *gin.Context)
+	PostPullRequestReopen(c *gin.Context)
+	PostPullRequestReady(c *gin.Context)
+	PostPullRequestReview(c *gin.Context)
+	PostPullRequestReviewersAdd(c *gin.Context)
+	PostPullRequestReviewersRemove(c *gin.Context)
 	PostPullRequestReassign(c *gin.Context)
+	GetPullRequestGet(c *gin.Context, params gen.GetPullRequestGetParams)
 	GetUsersGetReview(c *gin.Context, params gen.GetUsersGetReviewParams)
+	GetUsersGetAuthored(c *gin.Context, params gen.GetUsersGetAuthoredParams)
 }
 
 type pullRequestController struct {
@@ -36,9 +46,30 @@ func (s *pullRequestController) PostPullRequestCreate(c *gin.Context) {
 		return
 	}
 
+	opts := domain.CreatePullRequestOptions{
+		Draft: req.Draft != nil && *req.Draft,
+	}
+	if req.ChangedFiles != nil {
+		opts.ChangedFiles = *req.ChangedFiles
+	}
+	if req.RequiredSkills != nil {
+		opts.RequiredSkills = *req.RequiredSkills
+	}
+	if req.TeamName != nil {
+		opts.TeamName = *req.TeamName
+	}
+
 	pr, err := s.pullRequestUseCase.CreatePR(c.Request.Context(), domain.UserID(req.AuthorId),
-					domain.PRID(req.PullRequestId), req.PullRequestName)
+					domain.PRID(req.PullRequestId), req.PullRequestName, opts)
 	if err != nil {
+		if errors.Is(err, errs.ErrInvalidSkill) || errors.Is(err, errs.ErrAuthorNotInTeam) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if errors.Is(err, errs.ErrUserNotFound) || errors.Is(err, errs.ErrTeamNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
 		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
 		return
 	}
@@ -55,13 +86,158 @@ func (s *pullRequestController) PostPullRequestMerge(c *gin.Context) {
 		return
 	}
 
-	err := s.pullRequestUseCase.MergePR(c.Request.Context(), domain.PRID(req.PullRequestId))
+	var mergedBy *domain.UserID
+	if req.MergedBy != nil {
+		userID := domain.UserID(*req.MergedBy)
+		mergedBy = &userID
+	}
+
+	pr, err := s.pullRequestUseCase.MergePR(c.Request.Context(), domain.PRID(req.PullRequestId), mergedBy)
+	if err != nil {
+		var policyErr *errs.MergePolicyError
+		if errors.As(err, &policyErr) {
+			c.JSON(http.StatusConflict, gin.H{
+				"error":            err.Error(),
+				"unmet_conditions": mapper.UnmetMergeConditionsToDTO(policyErr.Unmet),
+			})
+			return
+		}
+		if errors.Is(err, errs.ErrPullRequestClosed) || errors.Is(err, errs.ErrInvalidPullRequestTransition) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"pr": mapper.DomainPullRequestToDTO(*pr)})
+}
+
+func (s *pullRequestController) PostPullRequestClose(c *gin.Context) {
+	var req gen.PostPullRequestCloseJSONBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	pr, err := s.pullRequestUseCase.ClosePR(c.Request.Context(), domain.PRID(req.PullRequestId))
 	if err != nil {
+		if errors.Is(err, errs.ErrInvalidPullRequestTransition) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
 		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"pr": req.PullRequestId})
+	c.JSON(http.StatusOK, gin.H{"pr": mapper.DomainPullRequestToDTO(*pr)})
+}
+
+func (s *pullRequestController) PostPullRequestReopen(c *gin.Context) {
+	var req gen.PostPullRequestReopenJSONBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	reselect := req.ReselectReviewers != nil && *req.ReselectReviewers
+
+	pr, err := s.pullRequestUseCase.ReopenPR(c.Request.Context(), domain.PRID(req.PullRequestId), reselect)
+	if err != nil {
+		if errors.Is(err, errs.ErrInvalidPullRequestTransition) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"pr": mapper.DomainPullRequestToDTO(*pr)})
+}
+
+func (s *pullRequestController) PostPullRequestReady(c *gin.Context) {
+	var req gen.PostPullRequestReadyJSONBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	pr, err := s.pullRequestUseCase.ReadyPR(c.Request.Context(), domain.PRID(req.PullRequestId))
+	if err != nil {
+		if errors.Is(err, errs.ErrInvalidPullRequestTransition) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"pr": mapper.DomainPullRequestToDTO(*pr)})
+}
+
+func (s *pullRequestController) PostPullRequestReview(c *gin.Context) {
+	var req gen.PostPullRequestReviewJSONBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	pr, err := s.pullRequestUseCase.ReviewPR(c.Request.Context(), domain.PRID(req.PullRequestId),
+		domain.UserID(req.ReviewerId), domain.ReviewVerdict(req.Verdict))
+	if err != nil {
+		if errors.Is(err, errs.ErrInvalidReviewVerdict) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if errors.Is(err, errs.ErrPullRequestNotOpen) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"pr": mapper.DomainPullRequestToDetailDTO(*pr)})
+}
+
+func (s *pullRequestController) PostPullRequestReviewersAdd(c *gin.Context) {
+	var req gen.PostPullRequestReviewersAddJSONBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	pr, err := s.pullRequestUseCase.AddReviewer(c.Request.Context(), domain.PRID(req.PullRequestId), domain.UserID(req.ReviewerId))
+	if err != nil {
+		if errors.Is(err, errs.ErrPullRequestNotFound) || errors.Is(err, errs.ErrUserNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"pr": mapper.DomainPullRequestToDetailDTO(*pr)})
+}
+
+func (s *pullRequestController) PostPullRequestReviewersRemove(c *gin.Context) {
+	var req gen.PostPullRequestReviewersRemoveJSONBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	pr, err := s.pullRequestUseCase.RemoveReviewer(c.Request.Context(), domain.PRID(req.PullRequestId), domain.UserID(req.ReviewerId))
+	if err != nil {
+		if errors.Is(err, errs.ErrPullRequestNotOpen) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"pr": mapper.DomainPullRequestToDetailDTO(*pr)})
 }
 
 func (s *pullRequestController) PostPullRequestReassign(c *gin.Context) {
@@ -71,24 +247,86 @@ func (s *pullRequestController) PostPullRequestReassign(c *gin.Context) {
 		return
 	}
 
-	err := s.pullRequestUseCase.ReassignReviewer(c.Request.Context(), domain.PRID(req.PullRequestId), domain.UserID(req.OldUserId))
+	var newReviewerID *domain.UserID
+	if req.NewReviewerId != nil {
+		userID := domain.UserID(*req.NewReviewerId)
+		newReviewerID = &userID
+	}
+
+	pr, replacedBy, err := s.pullRequestUseCase.ReassignReviewer(c.Request.Context(), domain.PRID(req.PullRequestId),
+		domain.UserID(req.OldUserId), newReviewerID)
 	if err != nil {
+		if errors.Is(err, errs.ErrPullRequestNotFound) || errors.Is(err, errs.ErrUserNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
 		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
 		return
 	}
 
-	c.JSON(http.StatusOK, nil)
+	c.JSON(http.StatusOK, gin.H{"pr": mapper.DomainPullRequestToDTO(*pr), "replaced_by": replacedBy})
+}
+
+func (s *pullRequestController) GetPullRequestGet(c *gin.Context, params gen.GetPullRequestGetParams) {
+	pr, err := s.pullRequestUseCase.GetPRByID(c.Request.Context(), domain.PRID(params.PullRequestId))
+	if err != nil {
+		if errors.Is(err, errs.ErrInvalidPullRequestID) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"pr": mapper.DomainPullRequestToDetailDTO(*pr)})
 }
 
 func (s *pullRequestController) GetUsersGetReview(c *gin.Context, params gen.GetUsersGetReviewParams) {
-	prs, err := s.pullRequestUseCase.GetPRByUserID(c.Request.Context(), domain.UserID(params.UserId))
+	filter, err := mapper.DTOPullRequestFilterToDomain(params.Limit, params.Cursor, params.Status,
+		params.CreatedAfter, params.CreatedBefore, params.Sort)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	page, err := s.pullRequestUseCase.GetPRByUserID(c.Request.Context(), domain.UserID(params.UserId), filter)
+	if err != nil {
+		if errors.Is(err, errs.ErrInvalidPullRequestFilter) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"user_id":       params.UserId,
+		"pull_requests": mapper.DomainPullRequestsToDTOs(page.PullRequests),
+		"next_cursor":   mapper.EncodeNextPageCursor(page.NextCursor),
+	})
+}
+
+func (s *pullRequestController) GetUsersGetAuthored(c *gin.Context, params gen.GetUsersGetAuthoredParams) {
+	filter, err := mapper.DTOPullRequestFilterToDomain(params.Limit, params.Cursor, params.Status,
+		params.CreatedAfter, params.CreatedBefore, params.Sort)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	page, err := s.pullRequestUseCase.GetPRByAuthorID(c.Request.Context(), domain.UserID(params.UserId), filter)
 	if err != nil {
+		if errors.Is(err, errs.ErrInvalidPullRequestFilter) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
 		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"user_id":       params.UserId,
-		"pull_requests": mapper.DomainPullRequestsToDTOs(prs),
+		"pull_requests": mapper.DomainPullRequestsToDTOs(page.PullRequests),
+		"next_cursor":   mapper.EncodeNextPageCursor(page.NextCursor),
 	})
 }
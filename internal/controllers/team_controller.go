@@ -1,11 +1,13 @@
 package controllers
 
 import (
+	"errors"
 	"net/http"
 
 	"app/internal/controllers/gen"
 	"app/internal/domain"
 	"app/internal/mapper"
+	"app/internal/usecase/errs"
 	"app/internal/usecase/team_usecase"
 
 	"github.com/gin-gonic/gin"
@@ -14,6 +16,15 @@ import (
 type TeamController interface {
 	PostTeamAdd(c *gin.Context)
 	GetTeamGet(c *gin.Context, params gen.GetTeamGetParams)
+	GetTeamSettings(c *gin.Context, params gen.GetTeamSettingsParams)
+	PostTeamSettings(c *gin.Context)
+	PostTeamMembersAdd(c *gin.Context)
+	PostTeamMembersRemove(c *gin.Context)
+	PostTeamMembersMove(c *gin.Context)
+	GetTeamList(c *gin.Context, params gen.GetTeamListParams)
+	PostTeamRename(c *gin.Context)
+	PostTeamDelete(c *gin.Context)
+	PutTeamSync(c *gin.Context)
 }
 
 type teamController struct {
@@ -61,3 +72,222 @@ func (s *teamController) PostTeamAdd(c *gin.Context) {
 
 	c.JSON(http.StatusCreated, gin.H{"team": mapper.DomainTeamToDTO(*team)})	
 }
+
+func (s *teamController) GetTeamSettings(c *gin.Context, params gen.GetTeamSettingsParams) {
+	team, err := s.teamUseCase.GetTeamByName(c.Request.Context(), params.TeamName)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"settings": mapper.DomainTeamSettingsToDTO(*team)})
+}
+
+func (s *teamController) PostTeamSettings(c *gin.Context) {
+	var req gen.PostTeamSettingsJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	team, err := s.teamUseCase.UpdateTeamSettings(c.Request.Context(), req.TeamName, mapper.DTOTeamSettingsToDomainUpdate(req))
+	if err != nil {
+		if errors.Is(err, errs.ErrTeamNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		if errors.Is(err, errs.ErrInvalidTeamName) || errors.Is(err, errs.ErrInvalidTeamSettings) ||
+			errors.Is(err, errs.ErrFallbackTeamNotFound) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"settings": mapper.DomainTeamSettingsToDTO(*team)})
+}
+
+func (s *teamController) PostTeamMembersAdd(c *gin.Context) {
+	var req gen.PostTeamMembersAddJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	member := domain.TeamUser{ID: domain.UserID(req.UserId)}
+	if req.Username != nil {
+		member.Name = *req.Username
+	}
+
+	team, err := s.teamUseCase.AddTeamMember(c.Request.Context(), req.TeamName, member)
+	if err != nil {
+		if errors.Is(err, errs.ErrTeamNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		if errors.Is(err, errs.ErrUserAlreadyInTeam) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"team": mapper.DomainTeamToDTO(*team)})
+}
+
+func (s *teamController) PostTeamMembersRemove(c *gin.Context) {
+	var req gen.PostTeamMembersRemoveJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	report, err := s.teamUseCase.RemoveTeamMember(c.Request.Context(), req.TeamName, domain.UserID(req.UserId))
+	if err != nil {
+		if errors.Is(err, errs.ErrTeamNotFound) || errors.Is(err, errs.ErrUserNotInTeam) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"team_name":    req.TeamName,
+		"user_id":      req.UserId,
+		"reassignment": mapper.DomainReassignmentReportToDTO(*report),
+	})
+}
+
+func (s *teamController) PostTeamMembersMove(c *gin.Context) {
+	var req gen.PostTeamMembersMoveJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	team, report, err := s.teamUseCase.MoveTeamMember(c.Request.Context(), domain.UserID(req.UserId), req.TeamName)
+	if err != nil {
+		if errors.Is(err, errs.ErrTeamNotFound) || errors.Is(err, errs.ErrUserHasNoTeam) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		if errors.Is(err, errs.ErrUserAlreadyInTeam) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"team":         mapper.DomainTeamToDTO(*team),
+		"reassignment": mapper.DomainReassignmentReportToDTO(*report),
+	})
+}
+
+func (s *teamController) GetTeamList(c *gin.Context, params gen.GetTeamListParams) {
+	filter, err := mapper.DTOTeamFilterToDomain(params.Limit, params.Cursor)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	page, err := s.teamUseCase.ListTeams(c.Request.Context(), filter)
+	if err != nil {
+		if errors.Is(err, errs.ErrInvalidTeamFilter) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"teams":       mapper.DomainTeamSummariesToDTOs(page.Teams),
+		"next_cursor": mapper.EncodeNextTeamPageCursor(page.NextCursor),
+	})
+}
+
+func (s *teamController) PostTeamRename(c *gin.Context) {
+	var req gen.PostTeamRenameJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	team, err := s.teamUseCase.RenameTeam(c.Request.Context(), req.TeamName, req.NewTeamName)
+	if err != nil {
+		if errors.Is(err, errs.ErrTeamNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		if errors.Is(err, errs.ErrTeamAlreadyExists) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"team": mapper.DomainTeamToDTO(*team)})
+}
+
+func (s *teamController) PostTeamDelete(c *gin.Context) {
+	var req gen.PostTeamDeleteJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	closeOpenPRs := req.CloseOpenPrs != nil && *req.CloseOpenPrs
+
+	closed, err := s.teamUseCase.DeleteTeam(c.Request.Context(), req.TeamName, closeOpenPRs)
+	if err != nil {
+		if errors.Is(err, errs.ErrTeamNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		if errors.Is(err, errs.ErrTeamHasOpenPullRequests) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	closedIDs := make([]string, 0, len(closed))
+	for _, prID := range closed {
+		closedIDs = append(closedIDs, prID.String())
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"team_name":               req.TeamName,
+		"closed_pull_request_ids": closedIDs,
+	})
+}
+
+func (s *teamController) PutTeamSync(c *gin.Context) {
+	var req gen.PutTeamSyncJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	dryRun := req.DryRun != nil && *req.DryRun
+
+	report, err := s.teamUseCase.SyncTeam(c.Request.Context(), req.TeamName, mapper.DTOTeamMembersToDomain(req.Members), dryRun)
+	if err != nil {
+		if errors.Is(err, errs.ErrInvalidTeamName) || errors.Is(err, errs.ErrNoUsersProvided) ||
+			errors.Is(err, errs.ErrInvalidUserID) || errors.Is(err, errs.ErrDuplicateTeamMember) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"sync": mapper.DomainTeamSyncReportToDTO(*report)})
+}
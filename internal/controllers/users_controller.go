@@ -1,10 +1,13 @@
 package controllers
 
 import (
+	"errors"
 	"net/http"
 
 	"app/internal/controllers/gen"
 	"app/internal/domain"
+	"app/internal/mapper"
+	"app/internal/usecase/errs"
 	"app/internal/usecase/user_usecase"
 
 	"github.com/gin-gonic/gin"
@@ -13,6 +16,14 @@ import (
 type UserController interface {
 	PostUsersSetIsActive(c *gin.Context)
 	PostUsersDeactivateTeam(c *gin.Context)
+	GetUsersSkills(c *gin.Context, params gen.GetUsersSkillsParams)
+	PostUsersSkillsAdd(c *gin.Context)
+	PostUsersSkillsRemove(c *gin.Context)
+	GetUsersTeams(c *gin.Context, params gen.GetUsersTeamsParams)
+	PostUsersSetPrimaryTeam(c *gin.Context)
+	GetUsersList(c *gin.Context, params gen.GetUsersListParams)
+	PostUsersUpdate(c *gin.Context)
+	PostUsersDelete(c *gin.Context)
 }
 
 type userController struct {
@@ -26,13 +37,16 @@ func (s *userController) PostUsersDeactivateTeam(c *gin.Context) {
 		return
 	}
 
-	err := s.userUseCase.DeactivateUsersByTeamName(c.Request.Context(), req.TeamName)
+	report, err := s.userUseCase.DeactivateUsersByTeamName(c.Request.Context(), req.TeamName)
 	if err != nil {
 		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"message": "Users deactivated successfully"})
+	c.JSON(http.StatusOK, gin.H{
+		"message":      "Users deactivated successfully",
+		"reassignment": mapper.DomainReassignmentReportToDTO(*report),
+	})
 }
 
 func NewUserController(userUseCase user_usecase.UserUseCase) UserController {
@@ -48,11 +62,172 @@ func (s *userController) PostUsersSetIsActive(c *gin.Context) {
 		return
 	}
 
-	user, err := s.userUseCase.UpdateUserActivity(c.Request.Context(), domain.UserID(req.UserId), domain.UserActivityStatus(req.IsActive))
+	user, report, err := s.userUseCase.UpdateUserActivity(c.Request.Context(), domain.UserID(req.UserId), domain.UserActivityStatus(req.IsActive))
 	if err != nil {
 		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
 		return
 	}
 
-	c.JSON(http.StatusCreated, gin.H{"user": user})
+	c.JSON(http.StatusCreated, gin.H{
+		"user":         user,
+		"reassignment": mapper.DomainReassignmentReportToDTO(*report),
+	})
+}
+
+func (s *userController) GetUsersSkills(c *gin.Context, params gen.GetUsersSkillsParams) {
+	skills, err := s.userUseCase.GetUserSkills(c.Request.Context(), domain.UserID(params.UserId))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gen.UserSkills{UserId: params.UserId, Skills: skills})
+}
+
+func (s *userController) PostUsersSkillsAdd(c *gin.Context) {
+	var req gen.PostUsersSkillsAddJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	skills, err := s.userUseCase.AddUserSkills(c.Request.Context(), domain.UserID(req.UserId), req.Skills)
+	if err != nil {
+		if errors.Is(err, errs.ErrUserNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gen.UserSkills{UserId: req.UserId, Skills: skills})
+}
+
+func (s *userController) PostUsersSkillsRemove(c *gin.Context) {
+	var req gen.PostUsersSkillsRemoveJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	skills, err := s.userUseCase.RemoveUserSkills(c.Request.Context(), domain.UserID(req.UserId), req.Skills)
+	if err != nil {
+		if errors.Is(err, errs.ErrUserNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gen.UserSkills{UserId: req.UserId, Skills: skills})
+}
+
+func (s *userController) GetUsersTeams(c *gin.Context, params gen.GetUsersTeamsParams) {
+	teams, err := s.userUseCase.GetUserTeams(c.Request.Context(), domain.UserID(params.UserId))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gen.UserTeams{UserId: params.UserId, Teams: mapper.DomainTeamMembershipsToDTOs(teams)})
+}
+
+func (s *userController) PostUsersSetPrimaryTeam(c *gin.Context) {
+	var req gen.PostUsersSetPrimaryTeamJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	teams, err := s.userUseCase.SetPrimaryTeam(c.Request.Context(), domain.UserID(req.UserId), req.TeamName)
+	if err != nil {
+		if errors.Is(err, errs.ErrTeamNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		if errors.Is(err, errs.ErrUserNotInTeam) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gen.UserTeams{UserId: req.UserId, Teams: mapper.DomainTeamMembershipsToDTOs(teams)})
+}
+
+func (s *userController) GetUsersList(c *gin.Context, params gen.GetUsersListParams) {
+	filter, err := mapper.DTOUserFilterToDomain(params.TeamName, params.IsActive, params.Limit, params.Cursor)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	page, err := s.userUseCase.ListUsers(c.Request.Context(), filter)
+	if err != nil {
+		if errors.Is(err, errs.ErrInvalidUserFilter) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if errors.Is(err, errs.ErrTeamNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"users":       mapper.DomainTeamMembersToDTO(page.Users),
+		"next_cursor": mapper.EncodeNextUserPageCursor(page.NextCursor),
+	})
+}
+
+func (s *userController) PostUsersUpdate(c *gin.Context) {
+	var req gen.PostUsersUpdateJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	user, err := s.userUseCase.UpdateUserName(c.Request.Context(), domain.UserID(req.UserId), req.Username)
+	if err != nil {
+		if errors.Is(err, errs.ErrUserNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"user": gen.TeamMember{
+		UserId:   user.ID.String(),
+		Username: user.Name,
+		IsActive: user.IsActive.IsActive(),
+	}})
+}
+
+func (s *userController) PostUsersDelete(c *gin.Context) {
+	var req gen.PostUsersDeleteJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	report, err := s.userUseCase.DeleteUser(c.Request.Context(), domain.UserID(req.UserId))
+	if err != nil {
+		if errors.Is(err, errs.ErrUserNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":      "User deleted successfully",
+		"reassignment": mapper.DomainReassignmentReportToDTO(*report),
+	})
 }
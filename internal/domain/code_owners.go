@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"path"
+	"strings"
+)
+
+// ValidCodeOwnerPattern проверяет синтаксис шаблона CODEOWNERS.
+func ValidCodeOwnerPattern(pattern string) bool {
+	segments := codeOwnerSegments(pattern)
+	if len(segments) == 0 {
+		return false
+	}
+
+	for _, segment := range segments {
+		if segment == "**" {
+			continue
+		}
+		if _, err := path.Match(segment, ""); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// MatchCodeOwnerPattern сопоставляет путь файла с шаблоном по правилам CODEOWNERS:
+// ведущий "/" привязывает шаблон к корню, шаблон из одного сегмента ("*.go", "docs/") совпадает на любой глубине,
+// "**" означает любое число каталогов, а шаблон каталога покрывает всё его содержимое.
+func MatchCodeOwnerPattern(pattern string, filePath string) bool {
+	segments := codeOwnerSegments(pattern)
+	if len(segments) == 0 {
+		return false
+	}
+
+	files := strings.Split(strings.Trim(filePath, "/"), "/")
+
+	if segments[len(segments)-1] != "**" {
+		segments = append(segments, "**")
+	}
+
+	return matchSegments(segments, files)
+}
+
+func codeOwnerSegments(pattern string) []string {
+	anchored := strings.HasPrefix(pattern, "/")
+	trimmed := strings.Trim(pattern, "/")
+	if trimmed == "" {
+		return nil
+	}
+
+	segments := strings.Split(trimmed, "/")
+	if !anchored && len(segments) == 1 {
+		segments = append([]string{"**"}, segments...)
+	}
+	return segments
+}
+
+func matchSegments(pattern []string, files []string) bool {
+	if len(pattern) == 0 {
+		return len(files) == 0
+	}
+
+	if pattern[0] == "**" {
+		for i := 0; i <= len(files); i++ {
+			if matchSegments(pattern[1:], files[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(files) == 0 {
+		return false
+	}
+
+	ok, err := path.Match(pattern[0], files[0])
+	if err != nil || !ok {
+		return false
+	}
+	return matchSegments(pattern[1:], files[1:])
+}
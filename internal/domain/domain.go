@@ -20,6 +20,88 @@ type Team struct {
 	TeamName  string
 	Users     []User
 	CreatedAt time.Time
+	Settings  TeamSettings
+}
+
+type TeamSummary struct {
+	ID          TeamID
+	TeamName    string
+	CreatedAt   time.Time
+	MemberCount int
+}
+
+// TeamMembership — членство пользователя в команде; основная команда у пользователя одна.
+type TeamMembership struct {
+	TeamID    TeamID
+	TeamName  string
+	IsPrimary bool
+	JoinedAt  time.Time
+}
+
+// TeamFilter — страница списка команд; порядок — по team_name.
+type TeamFilter struct {
+	// AfterName — имя последней команды предыдущей страницы.
+	AfterName *string
+	Limit     int
+}
+
+type TeamPage struct {
+	Teams []TeamSummary
+	// NextCursor — nil на последней странице.
+	NextCursor *string
+}
+
+// UserFilter — страница списка пользователей; порядок — по id. Удалённые пользователи не попадают в список.
+type UserFilter struct {
+	TeamName *string
+	IsActive *bool
+	// AfterID — id последнего пользователя предыдущей страницы.
+	AfterID *UserID
+	Limit   int
+}
+
+type UserPage struct {
+	Users []User
+	// NextCursor — nil на последней странице.
+	NextCursor *UserID
+}
+
+type TeamSettings struct {
+	MinReviewers     int
+	MaxReviewers     int
+	ReviewerStrategy ReviewerStrategy
+	MergePolicy      MergePolicy
+	// FallbackTeams — имена команд, из которых добираются ревьюверы, если своих не хватает до MinReviewers.
+	// Порядок задаёт приоритет.
+	FallbackTeams []string
+}
+
+// TeamSettingsUpdate — изменение настроек команды. Незаданные (nil) поля сохраняют текущие значения.
+type TeamSettingsUpdate struct {
+	MinReviewers     int
+	MaxReviewers     int
+	ReviewerStrategy *ReviewerStrategy
+	MergePolicy      *MergePolicy
+	// FallbackTeams — новый список резервных команд; пустой список снимает все.
+	FallbackTeams *[]string
+}
+
+// MergePolicy — условия, которые проверяются перед мержем PR автора из команды.
+// Нулевое значение ничего не требует.
+type MergePolicy struct {
+	MinApprovals int
+	// RequireAllResponded — каждый активный назначенный ревьювер должен оставить вердикт.
+	RequireAllResponded bool
+	ForbidSelfMerge     bool
+	// MinAge отсчитывается от выхода PR из черновика.
+	MinAge time.Duration
+}
+
+type UnmetMergeCondition struct {
+	Condition MergeCondition
+	Message   string
+	// UserIDs — ревьюверы, из-за которых условие не выполнено, если применимо.
+	UserIDs []UserID
 }
 
 type PullRequest struct {
@@ -31,9 +113,105 @@ type PullRequest struct {
 	NeedMoreReviewers bool
 	CreatedAt         time.Time
 	MergedAt          *time.Time
+	MergedBy          *UserID
+	// ReadyAt — момент выхода из черновика; для PR, созданных не черновиком, совпадает с CreatedAt.
+	ReadyAt           *time.Time
+	CandidateLoads    map[UserID]int
+	// ReviewersAssignedAt — момент назначения каждого ревьювера из Reviewers.
+	ReviewersAssignedAt map[UserID]time.Time
+	// Reviews — текущий вердикт каждого ревьювера из Reviewers.
+	Reviews map[UserID]Review
+	// FallbackReviewers — ревьюверы, взятые из запасных команд, и имя такой команды.
+	FallbackReviewers map[UserID]string
+}
+
+// CreatePullRequestOptions — необязательные параметры создания PR. Нулевое значение создаёт OPEN PR
+// в основной команде автора.
+type CreatePullRequestOptions struct {
+	// Draft создаёт черновик без ревьюверов.
+	Draft bool
+	// ChangedFiles — изменённые файлы; их владельцы назначаются в первую очередь.
+	ChangedFiles []string
+	// RequiredSkills — навыки, по числу совпадений с которыми предпочитаются кандидаты.
+	RequiredSkills []string
+	// TeamName закрепляет за PR одну из команд автора.
+	TeamName string
+}
+
+// CodeOwnerRule — правило в духе CODEOWNERS: файлы, подходящие под Pattern, принадлежат
+// пользователю OwnerUserID или команде OwnerTeam; задано ровно одно из двух.
+type CodeOwnerRule struct {
+	ID          CodeOwnerID
+	Pattern     string
+	OwnerUserID *UserID
+	OwnerTeam   *string
+	CreatedAt   time.Time
+}
+
+// Unavailability — окно [StartsAt, EndsAt), в которое пользователя не назначают ревьювером (отпуск, OOO).
+// Флаг активности при этом не меняется; после EndsAt пользователь снова доступен.
+type Unavailability struct {
+	ID        UnavailabilityID
+	UserID    UserID
+	StartsAt  time.Time
+	EndsAt    time.Time
+	Reason    string
+	CreatedAt time.Time
+}
+
+type Review struct {
+	State ReviewState
+	// ReviewedAt — момент последнего вердикта; nil, пока ревьювер не ответил.
+	ReviewedAt *time.Time
+}
+
+// PageCursor указывает на последний PR предыдущей страницы; порядок — (created_at, id).
+type PageCursor struct {
+	CreatedAt time.Time
+	ID        PRID
+}
+
+type PullRequestFilter struct {
+	Status        *PRStatus
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	SortOrder     SortOrder
+	Cursor        *PageCursor
+	Limit         int
+}
+
+type PullRequestPage struct {
+	PullRequests []PullRequest
+	// NextCursor — nil на последней странице.
+	NextCursor *PageCursor
 }
 
 type UserStats struct {
 	UserID        UserID
 	AssignedCount int
 }
+
+type ReviewerReassignment struct {
+	PullRequestID PRID
+	OldReviewerID UserID
+	// NewReviewerID — nil, если свободного ревьювера не нашлось.
+	NewReviewerID *UserID
+}
+
+type ReassignmentReport struct {
+	Reassigned []ReviewerReassignment
+	Unfilled   []ReviewerReassignment
+}
+
+// TeamSyncReport — изменения состава команды, внесённые синхронизацией (при DryRun — только вычисленные).
+type TeamSyncReport struct {
+	TeamName    string
+	DryRun      bool
+	TeamCreated bool
+	// Added — новые участники команды, в том числе состоящие в других командах.
+	Added []UserID
+	// Updated — участники, у которых изменились имя или активность.
+	Updated      []UserID
+	Removed      []UserID
+	Reassignment ReassignmentReport
+}
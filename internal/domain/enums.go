@@ -9,6 +9,89 @@ func (s PRStatus) String() string {
 const (
     PRStatusOpen   PRStatus = "OPEN"
     PRStatusMerged PRStatus = "MERGED"
+    PRStatusClosed PRStatus = "CLOSED"
+    PRStatusDraft  PRStatus = "DRAFT"
+)
+
+func (s PRStatus) IsValid() bool {
+    switch s {
+    case PRStatusOpen, PRStatusMerged, PRStatusClosed, PRStatusDraft:
+        return true
+    }
+    return false
+}
+
+// CanTransitionTo описывает жизненный цикл PR: DRAFT -> OPEN | CLOSED, OPEN -> MERGED | CLOSED,
+// CLOSED -> OPEN. MERGED конечен.
+func (s PRStatus) CanTransitionTo(next PRStatus) bool {
+    switch s {
+    case PRStatusDraft:
+        return next == PRStatusOpen || next == PRStatusClosed
+    case PRStatusOpen:
+        return next == PRStatusMerged || next == PRStatusClosed
+    case PRStatusClosed:
+        return next == PRStatusOpen
+    default:
+        return false
+    }
+}
+
+type ReviewState string
+
+func (s ReviewState) String() string {
+    return string(s)
+}
+
+const (
+    ReviewStatePending          ReviewState = "PENDING"
+    ReviewStateApproved         ReviewState = "APPROVED"
+    ReviewStateChangesRequested ReviewState = "CHANGES_REQUESTED"
+)
+
+type ReviewVerdict string
+
+func (v ReviewVerdict) String() string {
+    return string(v)
+}
+
+const (
+    ReviewVerdictApprove        ReviewVerdict = "APPROVE"
+    ReviewVerdictRequestChanges ReviewVerdict = "REQUEST_CHANGES"
+    ReviewVerdictComment        ReviewVerdict = "COMMENT"
+)
+
+func (v ReviewVerdict) IsValid() bool {
+    switch v {
+    case ReviewVerdictApprove, ReviewVerdictRequestChanges, ReviewVerdictComment:
+        return true
+    }
+    return false
+}
+
+// Apply возвращает состояние ревью после вердикта. Комментарий не меняет текущее состояние.
+func (v ReviewVerdict) Apply(current ReviewState) ReviewState {
+    switch v {
+    case ReviewVerdictApprove:
+        return ReviewStateApproved
+    case ReviewVerdictRequestChanges:
+        return ReviewStateChangesRequested
+    default:
+        return current
+    }
+}
+
+type MergeCondition string
+
+func (c MergeCondition) String() string {
+    return string(c)
+}
+
+const (
+    MergeConditionMinApprovals          MergeCondition = "MIN_APPROVALS"
+    MergeConditionNoChangesRequested    MergeCondition = "NO_CHANGES_REQUESTED"
+    MergeConditionAllReviewersResponded MergeCondition = "ALL_REVIEWERS_RESPONDED"
+    MergeConditionNoSelfMerge           MergeCondition = "NO_SELF_MERGE"
+    MergeConditionMinAge                MergeCondition = "MIN_AGE"
 )
 
 type PRID string
@@ -48,3 +131,56 @@ type TeamID int64
 func (id TeamID) Int64() int64 {
     return int64(id)
 }
+
+type ReviewerStrategy string
+
+const (
+    ReviewerStrategyRandom      ReviewerStrategy = "random"
+    ReviewerStrategyRoundRobin  ReviewerStrategy = "round_robin"
+    ReviewerStrategyLeastLoaded ReviewerStrategy = "least_loaded"
+    ReviewerStrategyWeighted    ReviewerStrategy = "weighted"
+)
+
+func (s ReviewerStrategy) String() string {
+    return string(s)
+}
+
+func (s ReviewerStrategy) IsValid() bool {
+    switch s {
+    case ReviewerStrategyRandom, ReviewerStrategyRoundRobin, ReviewerStrategyLeastLoaded, ReviewerStrategyWeighted:
+        return true
+    default:
+        return false
+    }
+}
+
+type SortOrder string
+
+const (
+    SortOrderAsc  SortOrder = "asc"
+    SortOrderDesc SortOrder = "desc"
+)
+
+func (o SortOrder) String() string {
+    return string(o)
+}
+
+func (o SortOrder) IsValid() bool {
+    switch o {
+    case SortOrderAsc, SortOrderDesc:
+        return true
+    }
+    return false
+}
+
+type CodeOwnerID int64
+
+func (id CodeOwnerID) Int64() int64 {
+    return int64(id)
+}
+
+type UnavailabilityID int64
+
+func (id UnavailabilityID) Int64() int64 {
+    return int64(id)
+}
@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"strings"
+)
+
+const maxSkillLength = 64
+
+// NormalizeSkills приводит теги навыков к нижнему регистру и убирает повторы.
+// Возвращает false, если тег пустой, слишком длинный или содержит недопустимые символы.
+func NormalizeSkills(skills []string) ([]string, bool) {
+	seen := make(map[string]bool, len(skills))
+	result := make([]string, 0, len(skills))
+
+	for _, skill := range skills {
+		skill = strings.ToLower(strings.TrimSpace(skill))
+		if len(skill) == 0 || len(skill) > maxSkillLength {
+			return nil, false
+		}
+
+		for _, r := range skill {
+			if !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || strings.ContainsRune("+#._-", r)) {
+				return nil, false
+			}
+		}
+
+		if seen[skill] {
+			continue
+		}
+		seen[skill] = true
+		result = append(result, skill)
+	}
+
+	return result, true
+}
@@ -0,0 +1,136 @@
+package mapper
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"app/internal/controllers/gen"
+	"app/internal/domain"
+)
+
+var ErrInvalidPageCursor = errors.New("invalid page cursor")
+
+// Курсор непрозрачен для клиента: base64 от "<created_at RFC3339Nano>|<pull_request_id>".
+func EncodePageCursor(cursor domain.PageCursor) string {
+	raw := cursor.CreatedAt.UTC().Format(time.RFC3339Nano) + "|" + string(cursor.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func DecodePageCursor(cursor string) (*domain.PageCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidPageCursor
+	}
+
+	createdAt, id, ok := strings.Cut(string(raw), "|")
+	if !ok || len(id) == 0 {
+		return nil, ErrInvalidPageCursor
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, createdAt)
+	if err != nil {
+		return nil, ErrInvalidPageCursor
+	}
+
+	return &domain.PageCursor{CreatedAt: t, ID: domain.PRID(id)}, nil
+}
+
+func EncodeNextPageCursor(cursor *domain.PageCursor) *string {
+	if cursor == nil {
+		return nil
+	}
+	encoded := EncodePageCursor(*cursor)
+	return &encoded
+}
+
+func DTOPullRequestFilterToDomain(limit *gen.LimitQuery, cursor *gen.CursorQuery, status *gen.PRStatusQuery,
+	createdAfter *gen.CreatedAfterQuery, createdBefore *gen.CreatedBeforeQuery, sort *gen.SortOrderQuery) (domain.PullRequestFilter, error) {
+	var filter domain.PullRequestFilter
+
+	if limit != nil {
+		filter.Limit = *limit
+	}
+
+	if cursor != nil && len(*cursor) > 0 {
+		pageCursor, err := DecodePageCursor(*cursor)
+		if err != nil {
+			return filter, err
+		}
+		filter.Cursor = pageCursor
+	}
+
+	if status != nil {
+		s := domain.PRStatus(*status)
+		filter.Status = &s
+	}
+
+	filter.CreatedAfter = createdAfter
+	filter.CreatedBefore = createdBefore
+
+	if sort != nil {
+		filter.SortOrder = domain.SortOrder(*sort)
+	}
+
+	return filter, nil
+}
+
+// Курсор списка команд — base64 от имени последней команды страницы.
+func EncodeNextTeamPageCursor(cursor *string) *string {
+	if cursor == nil {
+		return nil
+	}
+	encoded := base64.RawURLEncoding.EncodeToString([]byte(*cursor))
+	return &encoded
+}
+
+func DTOTeamFilterToDomain(limit *gen.LimitQuery, cursor *gen.CursorQuery) (domain.TeamFilter, error) {
+	var filter domain.TeamFilter
+
+	if limit != nil {
+		filter.Limit = *limit
+	}
+
+	if cursor != nil && len(*cursor) > 0 {
+		raw, err := base64.RawURLEncoding.DecodeString(*cursor)
+		if err != nil || len(raw) == 0 {
+			return filter, ErrInvalidPageCursor
+		}
+		afterName := string(raw)
+		filter.AfterName = &afterName
+	}
+
+	return filter, nil
+}
+
+// Курсор списка пользователей — base64 от id последнего пользователя страницы.
+func EncodeNextUserPageCursor(cursor *domain.UserID) *string {
+	if cursor == nil {
+		return nil
+	}
+	encoded := base64.RawURLEncoding.EncodeToString([]byte(*cursor))
+	return &encoded
+}
+
+func DTOUserFilterToDomain(teamName *gen.TeamNameQuery, isActive *bool, limit *gen.LimitQuery, cursor *gen.CursorQuery) (domain.UserFilter, error) {
+	var filter domain.UserFilter
+
+	filter.TeamName = teamName
+	filter.IsActive = isActive
+
+	if limit != nil {
+		filter.Limit = *limit
+	}
+
+	if cursor != nil && len(*cursor) > 0 {
+		raw, err := base64.RawURLEncoding.DecodeString(*cursor)
+		if err != nil || len(raw) == 0 {
+			return filter, ErrInvalidPageCursor
+		}
+		afterID := domain.UserID(raw)
+		filter.AfterID = &afterID
+	}
+
+	return filter, nil
+}
@@ -11,6 +11,10 @@ func FromDomainPullRequestShortStatusToDTO(status domain.PRStatus) gen.PullReque
 		return gen.PullRequestShortStatusOPEN
 	case domain.PRStatusMerged:
 		return gen.PullRequestShortStatusMERGED
+	case domain.PRStatusClosed:
+		return gen.PullRequestShortStatusCLOSED
+	case domain.PRStatusDraft:
+		return gen.PullRequestShortStatusDRAFT
 	default:
 		return gen.PullRequestShortStatusOPEN
 	}
@@ -22,6 +26,10 @@ func FromDomainPullRequestStatusToDTO(status domain.PRStatus) gen.PullRequestSta
 		return gen.PullRequestStatusOPEN
 	case domain.PRStatusMerged:
 		return gen.PullRequestStatusMERGED
+	case domain.PRStatusClosed:
+		return gen.PullRequestStatusCLOSED
+	case domain.PRStatusDraft:
+		return gen.PullRequestStatusDRAFT
 	default:
 		return gen.PullRequestStatusOPEN
 	}
@@ -26,9 +26,10 @@ func DomainUsersToModels(users []domain.User) []models.User {
 
 func DomainToModelTeam(team domain.Team) models.Team {
 	return models.Team{
-		ID:        team.ID,
-		TeamName:  team.TeamName,
-		CreatedAt: team.CreatedAt,
+		ID:           team.ID,
+		TeamName:     team.TeamName,
+		CreatedAt:    team.CreatedAt,
+		TeamSettings: DomainToModelTeamSettings(team.Settings),
 	}
 }
 
@@ -49,6 +50,8 @@ func DomainToModelPullRequest(pr domain.PullRequest) models.PullRequest {
 		NeedMoreReviewers: pr.NeedMoreReviewers,
 		CreatedAt:         pr.CreatedAt,
 		MergedAt:          pr.MergedAt,
+		MergedBy:          pr.MergedBy,
+		ReadyAt:           pr.ReadyAt,
 	}
 }
 
@@ -92,6 +95,53 @@ func ModelToDomainTeam(team models.Team, teamUsers []models.User) domain.Team {
 		ID:       team.ID,
 		TeamName: team.TeamName,
 		Users:    domainUsers,
+		Settings: ModelToDomainTeamSettings(team.TeamSettings),
+	}
+}
+
+func ModelToDomainTeamSettings(settings models.TeamSettings) domain.TeamSettings {
+	var strategy domain.ReviewerStrategy
+	if settings.ReviewerStrategy != nil {
+		strategy = *settings.ReviewerStrategy
+	}
+
+	return domain.TeamSettings{
+		MinReviewers:     settings.MinReviewers,
+		MaxReviewers:     settings.MaxReviewers,
+		ReviewerStrategy: strategy,
+		MergePolicy:      ModelToDomainMergePolicy(settings.MergePolicy),
+	}
+}
+
+func ModelToDomainMergePolicy(policy models.MergePolicy) domain.MergePolicy {
+	return domain.MergePolicy{
+		MinApprovals:        policy.MinApprovals,
+		RequireAllResponded: policy.RequireAllResponded,
+		ForbidSelfMerge:     policy.ForbidSelfMerge,
+		MinAge:              time.Duration(policy.MinAgeSeconds) * time.Second,
+	}
+}
+
+func DomainToModelMergePolicy(policy domain.MergePolicy) models.MergePolicy {
+	return models.MergePolicy{
+		MinApprovals:        policy.MinApprovals,
+		RequireAllResponded: policy.RequireAllResponded,
+		ForbidSelfMerge:     policy.ForbidSelfMerge,
+		MinAgeSeconds:       int(policy.MinAge / time.Second),
+	}
+}
+
+func DomainToModelTeamSettings(settings domain.TeamSettings) models.TeamSettings {
+	var strategy *domain.ReviewerStrategy
+	if settings.ReviewerStrategy != "" {
+		strategy = &settings.ReviewerStrategy
+	}
+
+	return models.TeamSettings{
+		MinReviewers:     settings.MinReviewers,
+		MaxReviewers:     settings.MaxReviewers,
+		ReviewerStrategy: strategy,
+		MergePolicy:      DomainToModelMergePolicy(settings.MergePolicy),
 	}
 }
 
@@ -107,8 +157,22 @@ func ModelsToDomainTeams(teams []models.Team, teamUsersMap map[domain.TeamID][]m
 
 func ModelToDomainPullRequest(pr models.PullRequest, author models.User, reviewers []models.User) domain.PullRequest {
 	var domainReviewers []domain.User
+	var assignedAt map[domain.UserID]time.Time
+	var reviews map[domain.UserID]domain.Review
 	for _, reviewer := range reviewers {
 		domainReviewers = append(domainReviewers, ModelToDomainUser(reviewer))
+		if reviewer.AssignedAt != nil {
+			if assignedAt == nil {
+				assignedAt = make(map[domain.UserID]time.Time, len(reviewers))
+			}
+			assignedAt[reviewer.ID] = *reviewer.AssignedAt
+		}
+		if reviewer.ReviewState != "" {
+			if reviews == nil {
+				reviews = make(map[domain.UserID]domain.Review, len(reviewers))
+			}
+			reviews[reviewer.ID] = domain.Review{State: reviewer.ReviewState, ReviewedAt: reviewer.ReviewedAt}
+		}
 	}
 
 	return domain.PullRequest{
@@ -120,7 +184,27 @@ func ModelToDomainPullRequest(pr models.PullRequest, author models.User, reviewe
 		NeedMoreReviewers: pr.NeedMoreReviewers,
 		CreatedAt:         pr.CreatedAt,
 		MergedAt:          pr.MergedAt,
+		MergedBy:          pr.MergedBy,
+		ReadyAt:           pr.ReadyAt,
+		ReviewersAssignedAt: assignedAt,
+		Reviews:             reviews,
+		FallbackReviewers:   ModelsToFallbackReviewers(reviewers),
+	}
+}
+
+// ModelsToFallbackReviewers собирает ревьюверов из запасных команд с именем команды каждого.
+func ModelsToFallbackReviewers(reviewers []models.User) map[domain.UserID]string {
+	var fallbackReviewers map[domain.UserID]string
+	for _, reviewer := range reviewers {
+		if reviewer.SourceTeamID == nil {
+			continue
+		}
+		if fallbackReviewers == nil {
+			fallbackReviewers = make(map[domain.UserID]string)
+		}
+		fallbackReviewers[reviewer.ID] = reviewer.SourceTeamName
 	}
+	return fallbackReviewers
 }
 
 
@@ -160,6 +244,148 @@ func DomainTeamToDTO(team domain.Team) gen.Team {
 	}
 }
 
+func DTOTeamMembersToDomain(members []gen.TeamMember) []domain.User {
+	users := make([]domain.User, 0, len(members))
+	for _, member := range members {
+		users = append(users, domain.User{
+			ID:       domain.UserID(member.UserId),
+			Name:     member.Username,
+			IsActive: domain.UserActivityStatus(member.IsActive),
+		})
+	}
+	return users
+}
+
+func DomainTeamSyncReportToDTO(report domain.TeamSyncReport) gen.TeamSyncReport {
+	return gen.TeamSyncReport{
+		TeamName:     report.TeamName,
+		DryRun:       report.DryRun,
+		TeamCreated:  report.TeamCreated,
+		Added:        domainUserIDsToStrings(report.Added),
+		Updated:      domainUserIDsToStrings(report.Updated),
+		Removed:      domainUserIDsToStrings(report.Removed),
+		Reassignment: DomainReassignmentReportToDTO(report.Reassignment),
+	}
+}
+
+func domainUserIDsToStrings(userIDs []domain.UserID) []string {
+	ids := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		ids = append(ids, userID.String())
+	}
+	return ids
+}
+
+func DomainTeamSummariesToDTOs(teams []domain.TeamSummary) []gen.TeamSummary {
+	dtos := make([]gen.TeamSummary, 0, len(teams))
+	for _, team := range teams {
+		dtos = append(dtos, gen.TeamSummary{
+			TeamName:    team.TeamName,
+			MemberCount: team.MemberCount,
+			CreatedAt:   team.CreatedAt,
+		})
+	}
+	return dtos
+}
+
+func ModelsToDomainTeamMemberships(memberships []models.TeamMembership) []domain.TeamMembership {
+	result := make([]domain.TeamMembership, 0, len(memberships))
+	for _, membership := range memberships {
+		result = append(result, domain.TeamMembership{
+			TeamID:    membership.TeamID,
+			TeamName:  membership.TeamName,
+			IsPrimary: membership.IsPrimary,
+			JoinedAt:  membership.JoinedAt,
+		})
+	}
+	return result
+}
+
+func DomainTeamMembershipsToDTOs(memberships []domain.TeamMembership) []gen.TeamMembership {
+	dtos := make([]gen.TeamMembership, 0, len(memberships))
+	for _, membership := range memberships {
+		dtos = append(dtos, gen.TeamMembership{
+			TeamName:  membership.TeamName,
+			IsPrimary: membership.IsPrimary,
+			JoinedAt:  membership.JoinedAt,
+		})
+	}
+	return dtos
+}
+
+func DomainTeamSettingsToDTO(team domain.Team) gen.TeamSettings {
+	var strategy *gen.TeamSettingsReviewerStrategy
+	if team.Settings.ReviewerStrategy != "" {
+		s := gen.TeamSettingsReviewerStrategy(team.Settings.ReviewerStrategy.String())
+		strategy = &s
+	}
+
+	policy := team.Settings.MergePolicy
+
+	fallbackTeams := append([]string{}, team.Settings.FallbackTeams...)
+
+	return gen.TeamSettings{
+		TeamName:         team.TeamName,
+		MinReviewers:     team.Settings.MinReviewers,
+		MaxReviewers:     team.Settings.MaxReviewers,
+		ReviewerStrategy: strategy,
+		MergePolicy: &gen.MergePolicy{
+			MinApprovals:        policy.MinApprovals,
+			RequireAllResponded: policy.RequireAllResponded,
+			ForbidSelfMerge:     policy.ForbidSelfMerge,
+			MinAgeSeconds:       int(policy.MinAge / time.Second),
+		},
+		FallbackTeams: &fallbackTeams,
+	}
+}
+
+func DTOTeamSettingsToDomainUpdate(settings gen.TeamSettings) domain.TeamSettingsUpdate {
+	var strategy *domain.ReviewerStrategy
+	if settings.ReviewerStrategy != nil {
+		s := domain.ReviewerStrategy(*settings.ReviewerStrategy)
+		strategy = &s
+	}
+
+	var policy *domain.MergePolicy
+	if settings.MergePolicy != nil {
+		policy = &domain.MergePolicy{
+			MinApprovals:        settings.MergePolicy.MinApprovals,
+			RequireAllResponded: settings.MergePolicy.RequireAllResponded,
+			ForbidSelfMerge:     settings.MergePolicy.ForbidSelfMerge,
+			MinAge:              time.Duration(settings.MergePolicy.MinAgeSeconds) * time.Second,
+		}
+	}
+
+	return domain.TeamSettingsUpdate{
+		MinReviewers:     settings.MinReviewers,
+		MaxReviewers:     settings.MaxReviewers,
+		ReviewerStrategy: strategy,
+		MergePolicy:      policy,
+		FallbackTeams:    settings.FallbackTeams,
+	}
+}
+
+func UnmetMergeConditionsToDTO(unmet []domain.UnmetMergeCondition) []gen.UnmetMergeCondition {
+	result := make([]gen.UnmetMergeCondition, 0, len(unmet))
+	for _, c := range unmet {
+		var userIDs *[]string
+		if len(c.UserIDs) > 0 {
+			ids := make([]string, 0, len(c.UserIDs))
+			for _, id := range c.UserIDs {
+				ids = append(ids, id.String())
+			}
+			userIDs = &ids
+		}
+
+		result = append(result, gen.UnmetMergeCondition{
+			Condition: gen.UnmetMergeConditionCondition(c.Condition.String()),
+			Message:   c.Message,
+			UserIds:   userIDs,
+		})
+	}
+	return result
+}
+
 func DomainTeamMembersToDTO(users []domain.User) []gen.TeamMember {
 	result := make([]gen.TeamMember, 0, len(users))
 	for _, user := range users {
@@ -188,14 +414,76 @@ func DomainPullRequestToDTO(pr domain.PullRequest) gen.PullRequest {
 		assignedReviewers = append(assignedReviewers, reviewer.ID.String())
 	}
 
+	var candidateLoads *map[string]int
+	if len(pr.CandidateLoads) > 0 {
+		loads := make(map[string]int, len(pr.CandidateLoads))
+		for userID, count := range pr.CandidateLoads {
+			loads[userID.String()] = count
+		}
+		candidateLoads = &loads
+	}
+
+	var fallbackReviewers *map[string]string
+	if len(pr.FallbackReviewers) > 0 {
+		teams := make(map[string]string, len(pr.FallbackReviewers))
+		for userID, teamName := range pr.FallbackReviewers {
+			teams[userID.String()] = teamName
+		}
+		fallbackReviewers = &teams
+	}
+
 	return gen.PullRequest{
 		PullRequestId:   pr.ID.String(),
 		PullRequestName: pr.Name,
 		AuthorId:        pr.Author.ID.String(),
 		Status:          gen.PullRequestStatus(pr.Status.String()),
 		AssignedReviewers: assignedReviewers,
+		CandidateLoads:  candidateLoads,
+		FallbackReviewers: fallbackReviewers,
+		NeedMoreReviewers: &pr.NeedMoreReviewers,
 		CreatedAt:       createdAt,
 		MergedAt:        mergedAt,
+		MergedBy:        userIDToDTO(pr.MergedBy),
+		ReadyAt:         pr.ReadyAt,
+	}
+}
+
+func userIDToDTO(id *domain.UserID) *string {
+	if id == nil {
+		return nil
+	}
+	value := id.String()
+	return &value
+}
+
+func DomainPullRequestToDetailDTO(pr domain.PullRequest) gen.PullRequestDetail {
+	reviewers := make([]gen.PullRequestReviewer, 0, len(pr.Reviewers))
+	for _, reviewer := range pr.Reviewers {
+		reviewers = append(reviewers, gen.PullRequestReviewer{
+			UserId:     reviewer.ID.String(),
+			Username:   reviewer.Name,
+			IsActive:   reviewer.IsActive.IsActive(),
+			AssignedAt: pr.ReviewersAssignedAt[reviewer.ID],
+			ReviewState: gen.PullRequestReviewerReviewState(pr.Reviews[reviewer.ID].State.String()),
+			ReviewedAt:  pr.Reviews[reviewer.ID].ReviewedAt,
+		})
+	}
+
+	return gen.PullRequestDetail{
+		PullRequestId:   pr.ID.String(),
+		PullRequestName: pr.Name,
+		Author: gen.TeamMember{
+			UserId:   pr.Author.ID.String(),
+			Username: pr.Author.Name,
+			IsActive: pr.Author.IsActive.IsActive(),
+		},
+		Status:            gen.PullRequestDetailStatus(pr.Status.String()),
+		Reviewers:         reviewers,
+		NeedMoreReviewers: pr.NeedMoreReviewers,
+		CreatedAt:         pr.CreatedAt,
+		MergedAt:          pr.MergedAt,
+		MergedBy:          userIDToDTO(pr.MergedBy),
+		ReadyAt:           pr.ReadyAt,
 	}
 }
 
@@ -223,3 +511,106 @@ func DomainUserStatsToDTOs(stats []domain.UserStats) []gen.UserAssignmentStats {
 	return result
 }
 
+func DomainReassignmentReportToDTO(report domain.ReassignmentReport) gen.ReassignmentReport {
+	return gen.ReassignmentReport{
+		Reassigned: DomainReviewerReassignmentsToDTOs(report.Reassigned),
+		Unfilled:   DomainReviewerReassignmentsToDTOs(report.Unfilled),
+	}
+}
+
+func DomainReviewerReassignmentsToDTOs(reassignments []domain.ReviewerReassignment) []gen.ReviewerReassignment {
+	result := make([]gen.ReviewerReassignment, 0, len(reassignments))
+	for _, r := range reassignments {
+		var newReviewerID *string
+		if r.NewReviewerID != nil {
+			id := r.NewReviewerID.String()
+			newReviewerID = &id
+		}
+
+		result = append(result, gen.ReviewerReassignment{
+			PullRequestId: r.PullRequestID.String(),
+			OldReviewerId: r.OldReviewerID.String(),
+			NewReviewerId: newReviewerID,
+		})
+	}
+	return result
+}
+
+func ModelToDomainCodeOwnerRule(rule models.CodeOwnerRule) domain.CodeOwnerRule {
+	return domain.CodeOwnerRule{
+		ID:          rule.ID,
+		Pattern:     rule.Pattern,
+		OwnerUserID: rule.OwnerUserID,
+		OwnerTeam:   rule.OwnerTeamName,
+		CreatedAt:   rule.CreatedAt,
+	}
+}
+
+func ModelsToDomainCodeOwnerRules(rules []models.CodeOwnerRule) []domain.CodeOwnerRule {
+	result := make([]domain.CodeOwnerRule, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, ModelToDomainCodeOwnerRule(rule))
+	}
+	return result
+}
+
+func DomainCodeOwnerRuleToDTO(rule domain.CodeOwnerRule) gen.CodeOwnerRule {
+	var userID *string
+	if rule.OwnerUserID != nil {
+		id := rule.OwnerUserID.String()
+		userID = &id
+	}
+
+	return gen.CodeOwnerRule{
+		Id:        rule.ID.Int64(),
+		Pattern:   rule.Pattern,
+		UserId:    userID,
+		TeamName:  rule.OwnerTeam,
+		CreatedAt: rule.CreatedAt,
+	}
+}
+
+func DomainCodeOwnerRulesToDTOs(rules []domain.CodeOwnerRule) []gen.CodeOwnerRule {
+	result := make([]gen.CodeOwnerRule, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, DomainCodeOwnerRuleToDTO(rule))
+	}
+	return result
+}
+
+func ModelToDomainUnavailability(window models.Unavailability) domain.Unavailability {
+	return domain.Unavailability{
+		ID:        window.ID,
+		UserID:    window.UserID,
+		StartsAt:  window.StartsAt,
+		EndsAt:    window.EndsAt,
+		Reason:    window.Reason,
+		CreatedAt: window.CreatedAt,
+	}
+}
+
+func ModelsToDomainUnavailabilities(windows []models.Unavailability) []domain.Unavailability {
+	result := make([]domain.Unavailability, 0, len(windows))
+	for _, window := range windows {
+		result = append(result, ModelToDomainUnavailability(window))
+	}
+	return result
+}
+
+func DomainUnavailabilityToDTO(window domain.Unavailability) gen.Unavailability {
+	return gen.Unavailability{
+		Id:       window.ID.Int64(),
+		UserId:   window.UserID.String(),
+		StartsAt: window.StartsAt,
+		EndsAt:   window.EndsAt,
+		Reason:   window.Reason,
+	}
+}
+
+func DomainUnavailabilitiesToDTOs(windows []domain.Unavailability) []gen.Unavailability {
+	result := make([]gen.Unavailability, 0, len(windows))
+	for _, window := range windows {
+		result = append(result, DomainUnavailabilityToDTO(window))
+	}
+	return result
+}
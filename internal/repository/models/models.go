@@ -9,12 +9,68 @@ type User struct {
 	ID        		domain.UserID
 	Name      		string     
 	StatusActivity  bool      
+	// AssignedAt, ReviewState и ReviewedAt заполняются только для ревьюверов, прочитанных из pr_reviewers.
+	AssignedAt		*time.Time
+	ReviewState		domain.ReviewState
+	ReviewedAt		*time.Time
+	// SourceTeamID и SourceTeamName заданы, если ревьювер взят из запасной команды.
+	SourceTeamID	*domain.TeamID
+	SourceTeamName	string
 }
 
 type Team struct {
 	ID        domain.TeamID
 	TeamName  string    
 	CreatedAt time.Time
+	TeamSettings
+}
+
+// TeamSummary — строка списка команд с числом участников.
+type TeamSummary struct {
+	ID          domain.TeamID
+	TeamName    string
+	CreatedAt   time.Time
+	MemberCount int
+}
+
+type TeamSettings struct {
+	MinReviewers     int
+	MaxReviewers     int
+	ReviewerStrategy *domain.ReviewerStrategy
+	MergePolicy      MergePolicy
+}
+
+type MergePolicy struct {
+	MinApprovals        int
+	RequireAllResponded bool
+	ForbidSelfMerge     bool
+	MinAgeSeconds       int
+}
+
+type CodeOwnerRule struct {
+	ID            domain.CodeOwnerID
+	Pattern       string
+	OwnerUserID   *domain.UserID
+	OwnerTeamID   *domain.TeamID
+	OwnerTeamName *string
+	CreatedAt     time.Time
+}
+
+type Unavailability struct {
+	ID        domain.UnavailabilityID
+	UserID    domain.UserID
+	StartsAt  time.Time
+	EndsAt    time.Time
+	Reason    string
+	CreatedAt time.Time
+}
+
+// TeamMembership — членство пользователя в команде.
+type TeamMembership struct {
+	TeamID    domain.TeamID
+	TeamName  string
+	IsPrimary bool
+	JoinedAt  time.Time
 }
 
 type UserTeam struct {
@@ -31,6 +87,10 @@ type PullRequest struct {
 	NeedMoreReviewers bool     
 	CreatedAt         time.Time 
 	MergedAt          *time.Time 
+	MergedBy          *domain.UserID
+	ReadyAt           *time.Time
+	// TeamID — команда, из которой выбираются ревьюверы; nil означает основную команду автора.
+	TeamID            *domain.TeamID
 }
 
 
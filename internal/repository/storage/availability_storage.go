@@ -0,0 +1,16 @@
+package storage
+
+import (
+	"app/internal/domain"
+	"app/internal/repository/models"
+	"context"
+	"time"
+)
+
+//go:generate mockgen -source=availability_storage.go -destination=mock/availability_storage_mock.go -package=mock
+type AvailabilityStorage interface {
+	CreateUnavailability(ctx context.Context, userID domain.UserID, startsAt time.Time, endsAt time.Time, reason string) (*models.Unavailability, error)
+	GetUnavailabilitiesByUserID(ctx context.Context, userID domain.UserID, endsAfter time.Time) ([]models.Unavailability, error)
+	DeleteUnavailability(ctx context.Context, userID domain.UserID, unavailabilityID domain.UnavailabilityID) error
+	GetUnavailableUserIDs(ctx context.Context, userIDs []domain.UserID, at time.Time) (map[domain.UserID]bool, error)
+}
@@ -0,0 +1,14 @@
+package storage
+
+import (
+	"app/internal/domain"
+	"app/internal/repository/models"
+	"context"
+)
+
+//go:generate mockgen -source=code_owner_storage.go -destination=mock/code_owner_storage_mock.go -package=mock
+type CodeOwnerStorage interface {
+	CreateCodeOwnerRule(ctx context.Context, pattern string, ownerUserID *domain.UserID, ownerTeamID *domain.TeamID) (*models.CodeOwnerRule, error)
+	GetCodeOwnerRules(ctx context.Context) ([]models.CodeOwnerRule, error)
+	DeleteCodeOwnerRule(ctx context.Context, ruleID domain.CodeOwnerID) error
+}
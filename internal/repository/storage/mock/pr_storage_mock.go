@@ -42,33 +42,48 @@ func (m *MockPRStorage) EXPECT() *MockPRStorageMockRecorder {
 	return m.recorder
 }
 
+// CountOpenReviewsByReviewerIDs mocks base method.
+func (m *MockPRStorage) CountOpenReviewsByReviewerIDs(ctx context.Context, reviewerIDs []domain.UserID) (map[domain.UserID]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountOpenReviewsByReviewerIDs", ctx, reviewerIDs)
+	ret0, _ := ret[0].(map[domain.UserID]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountOpenReviewsByReviewerIDs indicates an expected call of CountOpenReviewsByReviewerIDs.
+func (mr *MockPRStorageMockRecorder) CountOpenReviewsByReviewerIDs(ctx, reviewerIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountOpenReviewsByReviewerIDs", reflect.TypeOf((*MockPRStorage)(nil).CountOpenReviewsByReviewerIDs), ctx, reviewerIDs)
+}
+
 // CreatePRReviewerInstance mocks base method.
-func (m *MockPRStorage) CreatePRReviewerInstance(ctx context.Context, prID domain.PRID, reviewerID domain.UserID) error {
+func (m *MockPRStorage) CreatePRReviewerInstance(ctx context.Context, prID domain.PRID, reviewerID domain.UserID, sourceTeamID *domain.TeamID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreatePRReviewerInstance", ctx, prID, reviewerID)
+	ret := m.ctrl.Call(m, "CreatePRReviewerInstance", ctx, prID, reviewerID, sourceTeamID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreatePRReviewerInstance indicates an expected call of CreatePRReviewerInstance.
-func (mr *MockPRStorageMockRecorder) CreatePRReviewerInstance(ctx, prID, reviewerID any) *gomock.Call {
+func (mr *MockPRStorageMockRecorder) CreatePRReviewerInstance(ctx, prID, reviewerID, sourceTeamID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePRReviewerInstance", reflect.TypeOf((*MockPRStorage)(nil).CreatePRReviewerInstance), ctx, prID, reviewerID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePRReviewerInstance", reflect.TypeOf((*MockPRStorage)(nil).CreatePRReviewerInstance), ctx, prID, reviewerID, sourceTeamID)
 }
 
 // CreatePullRequest mocks base method.
-func (m *MockPRStorage) CreatePullRequest(ctx context.Context, prID domain.PRID, prName string, prAuthorID domain.UserID) (*models.PullRequest, error) {
+func (m *MockPRStorage) CreatePullRequest(ctx context.Context, prID domain.PRID, prName string, prAuthorID domain.UserID, status domain.PRStatus) (*models.PullRequest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreatePullRequest", ctx, prID, prName, prAuthorID)
+	ret := m.ctrl.Call(m, "CreatePullRequest", ctx, prID, prName, prAuthorID, status)
 	ret0, _ := ret[0].(*models.PullRequest)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreatePullRequest indicates an expected call of CreatePullRequest.
-func (mr *MockPRStorageMockRecorder) CreatePullRequest(ctx, prID, prName, prAuthorID any) *gomock.Call {
+func (mr *MockPRStorageMockRecorder) CreatePullRequest(ctx, prID, prName, prAuthorID, status any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePullRequest", reflect.TypeOf((*MockPRStorage)(nil).CreatePullRequest), ctx, prID, prName, prAuthorID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePullRequest", reflect.TypeOf((*MockPRStorage)(nil).CreatePullRequest), ctx, prID, prName, prAuthorID, status)
 }
 
 // DeletePRReviewerInstance mocks base method.
@@ -100,6 +115,22 @@ func (mr *MockPRStorageMockRecorder) GetAllOpenPullRequests(ctx any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllOpenPullRequests", reflect.TypeOf((*MockPRStorage)(nil).GetAllOpenPullRequests), ctx)
 }
 
+// GetParticipantsByPRIDs mocks base method.
+func (m *MockPRStorage) GetParticipantsByPRIDs(ctx context.Context, prIDs []domain.PRID) (map[domain.UserID]models.User, map[domain.PRID][]models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParticipantsByPRIDs", ctx, prIDs)
+	ret0, _ := ret[0].(map[domain.UserID]models.User)
+	ret1, _ := ret[1].(map[domain.PRID][]models.User)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetParticipantsByPRIDs indicates an expected call of GetParticipantsByPRIDs.
+func (mr *MockPRStorageMockRecorder) GetParticipantsByPRIDs(ctx, prIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParticipantsByPRIDs", reflect.TypeOf((*MockPRStorage)(nil).GetParticipantsByPRIDs), ctx, prIDs)
+}
+
 // GetPullRequestByID mocks base method.
 func (m *MockPRStorage) GetPullRequestByID(ctx context.Context, prID domain.PRID) (*models.PullRequest, error) {
 	m.ctrl.T.Helper()
@@ -115,19 +146,34 @@ func (mr *MockPRStorageMockRecorder) GetPullRequestByID(ctx, prID any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPullRequestByID", reflect.TypeOf((*MockPRStorage)(nil).GetPullRequestByID), ctx, prID)
 }
 
+// GetPullRequestsByAuthorID mocks base method.
+func (m *MockPRStorage) GetPullRequestsByAuthorID(ctx context.Context, authorID domain.UserID, filter domain.PullRequestFilter) ([]models.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPullRequestsByAuthorID", ctx, authorID, filter)
+	ret0, _ := ret[0].([]models.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPullRequestsByAuthorID indicates an expected call of GetPullRequestsByAuthorID.
+func (mr *MockPRStorageMockRecorder) GetPullRequestsByAuthorID(ctx, authorID, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPullRequestsByAuthorID", reflect.TypeOf((*MockPRStorage)(nil).GetPullRequestsByAuthorID), ctx, authorID, filter)
+}
+
 // GetPullRequestsByReviewerID mocks base method.
-func (m *MockPRStorage) GetPullRequestsByReviewerID(ctx context.Context, reviewerID domain.UserID) ([]models.PullRequest, error) {
+func (m *MockPRStorage) GetPullRequestsByReviewerID(ctx context.Context, reviewerID domain.UserID, filter domain.PullRequestFilter) ([]models.PullRequest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPullRequestsByReviewerID", ctx, reviewerID)
+	ret := m.ctrl.Call(m, "GetPullRequestsByReviewerID", ctx, reviewerID, filter)
 	ret0, _ := ret[0].([]models.PullRequest)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPullRequestsByReviewerID indicates an expected call of GetPullRequestsByReviewerID.
-func (mr *MockPRStorageMockRecorder) GetPullRequestsByReviewerID(ctx, reviewerID any) *gomock.Call {
+func (mr *MockPRStorageMockRecorder) GetPullRequestsByReviewerID(ctx, reviewerID, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPullRequestsByReviewerID", reflect.TypeOf((*MockPRStorage)(nil).GetPullRequestsByReviewerID), ctx, reviewerID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPullRequestsByReviewerID", reflect.TypeOf((*MockPRStorage)(nil).GetPullRequestsByReviewerID), ctx, reviewerID, filter)
 }
 
 // GetReviewersFromPR mocks base method.
@@ -145,16 +191,88 @@ func (mr *MockPRStorageMockRecorder) GetReviewersFromPR(ctx, prID any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewersFromPR", reflect.TypeOf((*MockPRStorage)(nil).GetReviewersFromPR), ctx, prID)
 }
 
+// MarkPullRequestReady mocks base method.
+func (m *MockPRStorage) MarkPullRequestReady(ctx context.Context, prID domain.PRID) (*models.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkPullRequestReady", ctx, prID)
+	ret0, _ := ret[0].(*models.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkPullRequestReady indicates an expected call of MarkPullRequestReady.
+func (mr *MockPRStorageMockRecorder) MarkPullRequestReady(ctx, prID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkPullRequestReady", reflect.TypeOf((*MockPRStorage)(nil).MarkPullRequestReady), ctx, prID)
+}
+
+// MergePullRequest mocks base method.
+func (m *MockPRStorage) MergePullRequest(ctx context.Context, prID domain.PRID, mergedBy *domain.UserID) (*models.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergePullRequest", ctx, prID, mergedBy)
+	ret0, _ := ret[0].(*models.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergePullRequest indicates an expected call of MergePullRequest.
+func (mr *MockPRStorageMockRecorder) MergePullRequest(ctx, prID, mergedBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergePullRequest", reflect.TypeOf((*MockPRStorage)(nil).MergePullRequest), ctx, prID, mergedBy)
+}
+
+// SetPullRequestTeam mocks base method.
+func (m *MockPRStorage) SetPullRequestTeam(ctx context.Context, prID domain.PRID, teamID domain.TeamID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPullRequestTeam", ctx, prID, teamID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPullRequestTeam indicates an expected call of SetPullRequestTeam.
+func (mr *MockPRStorageMockRecorder) SetPullRequestTeam(ctx, prID, teamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPullRequestTeam", reflect.TypeOf((*MockPRStorage)(nil).SetPullRequestTeam), ctx, prID, teamID)
+}
+
+// UpdateNeedMoreReviewers mocks base method.
+func (m *MockPRStorage) UpdateNeedMoreReviewers(ctx context.Context, prID domain.PRID, needMoreReviewers bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNeedMoreReviewers", ctx, prID, needMoreReviewers)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateNeedMoreReviewers indicates an expected call of UpdateNeedMoreReviewers.
+func (mr *MockPRStorageMockRecorder) UpdateNeedMoreReviewers(ctx, prID, needMoreReviewers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNeedMoreReviewers", reflect.TypeOf((*MockPRStorage)(nil).UpdateNeedMoreReviewers), ctx, prID, needMoreReviewers)
+}
+
 // UpdatePullRequestStatus mocks base method.
-func (m *MockPRStorage) UpdatePullRequestStatus(ctx context.Context, prID domain.PRID, status domain.PRStatus) error {
+func (m *MockPRStorage) UpdatePullRequestStatus(ctx context.Context, prID domain.PRID, from domain.PRStatus, to domain.PRStatus) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdatePullRequestStatus", ctx, prID, status)
+	ret := m.ctrl.Call(m, "UpdatePullRequestStatus", ctx, prID, from, to)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdatePullRequestStatus indicates an expected call of UpdatePullRequestStatus.
-func (mr *MockPRStorageMockRecorder) UpdatePullRequestStatus(ctx, prID, status any) *gomock.Call {
+func (mr *MockPRStorageMockRecorder) UpdatePullRequestStatus(ctx, prID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePullRequestStatus", reflect.TypeOf((*MockPRStorage)(nil).UpdatePullRequestStatus), ctx, prID, from, to)
+}
+
+// UpdateReviewState mocks base method.
+func (m *MockPRStorage) UpdateReviewState(ctx context.Context, prID domain.PRID, reviewerID domain.UserID, state domain.ReviewState) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReviewState", ctx, prID, reviewerID, state)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReviewState indicates an expected call of UpdateReviewState.
+func (mr *MockPRStorageMockRecorder) UpdateReviewState(ctx, prID, reviewerID, state any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePullRequestStatus", reflect.TypeOf((*MockPRStorage)(nil).UpdatePullRequestStatus), ctx, prID, status)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReviewState", reflect.TypeOf((*MockPRStorage)(nil).UpdateReviewState), ctx, prID, reviewerID, state)
 }
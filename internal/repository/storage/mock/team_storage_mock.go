@@ -71,6 +71,64 @@ func (mr *MockTeamStorageMockRecorder) CreateUserTeamInstance(ctx, teamID, userI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserTeamInstance", reflect.TypeOf((*MockTeamStorage)(nil).CreateUserTeamInstance), ctx, teamID, userID)
 }
 
+// DeleteTeam mocks base method.
+func (m *MockTeamStorage) DeleteTeam(ctx context.Context, teamID domain.TeamID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTeam", ctx, teamID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTeam indicates an expected call of DeleteTeam.
+func (mr *MockTeamStorageMockRecorder) DeleteTeam(ctx, teamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTeam", reflect.TypeOf((*MockTeamStorage)(nil).DeleteTeam), ctx, teamID)
+}
+
+// DeleteUserTeamInstance mocks base method.
+func (m *MockTeamStorage) DeleteUserTeamInstance(ctx context.Context, teamID domain.TeamID, userID domain.UserID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserTeamInstance", ctx, teamID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserTeamInstance indicates an expected call of DeleteUserTeamInstance.
+func (mr *MockTeamStorageMockRecorder) DeleteUserTeamInstance(ctx, teamID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserTeamInstance", reflect.TypeOf((*MockTeamStorage)(nil).DeleteUserTeamInstance), ctx, teamID, userID)
+}
+
+// GetFallbackTeams mocks base method.
+func (m *MockTeamStorage) GetFallbackTeams(ctx context.Context, teamID domain.TeamID) ([]models.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFallbackTeams", ctx, teamID)
+	ret0, _ := ret[0].([]models.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFallbackTeams indicates an expected call of GetFallbackTeams.
+func (mr *MockTeamStorageMockRecorder) GetFallbackTeams(ctx, teamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFallbackTeams", reflect.TypeOf((*MockTeamStorage)(nil).GetFallbackTeams), ctx, teamID)
+}
+
+// GetOpenPullRequestIDs mocks base method.
+func (m *MockTeamStorage) GetOpenPullRequestIDs(ctx context.Context, teamID domain.TeamID) ([]domain.PRID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenPullRequestIDs", ctx, teamID)
+	ret0, _ := ret[0].([]domain.PRID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpenPullRequestIDs indicates an expected call of GetOpenPullRequestIDs.
+func (mr *MockTeamStorageMockRecorder) GetOpenPullRequestIDs(ctx, teamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenPullRequestIDs", reflect.TypeOf((*MockTeamStorage)(nil).GetOpenPullRequestIDs), ctx, teamID)
+}
+
 // GetTeamByID mocks base method.
 func (m *MockTeamStorage) GetTeamByID(ctx context.Context, teamID domain.TeamID) (*models.Team, error) {
 	m.ctrl.T.Helper()
@@ -116,6 +174,21 @@ func (mr *MockTeamStorageMockRecorder) GetTeamByUserID(ctx, userID any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamByUserID", reflect.TypeOf((*MockTeamStorage)(nil).GetTeamByUserID), ctx, userID)
 }
 
+// GetTeamsByUserID mocks base method.
+func (m *MockTeamStorage) GetTeamsByUserID(ctx context.Context, userID domain.UserID) ([]models.TeamMembership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamsByUserID", ctx, userID)
+	ret0, _ := ret[0].([]models.TeamMembership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamsByUserID indicates an expected call of GetTeamsByUserID.
+func (mr *MockTeamStorageMockRecorder) GetTeamsByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamsByUserID", reflect.TypeOf((*MockTeamStorage)(nil).GetTeamsByUserID), ctx, userID)
+}
+
 // GetUsersByTeam mocks base method.
 func (m *MockTeamStorage) GetUsersByTeam(ctx context.Context, teamID domain.TeamID) ([]models.User, error) {
 	m.ctrl.T.Helper()
@@ -130,3 +203,74 @@ func (mr *MockTeamStorageMockRecorder) GetUsersByTeam(ctx, teamID any) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersByTeam", reflect.TypeOf((*MockTeamStorage)(nil).GetUsersByTeam), ctx, teamID)
 }
+
+// ListTeams mocks base method.
+func (m *MockTeamStorage) ListTeams(ctx context.Context, afterName *string, limit int) ([]models.TeamSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTeams", ctx, afterName, limit)
+	ret0, _ := ret[0].([]models.TeamSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTeams indicates an expected call of ListTeams.
+func (mr *MockTeamStorageMockRecorder) ListTeams(ctx, afterName, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTeams", reflect.TypeOf((*MockTeamStorage)(nil).ListTeams), ctx, afterName, limit)
+}
+
+// RenameTeam mocks base method.
+func (m *MockTeamStorage) RenameTeam(ctx context.Context, teamID domain.TeamID, teamName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameTeam", ctx, teamID, teamName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameTeam indicates an expected call of RenameTeam.
+func (mr *MockTeamStorageMockRecorder) RenameTeam(ctx, teamID, teamName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameTeam", reflect.TypeOf((*MockTeamStorage)(nil).RenameTeam), ctx, teamID, teamName)
+}
+
+// ReplaceFallbackTeams mocks base method.
+func (m *MockTeamStorage) ReplaceFallbackTeams(ctx context.Context, teamID domain.TeamID, fallbackTeamIDs []domain.TeamID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceFallbackTeams", ctx, teamID, fallbackTeamIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceFallbackTeams indicates an expected call of ReplaceFallbackTeams.
+func (mr *MockTeamStorageMockRecorder) ReplaceFallbackTeams(ctx, teamID, fallbackTeamIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceFallbackTeams", reflect.TypeOf((*MockTeamStorage)(nil).ReplaceFallbackTeams), ctx, teamID, fallbackTeamIDs)
+}
+
+// SetPrimaryTeam mocks base method.
+func (m *MockTeamStorage) SetPrimaryTeam(ctx context.Context, userID domain.UserID, teamID domain.TeamID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPrimaryTeam", ctx, userID, teamID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPrimaryTeam indicates an expected call of SetPrimaryTeam.
+func (mr *MockTeamStorageMockRecorder) SetPrimaryTeam(ctx, userID, teamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPrimaryTeam", reflect.TypeOf((*MockTeamStorage)(nil).SetPrimaryTeam), ctx, userID, teamID)
+}
+
+// UpdateTeamSettings mocks base method.
+func (m *MockTeamStorage) UpdateTeamSettings(ctx context.Context, teamID domain.TeamID, settings models.TeamSettings) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTeamSettings", ctx, teamID, settings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTeamSettings indicates an expected call of UpdateTeamSettings.
+func (mr *MockTeamStorageMockRecorder) UpdateTeamSettings(ctx, teamID, settings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTeamSettings", reflect.TypeOf((*MockTeamStorage)(nil).UpdateTeamSettings), ctx, teamID, settings)
+}
@@ -42,6 +42,35 @@ func (m *MockUserStorage) EXPECT() *MockUserStorageMockRecorder {
 	return m.recorder
 }
 
+// AddUserSkills mocks base method.
+func (m *MockUserStorage) AddUserSkills(ctx context.Context, userID domain.UserID, skills []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUserSkills", ctx, userID, skills)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddUserSkills indicates an expected call of AddUserSkills.
+func (mr *MockUserStorageMockRecorder) AddUserSkills(ctx, userID, skills any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserSkills", reflect.TypeOf((*MockUserStorage)(nil).AddUserSkills), ctx, userID, skills)
+}
+
+// CreateOrRestoreUser mocks base method.
+func (m *MockUserStorage) CreateOrRestoreUser(ctx context.Context, userID domain.UserID, name string) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrRestoreUser", ctx, userID, name)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrRestoreUser indicates an expected call of CreateOrRestoreUser.
+func (mr *MockUserStorageMockRecorder) CreateOrRestoreUser(ctx, userID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrRestoreUser", reflect.TypeOf((*MockUserStorage)(nil).CreateOrRestoreUser), ctx, userID, name)
+}
+
 // CreateUser mocks base method.
 func (m *MockUserStorage) CreateUser(ctx context.Context, userID domain.UserID, name string) (*models.User, error) {
 	m.ctrl.T.Helper()
@@ -57,6 +86,20 @@ func (mr *MockUserStorageMockRecorder) CreateUser(ctx, userID, name any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUserStorage)(nil).CreateUser), ctx, userID, name)
 }
 
+// DeleteUserSkills mocks base method.
+func (m *MockUserStorage) DeleteUserSkills(ctx context.Context, userID domain.UserID, skills []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserSkills", ctx, userID, skills)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserSkills indicates an expected call of DeleteUserSkills.
+func (mr *MockUserStorageMockRecorder) DeleteUserSkills(ctx, userID, skills any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserSkills", reflect.TypeOf((*MockUserStorage)(nil).DeleteUserSkills), ctx, userID, skills)
+}
+
 // GetActiveUsersByTeam mocks base method.
 func (m *MockUserStorage) GetActiveUsersByTeam(ctx context.Context, teamID domain.TeamID) ([]models.User, error) {
 	m.ctrl.T.Helper()
@@ -72,6 +115,21 @@ func (mr *MockUserStorageMockRecorder) GetActiveUsersByTeam(ctx, teamID any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveUsersByTeam", reflect.TypeOf((*MockUserStorage)(nil).GetActiveUsersByTeam), ctx, teamID)
 }
 
+// GetSkillsByUserIDs mocks base method.
+func (m *MockUserStorage) GetSkillsByUserIDs(ctx context.Context, userIDs []domain.UserID) (map[domain.UserID][]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSkillsByUserIDs", ctx, userIDs)
+	ret0, _ := ret[0].(map[domain.UserID][]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSkillsByUserIDs indicates an expected call of GetSkillsByUserIDs.
+func (mr *MockUserStorageMockRecorder) GetSkillsByUserIDs(ctx, userIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSkillsByUserIDs", reflect.TypeOf((*MockUserStorage)(nil).GetSkillsByUserIDs), ctx, userIDs)
+}
+
 // GetUserByID mocks base method.
 func (m *MockUserStorage) GetUserByID(ctx context.Context, userID domain.UserID) (*models.User, error) {
 	m.ctrl.T.Helper()
@@ -87,6 +145,35 @@ func (mr *MockUserStorageMockRecorder) GetUserByID(ctx, userID any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockUserStorage)(nil).GetUserByID), ctx, userID)
 }
 
+// ListUsers mocks base method.
+func (m *MockUserStorage) ListUsers(ctx context.Context, teamID *domain.TeamID, isActive *bool, afterID *domain.UserID, limit int) ([]models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsers", ctx, teamID, isActive, afterID, limit)
+	ret0, _ := ret[0].([]models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockUserStorageMockRecorder) ListUsers(ctx, teamID, isActive, afterID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockUserStorage)(nil).ListUsers), ctx, teamID, isActive, afterID, limit)
+}
+
+// SoftDeleteUser mocks base method.
+func (m *MockUserStorage) SoftDeleteUser(ctx context.Context, userID domain.UserID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoftDeleteUser", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SoftDeleteUser indicates an expected call of SoftDeleteUser.
+func (mr *MockUserStorageMockRecorder) SoftDeleteUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDeleteUser", reflect.TypeOf((*MockUserStorage)(nil).SoftDeleteUser), ctx, userID)
+}
+
 // UpdateActivity mocks base method.
 func (m *MockUserStorage) UpdateActivity(ctx context.Context, userID domain.UserID, isActive domain.UserActivityStatus) error {
 	m.ctrl.T.Helper()
@@ -100,3 +187,17 @@ func (mr *MockUserStorageMockRecorder) UpdateActivity(ctx, userID, isActive any)
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivity", reflect.TypeOf((*MockUserStorage)(nil).UpdateActivity), ctx, userID, isActive)
 }
+
+// UpdateName mocks base method.
+func (m *MockUserStorage) UpdateName(ctx context.Context, userID domain.UserID, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateName", ctx, userID, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateName indicates an expected call of UpdateName.
+func (mr *MockUserStorageMockRecorder) UpdateName(ctx, userID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateName", reflect.TypeOf((*MockUserStorage)(nil).UpdateName), ctx, userID, name)
+}
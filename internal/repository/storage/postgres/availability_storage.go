@@ -0,0 +1,188 @@
+package postgres
+
+import (
+	"app/internal/domain"
+	"app/internal/repository/errs"
+	"app/internal/repository/models"
+	"app/internal/repository/storage"
+	"app/pkg/logger"
+	"app/pkg/txmanager"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type availabilityStorage struct {
+	txmanager txmanager.TxManager
+	sq        squirrel.StatementBuilderType
+	logger    logger.Logger
+}
+
+func NewAvailabilityStorage(txmanager txmanager.TxManager, logger logger.Logger) storage.AvailabilityStorage {
+	return &availabilityStorage{
+		txmanager: txmanager,
+		sq:        squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+		logger:    logger,
+	}
+}
+
+func (a *availabilityStorage) CreateUnavailability(ctx context.Context, userID domain.UserID, startsAt time.Time,
+	endsAt time.Time, reason string) (*models.Unavailability, error) {
+	tx := a.txmanager.GetExecutor(ctx)
+
+	query, args, err := a.sq.
+		Insert("user_unavailability").
+		Columns("user_id", "starts_at", "ends_at", "reason").
+		Values(userID.String(), startsAt, endsAt, reason).
+		Suffix("RETURNING id, user_id, starts_at, ends_at, reason, created_at").
+		ToSql()
+	if err != nil {
+		a.logger.Errorw("Failed to build SQL query for creating unavailability", "error", err)
+		return nil, err
+	}
+
+	var window models.Unavailability
+	err = tx.QueryRow(ctx, query, args...).
+		Scan(&window.ID, &window.UserID, &window.StartsAt, &window.EndsAt, &window.Reason, &window.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23503" {
+				a.logger.Warnw("User not found for unavailability", "user_id", userID)
+				return nil, errs.ErrNotFound
+			}
+			if pgErr.Code == "23514" {
+				a.logger.Warnw("Unavailability violates constraint", "user_id", userID, "constraint", pgErr.ConstraintName)
+				return nil, errs.ErrInvalidInput
+			}
+		}
+		a.logger.Errorw("Failed to create unavailability", "user_id", userID, "error", err)
+		return nil, err
+	}
+
+	a.logger.Infow("Successfully created unavailability", "user_id", userID, "unavailability_id", window.ID)
+	return &window, nil
+}
+
+// GetUnavailabilitiesByUserID возвращает окна пользователя, закончившиеся позже endsAfter, по возрастанию начала.
+func (a *availabilityStorage) GetUnavailabilitiesByUserID(ctx context.Context, userID domain.UserID,
+	endsAfter time.Time) ([]models.Unavailability, error) {
+	tx := a.txmanager.GetExecutor(ctx)
+
+	query, args, err := a.sq.
+		Select("id", "user_id", "starts_at", "ends_at", "reason", "created_at").
+		From("user_unavailability").
+		Where(squirrel.Eq{"user_id": userID.String()}).
+		Where(squirrel.Gt{"ends_at": endsAfter}).
+		OrderBy("starts_at", "id").
+		ToSql()
+	if err != nil {
+		a.logger.Errorw("Failed to build SQL query for getting unavailabilities", "error", err)
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		a.logger.Errorw("Failed to get unavailabilities", "user_id", userID, "error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var windows []models.Unavailability
+	for rows.Next() {
+		var window models.Unavailability
+		if err := rows.Scan(&window.ID, &window.UserID, &window.StartsAt, &window.EndsAt, &window.Reason, &window.CreatedAt); err != nil {
+			a.logger.Errorw("Failed to scan unavailability row", "user_id", userID, "error", err)
+			return nil, err
+		}
+		windows = append(windows, window)
+	}
+
+	if err := rows.Err(); err != nil {
+		a.logger.Errorw("Error during rows iteration for unavailabilities", "user_id", userID, "error", err)
+		return nil, err
+	}
+
+	a.logger.Infow("Successfully retrieved unavailabilities", "user_id", userID, "count", len(windows))
+	return windows, nil
+}
+
+func (a *availabilityStorage) DeleteUnavailability(ctx context.Context, userID domain.UserID,
+	unavailabilityID domain.UnavailabilityID) error {
+	tx := a.txmanager.GetExecutor(ctx)
+
+	query, args, err := a.sq.
+		Delete("user_unavailability").
+		Where(squirrel.Eq{"id": unavailabilityID.Int64()}).
+		Where(squirrel.Eq{"user_id": userID.String()}).
+		ToSql()
+	if err != nil {
+		a.logger.Errorw("Failed to build SQL query for deleting unavailability", "error", err)
+		return err
+	}
+
+	result, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		a.logger.Errorw("Failed to delete unavailability", "unavailability_id", unavailabilityID, "error", err)
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		a.logger.Warnw("Unavailability not found", "user_id", userID, "unavailability_id", unavailabilityID)
+		return errs.ErrNotFound
+	}
+
+	a.logger.Infow("Successfully deleted unavailability", "user_id", userID, "unavailability_id", unavailabilityID)
+	return nil
+}
+
+// GetUnavailableUserIDs возвращает тех из userIDs, у кого на момент at есть действующее окно недоступности.
+func (a *availabilityStorage) GetUnavailableUserIDs(ctx context.Context, userIDs []domain.UserID,
+	at time.Time) (map[domain.UserID]bool, error) {
+	tx := a.txmanager.GetExecutor(ctx)
+
+	ids := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		ids = append(ids, userID.String())
+	}
+
+	query, args, err := a.sq.
+		Select("DISTINCT user_id").
+		From("user_unavailability").
+		Where(squirrel.Eq{"user_id": ids}).
+		Where(squirrel.LtOrEq{"starts_at": at}).
+		Where(squirrel.Gt{"ends_at": at}).
+		ToSql()
+	if err != nil {
+		a.logger.Errorw("Failed to build SQL query for getting unavailable users", "error", err)
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		a.logger.Errorw("Failed to get unavailable users", "user_ids", userIDs, "error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	unavailable := make(map[domain.UserID]bool)
+	for rows.Next() {
+		var userID domain.UserID
+		if err := rows.Scan(&userID); err != nil {
+			a.logger.Errorw("Failed to scan unavailable user row", "error", err)
+			return nil, err
+		}
+		unavailable[userID] = true
+	}
+
+	if err := rows.Err(); err != nil {
+		a.logger.Errorw("Error during rows iteration for unavailable users", "error", err)
+		return nil, err
+	}
+
+	a.logger.Infow("Successfully retrieved unavailable users", "count", len(unavailable))
+	return unavailable, nil
+}
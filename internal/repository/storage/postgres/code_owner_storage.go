@@ -0,0 +1,147 @@
+package postgres
+
+import (
+	"app/internal/domain"
+	"app/internal/repository/errs"
+	"app/internal/repository/models"
+	"app/internal/repository/storage"
+	"app/pkg/logger"
+	"app/pkg/txmanager"
+	"context"
+	"errors"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type codeOwnerStorage struct {
+	txmanager txmanager.TxManager
+	sq        squirrel.StatementBuilderType
+	logger    logger.Logger
+}
+
+func NewCodeOwnerStorage(txmanager txmanager.TxManager, logger logger.Logger) storage.CodeOwnerStorage {
+	return &codeOwnerStorage{
+		txmanager: txmanager,
+		sq:        squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+		logger:    logger,
+	}
+}
+
+func (c *codeOwnerStorage) CreateCodeOwnerRule(ctx context.Context, pattern string, ownerUserID *domain.UserID,
+	ownerTeamID *domain.TeamID) (*models.CodeOwnerRule, error) {
+	tx := c.txmanager.GetExecutor(ctx)
+
+	var ownerUserValue *string
+	if ownerUserID != nil {
+		value := ownerUserID.String()
+		ownerUserValue = &value
+	}
+
+	var ownerTeamValue *int64
+	if ownerTeamID != nil {
+		value := ownerTeamID.Int64()
+		ownerTeamValue = &value
+	}
+
+	query, args, err := c.sq.
+		Insert("code_owners").
+		Columns("pattern", "owner_user_id", "owner_team_id").
+		Values(pattern, ownerUserValue, ownerTeamValue).
+		Suffix("RETURNING id, pattern, owner_user_id, owner_team_id, created_at").
+		ToSql()
+	if err != nil {
+		c.logger.Errorw("Failed to build SQL query for creating code owner rule", "error", err)
+		return nil, err
+	}
+
+	var rule models.CodeOwnerRule
+	err = tx.QueryRow(ctx, query, args...).Scan(&rule.ID, &rule.Pattern, &rule.OwnerUserID, &rule.OwnerTeamID, &rule.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23505" {
+				c.logger.Warnw("Code owner rule already exists", "pattern", pattern)
+				return nil, errs.ErrAlreadyExists
+			}
+			if pgErr.Code == "23503" || pgErr.Code == "23514" {
+				c.logger.Warnw("Code owner rule violates constraint", "pattern", pattern, "constraint", pgErr.ConstraintName)
+				return nil, errs.ErrInvalidInput
+			}
+		}
+		c.logger.Errorw("Failed to create code owner rule", "pattern", pattern, "error", err)
+		return nil, err
+	}
+
+	c.logger.Infow("Successfully created code owner rule", "rule_id", rule.ID, "pattern", pattern)
+	return &rule, nil
+}
+
+// GetCodeOwnerRules возвращает все правила в порядке добавления: при совпадении нескольких шаблонов
+// побеждает последний, как в CODEOWNERS.
+func (c *codeOwnerStorage) GetCodeOwnerRules(ctx context.Context) ([]models.CodeOwnerRule, error) {
+	tx := c.txmanager.GetExecutor(ctx)
+
+	query, args, err := c.sq.
+		Select("co.id", "co.pattern", "co.owner_user_id", "co.owner_team_id", "t.team_name", "co.created_at").
+		From("code_owners co").
+		LeftJoin("teams t ON t.id = co.owner_team_id").
+		OrderBy("co.id").
+		ToSql()
+	if err != nil {
+		c.logger.Errorw("Failed to build SQL query for getting code owner rules", "error", err)
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		c.logger.Errorw("Failed to get code owner rules", "error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rules []models.CodeOwnerRule
+	for rows.Next() {
+		var rule models.CodeOwnerRule
+		if err := rows.Scan(&rule.ID, &rule.Pattern, &rule.OwnerUserID, &rule.OwnerTeamID, &rule.OwnerTeamName, &rule.CreatedAt); err != nil {
+			c.logger.Errorw("Failed to scan code owner rule row", "error", err)
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	if err := rows.Err(); err != nil {
+		c.logger.Errorw("Error during rows iteration for code owner rules", "error", err)
+		return nil, err
+	}
+
+	c.logger.Infow("Successfully retrieved code owner rules", "count", len(rules))
+	return rules, nil
+}
+
+func (c *codeOwnerStorage) DeleteCodeOwnerRule(ctx context.Context, ruleID domain.CodeOwnerID) error {
+	tx := c.txmanager.GetExecutor(ctx)
+
+	query, args, err := c.sq.
+		Delete("code_owners").
+		Where(squirrel.Eq{"id": ruleID.Int64()}).
+		ToSql()
+	if err != nil {
+		c.logger.Errorw("Failed to build SQL query for deleting code owner rule", "error", err)
+		return err
+	}
+
+	result, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		c.logger.Errorw("Failed to delete code owner rule", "rule_id", ruleID, "error", err)
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		c.logger.Warnw("No code owner rule found to delete", "rule_id", ruleID)
+		return errs.ErrNotFound
+	}
+
+	c.logger.Infow("Successfully deleted code owner rule", "rule_id", ruleID)
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"app/pkg/txmanager"
 	"context"
 	"errors"
+	"time"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/jackc/pgx/v5"
@@ -33,7 +34,7 @@ func (p *prStorage) GetAllOpenPullRequests(ctx context.Context) ([]models.PullRe
 	tx := p.txmanager.GetExecutor(ctx)
 
 	query, args, err := p.sq.
-		Select("id", "name", "author_id", "status", "need_more_reviewers", "created_at", "merged_at").
+		Select("id", "name", "author_id", "status", "need_more_reviewers", "created_at", "merged_at", "merged_by", "ready_at", "team_id").
 		From("pull_requests").
 		Where(squirrel.Eq{"status": domain.PRStatusOpen}).
 		ToSql()
@@ -52,7 +53,7 @@ func (p *prStorage) GetAllOpenPullRequests(ctx context.Context) ([]models.PullRe
 	var prs []models.PullRequest
 	for rows.Next() {
 		var pr models.PullRequest
-		if err := rows.Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status, &pr.NeedMoreReviewers, &pr.CreatedAt, &pr.MergedAt); err != nil {
+		if err := rows.Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status, &pr.NeedMoreReviewers, &pr.CreatedAt, &pr.MergedAt, &pr.MergedBy, &pr.ReadyAt, &pr.TeamID); err != nil {
 			p.logger.Errorw("Failed to scan pull request row", "error", err)
 			return nil, err
 		}
@@ -68,13 +69,21 @@ func (p *prStorage) GetAllOpenPullRequests(ctx context.Context) ([]models.PullRe
 	return prs, nil
 }
 
-func (p *prStorage) CreatePRReviewerInstance(ctx context.Context, prID domain.PRID, reviewerID domain.UserID) error {
+// CreatePRReviewerInstance назначает ревьювера. sourceTeamID передаётся для ревьювера из запасной команды,
+// для ревьювера из команды PR он nil.
+func (p *prStorage) CreatePRReviewerInstance(ctx context.Context, prID domain.PRID, reviewerID domain.UserID, sourceTeamID *domain.TeamID) error {
 	tx := p.txmanager.GetExecutor(ctx)
 
+	var sourceTeamValue *int64
+	if sourceTeamID != nil {
+		value := sourceTeamID.Int64()
+		sourceTeamValue = &value
+	}
+
 	query, args, err := p.sq.
 		Insert("pr_reviewers").
-		Columns("pr_id", "reviewer_id", "assigned_at").
-		Values(prID.String(), reviewerID.String(), squirrel.Expr("NOW()")).
+		Columns("pr_id", "reviewer_id", "assigned_at", "source_team_id").
+		Values(prID.String(), reviewerID.String(), squirrel.Expr("NOW()"), sourceTeamValue).
 		ToSql()
 	if err != nil {
 		p.logger.Errorw("Failed to build SQL query for creating PR reviewer", "error", err)
@@ -94,18 +103,24 @@ func (p *prStorage) CreatePRReviewerInstance(ctx context.Context, prID domain.PR
 		return err
 	}
 
-	p.logger.Infow("Successfully created PR reviewer instance", "pr_id", prID, "reviewer_id", reviewerID)
+	p.logger.Infow("Successfully created PR reviewer instance", "pr_id", prID, "reviewer_id", reviewerID, "source_team_id", sourceTeamID)
 	return nil
 }
 
-func (p *prStorage) CreatePullRequest(ctx context.Context, prID domain.PRID, prName string, prAuthorID domain.UserID) (*models.PullRequest, error) {
+// CreatePullRequest создаёт PR в статусе OPEN или DRAFT. У черновика ready_at остаётся NULL до перехода в OPEN.
+func (p *prStorage) CreatePullRequest(ctx context.Context, prID domain.PRID, prName string, prAuthorID domain.UserID, status domain.PRStatus) (*models.PullRequest, error) {
 	tx := p.txmanager.GetExecutor(ctx)
 
+	var readyAt any
+	if status != domain.PRStatusDraft {
+		readyAt = squirrel.Expr("CURRENT_TIMESTAMP")
+	}
+
 	query, args, err := p.sq.
 		Insert("pull_requests").
-		Columns("id", "name", "author_id", "status", "need_more_reviewers").
-		Values(prID.String(), prName, prAuthorID.String(), domain.PRStatusOpen, true).
-		Suffix("RETURNING id, name, author_id, status, need_more_reviewers, created_at").
+		Columns("id", "name", "author_id", "status", "need_more_reviewers", "ready_at").
+		Values(prID.String(), prName, prAuthorID.String(), status, status != domain.PRStatusDraft, readyAt).
+		Suffix("RETURNING id, name, author_id, status, need_more_reviewers, created_at, ready_at, team_id").
 		ToSql()
 	if err != nil {
 		p.logger.Errorw("Failed to build SQL query for creating pull request", "error", err)
@@ -113,7 +128,7 @@ func (p *prStorage) CreatePullRequest(ctx context.Context, prID domain.PRID, prN
 	}
 
 	var pr models.PullRequest
-	err = tx.QueryRow(ctx, query, args...).Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status, &pr.NeedMoreReviewers, &pr.CreatedAt)
+	err = tx.QueryRow(ctx, query, args...).Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status, &pr.NeedMoreReviewers, &pr.CreatedAt, &pr.ReadyAt, &pr.TeamID)
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
@@ -134,7 +149,7 @@ func (p *prStorage) GetPullRequestByID(ctx context.Context, prID domain.PRID) (*
 	tx := p.txmanager.GetExecutor(ctx)
 
 	query, args, err := p.sq.
-		Select("id", "name", "author_id", "status", "need_more_reviewers", "created_at", "merged_at").
+		Select("id", "name", "author_id", "status", "need_more_reviewers", "created_at", "merged_at", "merged_by", "ready_at", "team_id").
 		From("pull_requests").
 		Where(squirrel.Eq{"id": prID.String()}).
 		ToSql()
@@ -144,7 +159,7 @@ func (p *prStorage) GetPullRequestByID(ctx context.Context, prID domain.PRID) (*
 	}
 
 	var pr models.PullRequest
-	err = tx.QueryRow(ctx, query, args...).Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status, &pr.NeedMoreReviewers, &pr.CreatedAt, &pr.MergedAt)
+	err = tx.QueryRow(ctx, query, args...).Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status, &pr.NeedMoreReviewers, &pr.CreatedAt, &pr.MergedAt, &pr.MergedBy, &pr.ReadyAt, &pr.TeamID)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			p.logger.Warnw("Pull request not found", "pr_id", prID)
@@ -158,14 +173,14 @@ func (p *prStorage) GetPullRequestByID(ctx context.Context, prID domain.PRID) (*
 	return &pr, nil
 }
 
-func (p *prStorage) GetPullRequestsByReviewerID(ctx context.Context, reviewerID domain.UserID) ([]models.PullRequest, error) {
+func (p *prStorage) GetPullRequestsByReviewerID(ctx context.Context, reviewerID domain.UserID, filter domain.PullRequestFilter) ([]models.PullRequest, error) {
 	tx := p.txmanager.GetExecutor(ctx)
 
-	query, args, err := p.sq.
-		Select("pr.id", "pr.name", "pr.author_id", "pr.status", "pr.need_more_reviewers", "pr.created_at", "pr.merged_at").
+	query, args, err := applyPullRequestFilter(p.sq.
+		Select("pr.id", "pr.name", "pr.author_id", "pr.status", "pr.need_more_reviewers", "pr.created_at", "pr.merged_at", "pr.merged_by", "pr.ready_at", "pr.team_id").
 		From("pull_requests pr").
 		Join("pr_reviewers prr ON pr.id = prr.pr_id").
-		Where(squirrel.Eq{"prr.reviewer_id": reviewerID.String()}).
+		Where(squirrel.Eq{"prr.reviewer_id": reviewerID.String()}), filter).
 		ToSql()
 	if err != nil {
 		p.logger.Errorw("Failed to build SQL query for getting PRs by reviewer ID", "error", err)
@@ -182,7 +197,7 @@ func (p *prStorage) GetPullRequestsByReviewerID(ctx context.Context, reviewerID
 	var prs []models.PullRequest
 	for rows.Next() {
 		var pr models.PullRequest
-		if err := rows.Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status, &pr.NeedMoreReviewers, &pr.CreatedAt, &pr.MergedAt); err != nil {
+		if err := rows.Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status, &pr.NeedMoreReviewers, &pr.CreatedAt, &pr.MergedAt, &pr.MergedBy, &pr.ReadyAt, &pr.TeamID); err != nil {
 			p.logger.Errorw("Failed to scan pull request row for reviewer", "reviewer_id", reviewerID, "error", err)
 			return nil, err
 		}
@@ -198,14 +213,86 @@ func (p *prStorage) GetPullRequestsByReviewerID(ctx context.Context, reviewerID
 	return prs, nil
 }
 
+func (p *prStorage) GetPullRequestsByAuthorID(ctx context.Context, authorID domain.UserID, filter domain.PullRequestFilter) ([]models.PullRequest, error) {
+	tx := p.txmanager.GetExecutor(ctx)
+
+	query, args, err := applyPullRequestFilter(p.sq.
+		Select("pr.id", "pr.name", "pr.author_id", "pr.status", "pr.need_more_reviewers", "pr.created_at", "pr.merged_at", "pr.merged_by", "pr.ready_at", "pr.team_id").
+		From("pull_requests pr").
+		Where(squirrel.Eq{"pr.author_id": authorID.String()}), filter).
+		ToSql()
+	if err != nil {
+		p.logger.Errorw("Failed to build SQL query for getting PRs by author ID", "error", err)
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		p.logger.Errorw("Failed to get pull requests by author ID", "author_id", authorID, "error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var prs []models.PullRequest
+	for rows.Next() {
+		var pr models.PullRequest
+		if err := rows.Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status, &pr.NeedMoreReviewers, &pr.CreatedAt, &pr.MergedAt, &pr.MergedBy, &pr.ReadyAt, &pr.TeamID); err != nil {
+			p.logger.Errorw("Failed to scan pull request row for author", "author_id", authorID, "error", err)
+			return nil, err
+		}
+		prs = append(prs, pr)
+	}
+
+	if err := rows.Err(); err != nil {
+		p.logger.Errorw("Error during rows iteration for author PRs", "author_id", authorID, "error", err)
+		return nil, err
+	}
+
+	p.logger.Infow("Successfully retrieved pull requests for author", "author_id", authorID, "count", len(prs))
+	return prs, nil
+}
+
+// applyPullRequestFilter добавляет фильтры и keyset-пагинацию по (created_at, id) к выборке из pull_requests pr.
+func applyPullRequestFilter(b squirrel.SelectBuilder, filter domain.PullRequestFilter) squirrel.SelectBuilder {
+	if filter.Status != nil {
+		b = b.Where(squirrel.Eq{"pr.status": *filter.Status})
+	}
+	if filter.CreatedAfter != nil {
+		b = b.Where(squirrel.GtOrEq{"pr.created_at": *filter.CreatedAfter})
+	}
+	if filter.CreatedBefore != nil {
+		b = b.Where(squirrel.Lt{"pr.created_at": *filter.CreatedBefore})
+	}
+
+	direction, cmp := "ASC", ">"
+	if filter.SortOrder == domain.SortOrderDesc {
+		direction, cmp = "DESC", "<"
+	}
+
+	if filter.Cursor != nil {
+		b = b.Where("(pr.created_at, pr.id) "+cmp+" (?, ?)", filter.Cursor.CreatedAt, filter.Cursor.ID.String())
+	}
+
+	b = b.OrderBy("pr.created_at "+direction, "pr.id "+direction)
+
+	if filter.Limit > 0 {
+		b = b.Limit(uint64(filter.Limit))
+	}
+
+	return b
+}
+
 func (p *prStorage) GetReviewersFromPR(ctx context.Context, prID domain.PRID) ([]models.User, error) {
 	tx := p.txmanager.GetExecutor(ctx)
 
 	query, args, err := p.sq.
-		Select("u.id", "u.is_active", "u.name").
+		Select("u.id", "u.is_active", "u.name", "prr.assigned_at", "prr.review_state", "prr.reviewed_at",
+			"prr.source_team_id", "COALESCE(st.team_name, '')").
 		From("users u").
 		Join("pr_reviewers prr ON u.id = prr.reviewer_id").
+		LeftJoin("teams st ON st.id = prr.source_team_id").
 		Where(squirrel.Eq{"prr.pr_id": prID.String()}).
+		OrderBy("prr.assigned_at", "u.id").
 		ToSql()
 	if err != nil {
 		p.logger.Errorw("Failed to build SQL query for getting reviewers from PR", "error", err)
@@ -222,7 +309,8 @@ func (p *prStorage) GetReviewersFromPR(ctx context.Context, prID domain.PRID) ([
 	var reviewers []models.User
 	for rows.Next() {
 		var user models.User
-		if err := rows.Scan(&user.ID, &user.StatusActivity, &user.Name); err != nil {
+		if err := rows.Scan(&user.ID, &user.StatusActivity, &user.Name, &user.AssignedAt, &user.ReviewState, &user.ReviewedAt,
+			&user.SourceTeamID, &user.SourceTeamName); err != nil {
 			p.logger.Errorw("Failed to scan reviewer row", "pr_id", prID, "error", err)
 			return nil, err
 		}
@@ -238,6 +326,116 @@ func (p *prStorage) GetReviewersFromPR(ctx context.Context, prID domain.PRID) ([
 	return reviewers, nil
 }
 
+// GetParticipantsByPRIDs одним запросом достаёт авторов и ревьюверов для набора PR.
+func (p *prStorage) GetParticipantsByPRIDs(ctx context.Context, prIDs []domain.PRID) (map[domain.UserID]models.User, map[domain.PRID][]models.User, error) {
+	tx := p.txmanager.GetExecutor(ctx)
+
+	authors := make(map[domain.UserID]models.User)
+	reviewers := make(map[domain.PRID][]models.User, len(prIDs))
+	if len(prIDs) == 0 {
+		return authors, reviewers, nil
+	}
+
+	ids := make([]string, 0, len(prIDs))
+	for _, id := range prIDs {
+		ids = append(ids, id.String())
+	}
+
+	authorsQuery, args, err := p.sq.
+		Select("pr.id", "TRUE", "u.id", "u.is_active", "u.name", "pr.created_at", "''", "NULL::timestamp",
+			"NULL::bigint", "''").
+		From("pull_requests pr").
+		Join("users u ON u.id = pr.author_id").
+		Where("pr.id = ANY(?)", ids).
+		ToSql()
+	if err != nil {
+		p.logger.Errorw("Failed to build SQL query for getting PR authors", "error", err)
+		return nil, nil, err
+	}
+
+	reviewersQuery, _, err := p.sq.
+		Select("prr.pr_id", "FALSE", "u.id", "u.is_active", "u.name", "prr.assigned_at", "prr.review_state::text", "prr.reviewed_at",
+			"prr.source_team_id", "COALESCE(st.team_name, '')").
+		From("pr_reviewers prr").
+		Join("users u ON u.id = prr.reviewer_id").
+		LeftJoin("teams st ON st.id = prr.source_team_id").
+		Where("prr.pr_id = ANY(?)", ids).
+		ToSql()
+	if err != nil {
+		p.logger.Errorw("Failed to build SQL query for getting PR reviewers", "error", err)
+		return nil, nil, err
+	}
+
+	// Обе части ссылаются на один и тот же $1 с массивом id.
+	query := authorsQuery + " UNION ALL " + reviewersQuery + " ORDER BY 1, 6, 3"
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		p.logger.Errorw("Failed to get PR participants", "count", len(prIDs), "error", err)
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			prID     domain.PRID
+			isAuthor bool
+			user     models.User
+			at       *time.Time
+		)
+		if err := rows.Scan(&prID, &isAuthor, &user.ID, &user.StatusActivity, &user.Name, &at,
+			&user.ReviewState, &user.ReviewedAt, &user.SourceTeamID, &user.SourceTeamName); err != nil {
+			p.logger.Errorw("Failed to scan PR participant row", "error", err)
+			return nil, nil, err
+		}
+		if isAuthor {
+			authors[user.ID] = user
+			continue
+		}
+		user.AssignedAt = at
+		reviewers[prID] = append(reviewers[prID], user)
+	}
+
+	if err := rows.Err(); err != nil {
+		p.logger.Errorw("Error during rows iteration for PR participants", "error", err)
+		return nil, nil, err
+	}
+
+	p.logger.Infow("Successfully retrieved PR participants", "count", len(prIDs))
+	return authors, reviewers, nil
+}
+
+// UpdateReviewState записывает вердикт ревьювера и момент ответа.
+func (p *prStorage) UpdateReviewState(ctx context.Context, prID domain.PRID, reviewerID domain.UserID, state domain.ReviewState) error {
+	tx := p.txmanager.GetExecutor(ctx)
+
+	query, args, err := p.sq.
+		Update("pr_reviewers").
+		Set("review_state", state).
+		Set("reviewed_at", squirrel.Expr("CURRENT_TIMESTAMP")).
+		Where(squirrel.Eq{"pr_id": prID.String()}).
+		Where(squirrel.Eq{"reviewer_id": reviewerID.String()}).
+		ToSql()
+	if err != nil {
+		p.logger.Errorw("Failed to build SQL query for updating review state", "error", err)
+		return err
+	}
+
+	tag, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		p.logger.Errorw("Failed to update review state", "pr_id", prID, "reviewer_id", reviewerID, "error", err)
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		p.logger.Warnw("No reviewer found to update review state", "pr_id", prID, "reviewer_id", reviewerID)
+		return errs.ErrNotFound
+	}
+
+	p.logger.Infow("Successfully updated review state", "pr_id", prID, "reviewer_id", reviewerID, "state", state)
+	return nil
+}
+
 func (p *prStorage) DeletePRReviewerInstance(ctx context.Context, prID domain.PRID, reviewerID domain.UserID) error {
 	tx := p.txmanager.GetExecutor(ctx)
 
@@ -266,13 +464,15 @@ func (p *prStorage) DeletePRReviewerInstance(ctx context.Context, prID domain.PR
 	return nil
 }
 
-func (p *prStorage) UpdatePullRequestStatus(ctx context.Context, prID domain.PRID, status domain.PRStatus) error {
+// UpdatePullRequestStatus меняет статус, только если PR всё ещё в статусе from; иначе ErrNotFound.
+func (p *prStorage) UpdatePullRequestStatus(ctx context.Context, prID domain.PRID, from, to domain.PRStatus) error {
 	tx := p.txmanager.GetExecutor(ctx)
 
 	query, args, err := p.sq.
 		Update("pull_requests").
-		Set("status", status).
+		Set("status", to).
 		Where(squirrel.Eq{"id": prID.String()}).
+		Where(squirrel.Eq{"status": from}).
 		ToSql()
 	if err != nil {
 		p.logger.Errorw("Failed to build SQL query for updating PR status", "error", err)
@@ -281,15 +481,197 @@ func (p *prStorage) UpdatePullRequestStatus(ctx context.Context, prID domain.PRI
 
 	result, err := tx.Exec(ctx, query, args...)
 	if err != nil {
-		p.logger.Errorw("Failed to update pull request status", "pr_id", prID, "status", status, "error", err)
+		p.logger.Errorw("Failed to update pull request status", "pr_id", prID, "status", to, "error", err)
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		p.logger.Warnw("No pull request found to update status", "pr_id", prID, "from", from)
+		return errs.ErrNotFound
+	}
+
+	p.logger.Infow("Successfully updated pull request status", "pr_id", prID, "from", from, "to", to)
+	return nil
+}
+
+// MergePullRequest одним UPDATE переводит OPEN PR в MERGED и проставляет merged_at и merged_by.
+func (p *prStorage) MergePullRequest(ctx context.Context, prID domain.PRID, mergedBy *domain.UserID) (*models.PullRequest, error) {
+	tx := p.txmanager.GetExecutor(ctx)
+
+	var mergedByValue *string
+	if mergedBy != nil {
+		value := mergedBy.String()
+		mergedByValue = &value
+	}
+
+	query, args, err := p.sq.
+		Update("pull_requests").
+		Set("status", domain.PRStatusMerged).
+		Set("merged_at", squirrel.Expr("CURRENT_TIMESTAMP")).
+		Set("merged_by", mergedByValue).
+		Where(squirrel.Eq{"id": prID.String()}).
+		Where(squirrel.Eq{"status": domain.PRStatusOpen}).
+		Suffix("RETURNING id, name, author_id, status, need_more_reviewers, created_at, merged_at, merged_by, ready_at, team_id").
+		ToSql()
+	if err != nil {
+		p.logger.Errorw("Failed to build SQL query for merging pull request", "error", err)
+		return nil, err
+	}
+
+	var pr models.PullRequest
+	err = tx.QueryRow(ctx, query, args...).
+		Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status, &pr.NeedMoreReviewers, &pr.CreatedAt, &pr.MergedAt, &pr.MergedBy, &pr.ReadyAt, &pr.TeamID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			p.logger.Warnw("No open pull request found to merge", "pr_id", prID)
+			return nil, errs.ErrNotFound
+		}
+		p.logger.Errorw("Failed to merge pull request", "pr_id", prID, "error", err)
+		return nil, err
+	}
+
+	p.logger.Infow("Successfully merged pull request", "pr_id", prID, "merged_by", mergedBy)
+	return &pr, nil
+}
+
+// MarkPullRequestReady переводит черновик в OPEN и фиксирует ready_at.
+func (p *prStorage) MarkPullRequestReady(ctx context.Context, prID domain.PRID) (*models.PullRequest, error) {
+	tx := p.txmanager.GetExecutor(ctx)
+
+	query, args, err := p.sq.
+		Update("pull_requests").
+		Set("status", domain.PRStatusOpen).
+		Set("ready_at", squirrel.Expr("CURRENT_TIMESTAMP")).
+		Where(squirrel.Eq{"id": prID.String()}).
+		Where(squirrel.Eq{"status": domain.PRStatusDraft}).
+		Suffix("RETURNING id, name, author_id, status, need_more_reviewers, created_at, merged_at, merged_by, ready_at, team_id").
+		ToSql()
+	if err != nil {
+		p.logger.Errorw("Failed to build SQL query for marking pull request ready", "error", err)
+		return nil, err
+	}
+
+	var pr models.PullRequest
+	err = tx.QueryRow(ctx, query, args...).
+		Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status, &pr.NeedMoreReviewers, &pr.CreatedAt, &pr.MergedAt, &pr.MergedBy, &pr.ReadyAt, &pr.TeamID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			p.logger.Warnw("No draft pull request found to mark ready", "pr_id", prID)
+			return nil, errs.ErrNotFound
+		}
+		p.logger.Errorw("Failed to mark pull request ready", "pr_id", prID, "error", err)
+		return nil, err
+	}
+
+	p.logger.Infow("Successfully marked pull request ready", "pr_id", prID)
+	return &pr, nil
+}
+
+func (p *prStorage) UpdateNeedMoreReviewers(ctx context.Context, prID domain.PRID, needMoreReviewers bool) error {
+	tx := p.txmanager.GetExecutor(ctx)
+
+	query, args, err := p.sq.
+		Update("pull_requests").
+		Set("need_more_reviewers", needMoreReviewers).
+		Where(squirrel.Eq{"id": prID.String()}).
+		ToSql()
+	if err != nil {
+		p.logger.Errorw("Failed to build SQL query for updating need_more_reviewers", "error", err)
+		return err
+	}
+
+	result, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		p.logger.Errorw("Failed to update need_more_reviewers", "pr_id", prID, "error", err)
 		return err
 	}
 
 	if result.RowsAffected() == 0 {
-		p.logger.Warnw("No pull request found to update status", "pr_id", prID)
+		p.logger.Warnw("No pull request found to update need_more_reviewers", "pr_id", prID)
 		return errs.ErrNotFound
 	}
 
-	p.logger.Infow("Successfully updated pull request status", "pr_id", prID, "status", status)
+	p.logger.Infow("Successfully updated need_more_reviewers", "pr_id", prID, "need_more_reviewers", needMoreReviewers)
 	return nil
 }
+
+// SetPullRequestTeam закрепляет за PR команду, из которой выбираются ревьюверы.
+func (p *prStorage) SetPullRequestTeam(ctx context.Context, prID domain.PRID, teamID domain.TeamID) error {
+	tx := p.txmanager.GetExecutor(ctx)
+
+	query, args, err := p.sq.
+		Update("pull_requests").
+		Set("team_id", teamID.Int64()).
+		Where(squirrel.Eq{"id": prID.String()}).
+		ToSql()
+	if err != nil {
+		p.logger.Errorw("Failed to build SQL query for setting pull request team", "error", err)
+		return err
+	}
+
+	result, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		p.logger.Errorw("Failed to set pull request team", "pr_id", prID, "team_id", teamID, "error", err)
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		p.logger.Warnw("No pull request found to set team", "pr_id", prID)
+		return errs.ErrNotFound
+	}
+
+	p.logger.Infow("Successfully set pull request team", "pr_id", prID, "team_id", teamID)
+	return nil
+}
+
+func (p *prStorage) CountOpenReviewsByReviewerIDs(ctx context.Context, reviewerIDs []domain.UserID) (map[domain.UserID]int, error) {
+	tx := p.txmanager.GetExecutor(ctx)
+
+	counts := make(map[domain.UserID]int, len(reviewerIDs))
+	if len(reviewerIDs) == 0 {
+		return counts, nil
+	}
+
+	ids := make([]string, 0, len(reviewerIDs))
+	for _, id := range reviewerIDs {
+		ids = append(ids, id.String())
+	}
+
+	query, args, err := p.sq.
+		Select("prr.reviewer_id", "COUNT(*)").
+		From("pr_reviewers prr").
+		Join("pull_requests pr ON pr.id = prr.pr_id").
+		Where(squirrel.Eq{"prr.reviewer_id": ids}).
+		Where(squirrel.Eq{"pr.status": domain.PRStatusOpen}).
+		GroupBy("prr.reviewer_id").
+		ToSql()
+	if err != nil {
+		p.logger.Errorw("Failed to build SQL query for counting open reviews", "error", err)
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		p.logger.Errorw("Failed to count open reviews by reviewer IDs", "error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var reviewerID domain.UserID
+		var count int
+		if err := rows.Scan(&reviewerID, &count); err != nil {
+			p.logger.Errorw("Failed to scan open reviews count row", "error", err)
+			return nil, err
+		}
+		counts[reviewerID] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		p.logger.Errorw("Error during rows iteration for open reviews count", "error", err)
+		return nil, err
+	}
+
+	p.logger.Infow("Successfully counted open reviews", "reviewers", len(reviewerIDs))
+	return counts, nil
+}
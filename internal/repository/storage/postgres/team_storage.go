@@ -9,6 +9,7 @@ import (
 	"app/pkg/txmanager"
 	"context"
 	"errors"
+	"strings"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/jackc/pgx/v5"
@@ -29,13 +30,21 @@ func NewTeamStorage(txmanager txmanager.TxManager, logger logger.Logger) storage
 	}
 }
 
+// primaryTeamOrder упорядочивает членства пользователя: первым идёт основная команда,
+// а если она не выбрана — та, в которую пользователь вступил раньше.
+var primaryTeamOrder = []string{"ut.is_primary DESC", "ut.joined_at", "ut.team_id"}
+
+// GetTeamByUserID возвращает основную команду пользователя.
 func (t *teamStorage) GetTeamByUserID(ctx context.Context, userID domain.UserID) (*models.Team, error) {
 	tx := t.txmanager.GetExecutor(ctx)
 	query, args, err := t.sq.
-		Select("t.id", "t.team_name", "t.created_at").
+		Select("t.id", "t.team_name", "t.created_at", "t.min_reviewers", "t.max_reviewers", "t.reviewer_strategy",
+			"t.min_approvals", "t.require_all_responded", "t.forbid_self_merge", "t.min_pr_age_seconds").
 		From("teams t").
 		Join("user_teams ut ON t.id = ut.team_id").
 		Where(squirrel.Eq{"ut.user_id": userID.String()}).
+		OrderBy(primaryTeamOrder...).
+		Limit(1).
 		ToSql()
 	if err != nil {
 		t.logger.Errorw("Failed to build SQL query for getting team by user ID", "error", err)
@@ -43,7 +52,8 @@ func (t *teamStorage) GetTeamByUserID(ctx context.Context, userID domain.UserID)
 	}
 
 	var team models.Team
-	err = tx.QueryRow(ctx, query, args...).Scan(&team.ID, &team.TeamName, &team.CreatedAt)
+	err = tx.QueryRow(ctx, query, args...).Scan(&team.ID, &team.TeamName, &team.CreatedAt, &team.MinReviewers, &team.MaxReviewers, &team.ReviewerStrategy,
+		&team.MergePolicy.MinApprovals, &team.MergePolicy.RequireAllResponded, &team.MergePolicy.ForbidSelfMerge, &team.MergePolicy.MinAgeSeconds)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			t.logger.Warnw("Team not found for user", "user_id", userID)
@@ -103,7 +113,8 @@ func (t *teamStorage) CreateTeam(ctx context.Context, teamName string) (*models.
 		Insert("teams").
 		Columns("team_name", "created_at").
 		Values(teamName, squirrel.Expr("NOW()")).
-		Suffix("RETURNING id, team_name, created_at").
+		Suffix("RETURNING id, team_name, created_at, min_reviewers, max_reviewers, reviewer_strategy, " +
+			"min_approvals, require_all_responded, forbid_self_merge, min_pr_age_seconds").
 		ToSql()
 	if err != nil {
 		t.logger.Errorw("Failed to build SQL query for creating team", "error", err)
@@ -111,7 +122,8 @@ func (t *teamStorage) CreateTeam(ctx context.Context, teamName string) (*models.
 	}
 
 	var team models.Team
-	err = tx.QueryRow(ctx, query, args...).Scan(&team.ID, &team.TeamName, &team.CreatedAt)
+	err = tx.QueryRow(ctx, query, args...).Scan(&team.ID, &team.TeamName, &team.CreatedAt, &team.MinReviewers, &team.MaxReviewers, &team.ReviewerStrategy,
+		&team.MergePolicy.MinApprovals, &team.MergePolicy.RequireAllResponded, &team.MergePolicy.ForbidSelfMerge, &team.MergePolicy.MinAgeSeconds)
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
@@ -131,10 +143,12 @@ func (t *teamStorage) CreateTeam(ctx context.Context, teamName string) (*models.
 func (t *teamStorage) CreateUserTeamInstance(ctx context.Context, teamID domain.TeamID, userID domain.UserID) error {
 	tx := t.txmanager.GetExecutor(ctx)
 
+	// Первая команда пользователя становится основной.
 	query, args, err := t.sq.
 		Insert("user_teams").
-		Columns("team_id", "user_id", "joined_at").
-		Values(teamID.Int64(), userID.String(), squirrel.Expr("NOW()")).
+		Columns("team_id", "user_id", "joined_at", "is_primary").
+		Values(teamID.Int64(), userID.String(), squirrel.Expr("NOW()"),
+			squirrel.Expr("NOT EXISTS (SELECT 1 FROM user_teams WHERE user_id = ? AND is_primary)", userID.String())).
 		ToSql()
 	if err != nil {
 		t.logger.Errorw("Failed to build SQL query for creating user-team instance", "error", err)
@@ -158,10 +172,75 @@ func (t *teamStorage) CreateUserTeamInstance(ctx context.Context, teamID domain.
 	return nil
 }
 
+func (t *teamStorage) DeleteUserTeamInstance(ctx context.Context, teamID domain.TeamID, userID domain.UserID) error {
+	tx := t.txmanager.GetExecutor(ctx)
+
+	query, args, err := t.sq.
+		Delete("user_teams").
+		Where(squirrel.Eq{"team_id": teamID.Int64()}).
+		Where(squirrel.Eq{"user_id": userID.String()}).
+		ToSql()
+	if err != nil {
+		t.logger.Errorw("Failed to build SQL query for deleting user-team instance", "error", err)
+		return err
+	}
+
+	result, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		t.logger.Errorw("Failed to delete user-team instance", "team_id", teamID, "user_id", userID, "error", err)
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		t.logger.Warnw("User-team instance not found", "team_id", teamID, "user_id", userID)
+		return errs.ErrNotFound
+	}
+
+	if err := t.promotePrimaryTeams(ctx, &userID); err != nil {
+		return err
+	}
+
+	t.logger.Infow("Successfully deleted user-team instance", "team_id", teamID, "user_id", userID)
+	return nil
+}
+
+// promotePrimaryTeams делает основной самую раннюю команду пользователей, оставшихся без основной.
+// userID ограничивает выборку одним пользователем, nil — все пользователи.
+func (t *teamStorage) promotePrimaryTeams(ctx context.Context, userID *domain.UserID) error {
+	tx := t.txmanager.GetExecutor(ctx)
+
+	earliest := squirrel.
+		Select("DISTINCT ON (ut.user_id) ut.user_id", "ut.team_id").
+		From("user_teams ut").
+		Where("NOT EXISTS (SELECT 1 FROM user_teams p WHERE p.user_id = ut.user_id AND p.is_primary)").
+		OrderBy("ut.user_id", "ut.joined_at", "ut.team_id")
+	if userID != nil {
+		earliest = earliest.Where(squirrel.Eq{"ut.user_id": userID.String()})
+	}
+
+	query, args, err := t.sq.
+		Update("user_teams").
+		Set("is_primary", true).
+		Where(squirrel.Expr("(user_id, team_id) IN (?)", earliest)).
+		ToSql()
+	if err != nil {
+		t.logger.Errorw("Failed to build SQL query for promoting primary teams", "error", err)
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		t.logger.Errorw("Failed to promote primary teams", "error", err)
+		return err
+	}
+
+	return nil
+}
+
 func (t *teamStorage) GetTeamByID(ctx context.Context, teamID domain.TeamID) (*models.Team, error) {
 	tx := t.txmanager.GetExecutor(ctx)
 	query, args, err := t.sq.
-		Select("id", "team_name", "created_at").
+		Select("id", "team_name", "created_at", "min_reviewers", "max_reviewers", "reviewer_strategy",
+			"min_approvals", "require_all_responded", "forbid_self_merge", "min_pr_age_seconds").
 		From("teams").
 		Where(squirrel.Eq{"id": teamID.Int64()}).
 		ToSql()
@@ -171,7 +250,8 @@ func (t *teamStorage) GetTeamByID(ctx context.Context, teamID domain.TeamID) (*m
 	}
 
 	var team models.Team
-	err = tx.QueryRow(ctx, query, args...).Scan(&team.ID, &team.TeamName, &team.CreatedAt)
+	err = tx.QueryRow(ctx, query, args...).Scan(&team.ID, &team.TeamName, &team.CreatedAt, &team.MinReviewers, &team.MaxReviewers, &team.ReviewerStrategy,
+		&team.MergePolicy.MinApprovals, &team.MergePolicy.RequireAllResponded, &team.MergePolicy.ForbidSelfMerge, &team.MergePolicy.MinAgeSeconds)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			t.logger.Warnw("Team not found by ID", "team_id", teamID)
@@ -188,7 +268,8 @@ func (t *teamStorage) GetTeamByID(ctx context.Context, teamID domain.TeamID) (*m
 func (t *teamStorage) GetTeamByName(ctx context.Context, teamName string) (*models.Team, error) {
 	tx := t.txmanager.GetExecutor(ctx)
 	query, args, err := t.sq.
-		Select("id", "team_name", "created_at").
+		Select("id", "team_name", "created_at", "min_reviewers", "max_reviewers", "reviewer_strategy",
+			"min_approvals", "require_all_responded", "forbid_self_merge", "min_pr_age_seconds").
 		From("teams").
 		Where(squirrel.Eq{"team_name": teamName}).
 		ToSql()
@@ -198,7 +279,8 @@ func (t *teamStorage) GetTeamByName(ctx context.Context, teamName string) (*mode
 	}
 
 	var team models.Team
-	err = tx.QueryRow(ctx, query, args...).Scan(&team.ID, &team.TeamName, &team.CreatedAt)
+	err = tx.QueryRow(ctx, query, args...).Scan(&team.ID, &team.TeamName, &team.CreatedAt, &team.MinReviewers, &team.MaxReviewers, &team.ReviewerStrategy,
+		&team.MergePolicy.MinApprovals, &team.MergePolicy.RequireAllResponded, &team.MergePolicy.ForbidSelfMerge, &team.MergePolicy.MinAgeSeconds)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			t.logger.Warnw("Team not found by name", "team_name", teamName)
@@ -211,3 +293,391 @@ func (t *teamStorage) GetTeamByName(ctx context.Context, teamName string) (*mode
 	t.logger.Infow("Successfully retrieved team by name", "team_id", team.ID, "team_name", teamName)
 	return &team, nil
 }
+
+func (t *teamStorage) UpdateTeamSettings(ctx context.Context, teamID domain.TeamID, settings models.TeamSettings) error {
+	tx := t.txmanager.GetExecutor(ctx)
+
+	query, args, err := t.sq.
+		Update("teams").
+		Set("min_reviewers", settings.MinReviewers).
+		Set("max_reviewers", settings.MaxReviewers).
+		Set("reviewer_strategy", settings.ReviewerStrategy).
+		Set("min_approvals", settings.MergePolicy.MinApprovals).
+		Set("require_all_responded", settings.MergePolicy.RequireAllResponded).
+		Set("forbid_self_merge", settings.MergePolicy.ForbidSelfMerge).
+		Set("min_pr_age_seconds", settings.MergePolicy.MinAgeSeconds).
+		Where(squirrel.Eq{"id": teamID.Int64()}).
+		ToSql()
+	if err != nil {
+		t.logger.Errorw("Failed to build SQL query for updating team settings", "error", err)
+		return err
+	}
+
+	result, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23514" {
+				t.logger.Warnw("Team settings violate constraint", "team_id", teamID, "constraint", pgErr.ConstraintName)
+				return errs.ErrInvalidInput
+			}
+		}
+		t.logger.Errorw("Failed to update team settings", "team_id", teamID, "error", err)
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		t.logger.Warnw("No team found to update settings", "team_id", teamID)
+		return errs.ErrNotFound
+	}
+
+	t.logger.Infow("Successfully updated team settings", "team_id", teamID,
+		"min_reviewers", settings.MinReviewers, "max_reviewers", settings.MaxReviewers)
+	return nil
+}
+
+// GetFallbackTeams возвращает запасные команды в порядке приоритета.
+func (t *teamStorage) GetFallbackTeams(ctx context.Context, teamID domain.TeamID) ([]models.Team, error) {
+	tx := t.txmanager.GetExecutor(ctx)
+	query, args, err := t.sq.
+		Select("t.id", "t.team_name", "t.created_at", "t.min_reviewers", "t.max_reviewers", "t.reviewer_strategy",
+			"t.min_approvals", "t.require_all_responded", "t.forbid_self_merge", "t.min_pr_age_seconds").
+		From("team_fallback_teams tf").
+		Join("teams t ON t.id = tf.fallback_team_id").
+		Where(squirrel.Eq{"tf.team_id": teamID.Int64()}).
+		OrderBy("tf.priority").
+		ToSql()
+	if err != nil {
+		t.logger.Errorw("Failed to build SQL query for getting fallback teams", "error", err)
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		t.logger.Errorw("Failed to get fallback teams", "team_id", teamID, "error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var teams []models.Team
+	for rows.Next() {
+		var team models.Team
+		if err := rows.Scan(&team.ID, &team.TeamName, &team.CreatedAt, &team.MinReviewers, &team.MaxReviewers, &team.ReviewerStrategy,
+			&team.MergePolicy.MinApprovals, &team.MergePolicy.RequireAllResponded, &team.MergePolicy.ForbidSelfMerge, &team.MergePolicy.MinAgeSeconds); err != nil {
+			t.logger.Errorw("Failed to scan fallback team row", "team_id", teamID, "error", err)
+			return nil, err
+		}
+		teams = append(teams, team)
+	}
+
+	if err := rows.Err(); err != nil {
+		t.logger.Errorw("Error during rows iteration for fallback teams", "team_id", teamID, "error", err)
+		return nil, err
+	}
+
+	t.logger.Infow("Successfully retrieved fallback teams", "team_id", teamID, "count", len(teams))
+	return teams, nil
+}
+
+// ReplaceFallbackTeams заменяет список запасных команд; приоритет задаётся порядком fallbackTeamIDs.
+func (t *teamStorage) ReplaceFallbackTeams(ctx context.Context, teamID domain.TeamID, fallbackTeamIDs []domain.TeamID) error {
+	tx := t.txmanager.GetExecutor(ctx)
+
+	query, args, err := t.sq.
+		Delete("team_fallback_teams").
+		Where(squirrel.Eq{"team_id": teamID.Int64()}).
+		ToSql()
+	if err != nil {
+		t.logger.Errorw("Failed to build SQL query for deleting fallback teams", "error", err)
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		t.logger.Errorw("Failed to delete fallback teams", "team_id", teamID, "error", err)
+		return err
+	}
+
+	if len(fallbackTeamIDs) == 0 {
+		t.logger.Infow("Successfully cleared fallback teams", "team_id", teamID)
+		return nil
+	}
+
+	insert := t.sq.
+		Insert("team_fallback_teams").
+		Columns("team_id", "fallback_team_id", "priority")
+	for priority, fallbackTeamID := range fallbackTeamIDs {
+		insert = insert.Values(teamID.Int64(), fallbackTeamID.Int64(), priority)
+	}
+
+	query, args, err = insert.ToSql()
+	if err != nil {
+		t.logger.Errorw("Failed to build SQL query for inserting fallback teams", "error", err)
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23514" || pgErr.Code == "23505" || pgErr.Code == "23503" {
+				t.logger.Warnw("Fallback teams violate constraint", "team_id", teamID, "constraint", pgErr.ConstraintName)
+				return errs.ErrInvalidInput
+			}
+		}
+		t.logger.Errorw("Failed to insert fallback teams", "team_id", teamID, "error", err)
+		return err
+	}
+
+	t.logger.Infow("Successfully replaced fallback teams", "team_id", teamID, "count", len(fallbackTeamIDs))
+	return nil
+}
+
+func (t *teamStorage) ListTeams(ctx context.Context, afterName *string, limit int) ([]models.TeamSummary, error) {
+	tx := t.txmanager.GetExecutor(ctx)
+
+	builder := t.sq.
+		Select("t.id", "t.team_name", "t.created_at", "COUNT(ut.user_id)").
+		From("teams t").
+		LeftJoin("user_teams ut ON ut.team_id = t.id").
+		GroupBy("t.id").
+		OrderBy("t.team_name").
+		Limit(uint64(limit))
+
+	if afterName != nil {
+		builder = builder.Where(squirrel.Gt{"t.team_name": *afterName})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		t.logger.Errorw("Failed to build SQL query for listing teams", "error", err)
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		t.logger.Errorw("Failed to list teams", "error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var teams []models.TeamSummary
+	for rows.Next() {
+		var team models.TeamSummary
+		if err := rows.Scan(&team.ID, &team.TeamName, &team.CreatedAt, &team.MemberCount); err != nil {
+			t.logger.Errorw("Failed to scan team row", "error", err)
+			return nil, err
+		}
+		teams = append(teams, team)
+	}
+
+	if err := rows.Err(); err != nil {
+		t.logger.Errorw("Error during rows iteration for teams", "error", err)
+		return nil, err
+	}
+
+	t.logger.Infow("Successfully listed teams", "count", len(teams))
+	return teams, nil
+}
+
+func (t *teamStorage) RenameTeam(ctx context.Context, teamID domain.TeamID, teamName string) error {
+	tx := t.txmanager.GetExecutor(ctx)
+
+	query, args, err := t.sq.
+		Update("teams").
+		Set("team_name", teamName).
+		Where(squirrel.Eq{"id": teamID.Int64()}).
+		ToSql()
+	if err != nil {
+		t.logger.Errorw("Failed to build SQL query for renaming team", "error", err)
+		return err
+	}
+
+	result, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23505" {
+				t.logger.Warnw("Team already exists", "team_name", teamName)
+				return errs.ErrAlreadyExists
+			}
+		}
+		t.logger.Errorw("Failed to rename team", "team_id", teamID, "team_name", teamName, "error", err)
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		t.logger.Warnw("Team not found for rename", "team_id", teamID)
+		return errs.ErrNotFound
+	}
+
+	t.logger.Infow("Successfully renamed team", "team_id", teamID, "team_name", teamName)
+	return nil
+}
+
+func (t *teamStorage) DeleteTeam(ctx context.Context, teamID domain.TeamID) error {
+	tx := t.txmanager.GetExecutor(ctx)
+
+	query, args, err := t.sq.
+		Delete("teams").
+		Where(squirrel.Eq{"id": teamID.Int64()}).
+		ToSql()
+	if err != nil {
+		t.logger.Errorw("Failed to build SQL query for deleting team", "error", err)
+		return err
+	}
+
+	result, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		t.logger.Errorw("Failed to delete team", "team_id", teamID, "error", err)
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		t.logger.Warnw("Team not found for delete", "team_id", teamID)
+		return errs.ErrNotFound
+	}
+
+	// Членства удаляются каскадно; у тех, для кого команда была основной, основной становится следующая.
+	if err := t.promotePrimaryTeams(ctx, nil); err != nil {
+		return err
+	}
+
+	t.logger.Infow("Successfully deleted team", "team_id", teamID)
+	return nil
+}
+
+// GetOpenPullRequestIDs возвращает OPEN PR команды: явно направленные в неё и PR без команды,
+// у авторов которых она основная.
+func (t *teamStorage) GetOpenPullRequestIDs(ctx context.Context, teamID domain.TeamID) ([]domain.PRID, error) {
+	tx := t.txmanager.GetExecutor(ctx)
+
+	query, args, err := t.sq.
+		Select("pr.id").
+		From("pull_requests pr").
+		Where(squirrel.Or{
+			squirrel.Eq{"pr.team_id": teamID.Int64()},
+			squirrel.And{
+				squirrel.Eq{"pr.team_id": nil},
+				squirrel.Expr("(SELECT ut.team_id FROM user_teams ut WHERE ut.user_id = pr.author_id "+
+					"ORDER BY "+strings.Join(primaryTeamOrder, ", ")+" LIMIT 1) = ?", teamID.Int64()),
+			},
+		}).
+		Where(squirrel.Eq{"pr.status": domain.PRStatusOpen}).
+		OrderBy("pr.id").
+		ToSql()
+	if err != nil {
+		t.logger.Errorw("Failed to build SQL query for getting open pull requests of team", "error", err)
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		t.logger.Errorw("Failed to get open pull requests of team", "team_id", teamID, "error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var prIDs []domain.PRID
+	for rows.Next() {
+		var prID domain.PRID
+		if err := rows.Scan(&prID); err != nil {
+			t.logger.Errorw("Failed to scan pull request ID", "team_id", teamID, "error", err)
+			return nil, err
+		}
+		prIDs = append(prIDs, prID)
+	}
+
+	if err := rows.Err(); err != nil {
+		t.logger.Errorw("Error during rows iteration for open pull requests of team", "team_id", teamID, "error", err)
+		return nil, err
+	}
+
+	t.logger.Infow("Successfully retrieved open pull requests of team", "team_id", teamID, "count", len(prIDs))
+	return prIDs, nil
+}
+
+// GetTeamsByUserID возвращает все членства пользователя, основная команда — первая.
+func (t *teamStorage) GetTeamsByUserID(ctx context.Context, userID domain.UserID) ([]models.TeamMembership, error) {
+	tx := t.txmanager.GetExecutor(ctx)
+
+	query, args, err := t.sq.
+		Select("t.id", "t.team_name", "ut.is_primary", "ut.joined_at").
+		From("teams t").
+		Join("user_teams ut ON t.id = ut.team_id").
+		Where(squirrel.Eq{"ut.user_id": userID.String()}).
+		OrderBy(primaryTeamOrder...).
+		ToSql()
+	if err != nil {
+		t.logger.Errorw("Failed to build SQL query for getting teams by user ID", "error", err)
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		t.logger.Errorw("Failed to get teams by user ID", "user_id", userID, "error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var memberships []models.TeamMembership
+	for rows.Next() {
+		var membership models.TeamMembership
+		if err := rows.Scan(&membership.TeamID, &membership.TeamName, &membership.IsPrimary, &membership.JoinedAt); err != nil {
+			t.logger.Errorw("Failed to scan team membership row", "user_id", userID, "error", err)
+			return nil, err
+		}
+		memberships = append(memberships, membership)
+	}
+
+	if err := rows.Err(); err != nil {
+		t.logger.Errorw("Error during rows iteration for team memberships", "user_id", userID, "error", err)
+		return nil, err
+	}
+
+	t.logger.Infow("Successfully retrieved teams by user ID", "user_id", userID, "count", len(memberships))
+	return memberships, nil
+}
+
+func (t *teamStorage) SetPrimaryTeam(ctx context.Context, userID domain.UserID, teamID domain.TeamID) error {
+	tx := t.txmanager.GetExecutor(ctx)
+
+	query, args, err := t.sq.
+		Update("user_teams").
+		Set("is_primary", false).
+		Where(squirrel.Eq{"user_id": userID.String()}).
+		Where(squirrel.Eq{"is_primary": true}).
+		ToSql()
+	if err != nil {
+		t.logger.Errorw("Failed to build SQL query for resetting primary team", "error", err)
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		t.logger.Errorw("Failed to reset primary team", "user_id", userID, "error", err)
+		return err
+	}
+
+	query, args, err = t.sq.
+		Update("user_teams").
+		Set("is_primary", true).
+		Where(squirrel.Eq{"user_id": userID.String()}).
+		Where(squirrel.Eq{"team_id": teamID.Int64()}).
+		ToSql()
+	if err != nil {
+		t.logger.Errorw("Failed to build SQL query for setting primary team", "error", err)
+		return err
+	}
+
+	result, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		t.logger.Errorw("Failed to set primary team", "user_id", userID, "team_id", teamID, "error", err)
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		t.logger.Warnw("User-team instance not found for primary team", "user_id", userID, "team_id", teamID)
+		return errs.ErrNotFound
+	}
+
+	t.logger.Infow("Successfully set primary team", "user_id", userID, "team_id", teamID)
+	return nil
+}
@@ -70,6 +70,7 @@ func (u *userStorage) GetActiveUsersByTeam(ctx context.Context, teamID domain.Te
 		Join("user_teams ut ON u.id = ut.user_id").
 		Where(squirrel.Eq{"ut.team_id": teamID.Int64()}).
 		Where(squirrel.Eq{"u.is_active": true}).
+		Where("u.deleted_at IS NULL").
 		ToSql()
 	if err != nil {
 		u.logger.Errorw("Failed to build SQL query for getting active users by team", "error", err)
@@ -109,6 +110,7 @@ func (u *userStorage) GetUserByID(ctx context.Context, userID domain.UserID) (*m
 		Select("id", "is_active", "name").
 		From("users").
 		Where(squirrel.Eq{"id": userID.String()}).
+		Where("deleted_at IS NULL").
 		ToSql()
 	if err != nil {
 		u.logger.Errorw("Failed to build SQL query for getting user by ID", "error", err)
@@ -157,3 +159,250 @@ func (u *userStorage) UpdateActivity(ctx context.Context, userID domain.UserID,
 	u.logger.Infow("Successfully updated user activity", "user_id", userID, "status", statusActivity)
 	return nil
 }
+
+func (u *userStorage) UpdateName(ctx context.Context, userID domain.UserID, name string) error {
+	tx := u.txmanager.GetExecutor(ctx)
+
+	query, args, err := u.sq.
+		Update("users").
+		Set("name", name).
+		Where(squirrel.Eq{"id": userID.String()}).
+		ToSql()
+	if err != nil {
+		u.logger.Errorw("Failed to build SQL query for updating user name", "error", err)
+		return err
+	}
+
+	result, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		u.logger.Errorw("Failed to update user name", "user_id", userID, "error", err)
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		u.logger.Warnw("No user found to update name", "user_id", userID)
+		return errs.ErrNotFound
+	}
+
+	u.logger.Infow("Successfully updated user name", "user_id", userID)
+	return nil
+}
+
+func (u *userStorage) GetSkillsByUserIDs(ctx context.Context, userIDs []domain.UserID) (map[domain.UserID][]string, error) {
+	tx := u.txmanager.GetExecutor(ctx)
+
+	ids := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		ids = append(ids, userID.String())
+	}
+
+	query, args, err := u.sq.
+		Select("user_id", "skill").
+		From("user_skills").
+		Where(squirrel.Eq{"user_id": ids}).
+		OrderBy("user_id", "skill").
+		ToSql()
+	if err != nil {
+		u.logger.Errorw("Failed to build SQL query for getting user skills", "error", err)
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		u.logger.Errorw("Failed to get user skills", "user_ids", userIDs, "error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	skills := make(map[domain.UserID][]string)
+	for rows.Next() {
+		var userID domain.UserID
+		var skill string
+		if err := rows.Scan(&userID, &skill); err != nil {
+			u.logger.Errorw("Failed to scan user skill row", "error", err)
+			return nil, err
+		}
+		skills[userID] = append(skills[userID], skill)
+	}
+
+	if err := rows.Err(); err != nil {
+		u.logger.Errorw("Error during rows iteration for user skills", "error", err)
+		return nil, err
+	}
+
+	u.logger.Infow("Successfully retrieved user skills", "count", len(skills))
+	return skills, nil
+}
+
+func (u *userStorage) AddUserSkills(ctx context.Context, userID domain.UserID, skills []string) error {
+	tx := u.txmanager.GetExecutor(ctx)
+
+	insert := u.sq.
+		Insert("user_skills").
+		Columns("user_id", "skill")
+	for _, skill := range skills {
+		insert = insert.Values(userID.String(), skill)
+	}
+
+	query, args, err := insert.Suffix("ON CONFLICT DO NOTHING").ToSql()
+	if err != nil {
+		u.logger.Errorw("Failed to build SQL query for adding user skills", "error", err)
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == "23503" {
+				u.logger.Warnw("User not found for skills", "user_id", userID)
+				return errs.ErrNotFound
+			}
+		}
+		u.logger.Errorw("Failed to add user skills", "user_id", userID, "error", err)
+		return err
+	}
+
+	u.logger.Infow("Successfully added user skills", "user_id", userID, "count", len(skills))
+	return nil
+}
+
+func (u *userStorage) DeleteUserSkills(ctx context.Context, userID domain.UserID, skills []string) error {
+	tx := u.txmanager.GetExecutor(ctx)
+
+	query, args, err := u.sq.
+		Delete("user_skills").
+		Where(squirrel.Eq{"user_id": userID.String()}).
+		Where(squirrel.Eq{"skill": skills}).
+		ToSql()
+	if err != nil {
+		u.logger.Errorw("Failed to build SQL query for deleting user skills", "error", err)
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		u.logger.Errorw("Failed to delete user skills", "user_id", userID, "error", err)
+		return err
+	}
+
+	u.logger.Infow("Successfully deleted user skills", "user_id", userID, "count", len(skills))
+	return nil
+}
+
+func (u *userStorage) ListUsers(ctx context.Context, teamID *domain.TeamID, isActive *bool, afterID *domain.UserID, limit int) ([]models.User, error) {
+	tx := u.txmanager.GetExecutor(ctx)
+
+	builder := u.sq.
+		Select("u.id", "u.is_active", "u.name").
+		From("users u").
+		Where("u.deleted_at IS NULL").
+		OrderBy("u.id").
+		Limit(uint64(limit))
+
+	if teamID != nil {
+		builder = builder.Where("EXISTS (SELECT 1 FROM user_teams ut WHERE ut.user_id = u.id AND ut.team_id = ?)", teamID.Int64())
+	}
+
+	if isActive != nil {
+		builder = builder.Where(squirrel.Eq{"u.is_active": *isActive})
+	}
+
+	if afterID != nil {
+		builder = builder.Where(squirrel.Gt{"u.id": afterID.String()})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		u.logger.Errorw("Failed to build SQL query for listing users", "error", err)
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		u.logger.Errorw("Failed to list users", "error", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []models.User
+	for rows.Next() {
+		var user models.User
+		if err := rows.Scan(&user.ID, &user.StatusActivity, &user.Name); err != nil {
+			u.logger.Errorw("Failed to scan user row", "error", err)
+			return nil, err
+		}
+		users = append(users, user)
+	}
+
+	if err := rows.Err(); err != nil {
+		u.logger.Errorw("Error during rows iteration for users", "error", err)
+		return nil, err
+	}
+
+	u.logger.Infow("Successfully listed users", "count", len(users))
+	return users, nil
+}
+
+// SoftDeleteUser помечает пользователя удалённым и выключает его; строка остаётся, чтобы не терять историю PR.
+func (u *userStorage) SoftDeleteUser(ctx context.Context, userID domain.UserID) error {
+	tx := u.txmanager.GetExecutor(ctx)
+
+	query, args, err := u.sq.
+		Update("users").
+		Set("is_active", false).
+		Set("deleted_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"id": userID.String()}).
+		Where("deleted_at IS NULL").
+		ToSql()
+	if err != nil {
+		u.logger.Errorw("Failed to build SQL query for deleting user", "error", err)
+		return err
+	}
+
+	result, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		u.logger.Errorw("Failed to delete user", "user_id", userID, "error", err)
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		u.logger.Warnw("No user found to delete", "user_id", userID)
+		return errs.ErrNotFound
+	}
+
+	u.logger.Infow("Successfully deleted user", "user_id", userID)
+	return nil
+}
+
+// CreateOrRestoreUser создаёт пользователя, а мягко удалённого с тем же id восстанавливает: снимает deleted_at,
+// включает и переименовывает. Всё делается одним INSERT ... ON CONFLICT, поэтому конфликт по id не обрывает
+// внешнюю транзакцию. Для существующего неудалённого пользователя возвращает ErrAlreadyExists.
+func (u *userStorage) CreateOrRestoreUser(ctx context.Context, userID domain.UserID, name string) (*models.User, error) {
+	tx := u.txmanager.GetExecutor(ctx)
+
+	query, args, err := u.sq.
+		Insert("users").
+		Columns("id", "name").
+		Values(userID.String(), name).
+		Suffix("ON CONFLICT (id) DO UPDATE SET name = EXCLUDED.name, is_active = TRUE, deleted_at = NULL " +
+			"WHERE users.deleted_at IS NOT NULL RETURNING id, is_active, name").
+		ToSql()
+	if err != nil {
+		u.logger.Errorw("Failed to build SQL query for creating or restoring user", "error", err)
+		return nil, err
+	}
+
+	var user models.User
+	err = tx.QueryRow(ctx, query, args...).Scan(&user.ID, &user.StatusActivity, &user.Name)
+	if err != nil {
+		// Строка есть и не удалена: условие DO UPDATE не выполнилось, и RETURNING ничего не вернул.
+		if errors.Is(err, pgx.ErrNoRows) {
+			u.logger.Warnw("User already exists", "user_id", userID, "name", name)
+			return nil, errs.ErrAlreadyExists
+		}
+		u.logger.Errorw("Failed to create or restore user", "user_id", userID, "name", name, "error", err)
+		return nil, err
+	}
+
+	u.logger.Infow("Successfully created or restored user", "user_id", userID, "name", name)
+	return &user, nil
+}
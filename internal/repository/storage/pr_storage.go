@@ -9,12 +9,20 @@ import (
 
 //go:generate mockgen -source=pr_storage.go -destination=mock/pr_storage_mock.go -package=mock
 type PRStorage interface {
-	CreatePullRequest(ctx context.Context, prID domain.PRID, prName string, prAuthorID domain.UserID) (*models.PullRequest, error)
+	CreatePullRequest(ctx context.Context, prID domain.PRID, prName string, prAuthorID domain.UserID, status domain.PRStatus) (*models.PullRequest, error)
 	GetPullRequestByID(ctx context.Context, prID domain.PRID) (*models.PullRequest, error)
-	GetPullRequestsByReviewerID(ctx context.Context, reviewerID domain.UserID) ([]models.PullRequest, error)
+	GetPullRequestsByReviewerID(ctx context.Context, reviewerID domain.UserID, filter domain.PullRequestFilter) ([]models.PullRequest, error)
+	GetPullRequestsByAuthorID(ctx context.Context, authorID domain.UserID, filter domain.PullRequestFilter) ([]models.PullRequest, error)
 	GetAllOpenPullRequests(ctx context.Context) ([]models.PullRequest, error)
-	UpdatePullRequestStatus(ctx context.Context, prID domain.PRID,  status domain.PRStatus) error
-	CreatePRReviewerInstance(ctx context.Context, prID domain.PRID, reviewerID domain.UserID) error
+	UpdatePullRequestStatus(ctx context.Context, prID domain.PRID, from domain.PRStatus, to domain.PRStatus) error
+	MergePullRequest(ctx context.Context, prID domain.PRID, mergedBy *domain.UserID) (*models.PullRequest, error)
+	MarkPullRequestReady(ctx context.Context, prID domain.PRID) (*models.PullRequest, error)
+	UpdateNeedMoreReviewers(ctx context.Context, prID domain.PRID, needMoreReviewers bool) error
+	SetPullRequestTeam(ctx context.Context, prID domain.PRID, teamID domain.TeamID) error
+	CreatePRReviewerInstance(ctx context.Context, prID domain.PRID, reviewerID domain.UserID, sourceTeamID *domain.TeamID) error
 	DeletePRReviewerInstance(ctx context.Context, prID domain.PRID, reviewerID domain.UserID) error
+	UpdateReviewState(ctx context.Context, prID domain.PRID, reviewerID domain.UserID, state domain.ReviewState) error
 	GetReviewersFromPR(ctx context.Context, prID domain.PRID) ([]models.User, error)
+	GetParticipantsByPRIDs(ctx context.Context, prIDs []domain.PRID) (map[domain.UserID]models.User, map[domain.PRID][]models.User, error)
+	CountOpenReviewsByReviewerIDs(ctx context.Context, reviewerIDs []domain.UserID) (map[domain.UserID]int, error)
 }
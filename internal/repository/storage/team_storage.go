@@ -12,6 +12,16 @@ type TeamStorage interface {
 	GetTeamByID(ctx context.Context, teamID domain.TeamID) (*models.Team, error)
 	GetTeamByName(ctx context.Context, teamName string) (*models.Team, error)
 	GetTeamByUserID(ctx context.Context, userID domain.UserID) (*models.Team, error)
+	GetTeamsByUserID(ctx context.Context, userID domain.UserID) ([]models.TeamMembership, error)
+	SetPrimaryTeam(ctx context.Context, userID domain.UserID, teamID domain.TeamID) error
+	ListTeams(ctx context.Context, afterName *string, limit int) ([]models.TeamSummary, error)
+	RenameTeam(ctx context.Context, teamID domain.TeamID, teamName string) error
+	DeleteTeam(ctx context.Context, teamID domain.TeamID) error
+	GetOpenPullRequestIDs(ctx context.Context, teamID domain.TeamID) ([]domain.PRID, error)
 	CreateUserTeamInstance(ctx context.Context, teamID domain.TeamID, userID domain.UserID) error
+	DeleteUserTeamInstance(ctx context.Context, teamID domain.TeamID, userID domain.UserID) error
 	GetUsersByTeam(ctx context.Context, teamID domain.TeamID) ([]models.User, error)
+	UpdateTeamSettings(ctx context.Context, teamID domain.TeamID, settings models.TeamSettings) error
+	GetFallbackTeams(ctx context.Context, teamID domain.TeamID) ([]models.Team, error)
+	ReplaceFallbackTeams(ctx context.Context, teamID domain.TeamID, fallbackTeamIDs []domain.TeamID) error
 }
\ No newline at end of file
@@ -12,4 +12,11 @@ type UserStorage interface {
 	GetUserByID(ctx context.Context, userID domain.UserID) (*models.User, error)
 	GetActiveUsersByTeam(ctx context.Context, teamID domain.TeamID) ([]models.User, error)
 	UpdateActivity(ctx context.Context, userID domain.UserID, isActive domain.UserActivityStatus) error
+	UpdateName(ctx context.Context, userID domain.UserID, name string) error
+	GetSkillsByUserIDs(ctx context.Context, userIDs []domain.UserID) (map[domain.UserID][]string, error)
+	AddUserSkills(ctx context.Context, userID domain.UserID, skills []string) error
+	DeleteUserSkills(ctx context.Context, userID domain.UserID, skills []string) error
+	ListUsers(ctx context.Context, teamID *domain.TeamID, isActive *bool, afterID *domain.UserID, limit int) ([]models.User, error)
+	SoftDeleteUser(ctx context.Context, userID domain.UserID) error
+	CreateOrRestoreUser(ctx context.Context, userID domain.UserID, name string) (*models.User, error)
 }
\ No newline at end of file
@@ -0,0 +1,128 @@
+package availability_usecase
+
+import (
+	"app/internal/domain"
+	"app/internal/mapper"
+	repositoryerrs "app/internal/repository/errs"
+	"app/internal/repository/storage"
+	"app/internal/usecase/errs"
+	"app/pkg/logger"
+	"app/pkg/txmanager"
+	"context"
+	"errors"
+	"time"
+)
+
+type AvailabilityUseCase interface {
+	AddUnavailability(ctx context.Context, userID domain.UserID, startsAt time.Time, endsAt time.Time, reason string) (*domain.Unavailability, error)
+	ListUnavailability(ctx context.Context, userID domain.UserID) ([]domain.Unavailability, error)
+	RemoveUnavailability(ctx context.Context, userID domain.UserID, unavailabilityID domain.UnavailabilityID) error
+}
+
+type availabilityUseCase struct {
+	availabilityStorage storage.AvailabilityStorage
+	userStorage         storage.UserStorage
+	txmanager           txmanager.TxManager
+	logger              logger.Logger
+}
+
+func NewAvailabilityUseCase(availabilityStorage storage.AvailabilityStorage, userStorage storage.UserStorage,
+	txmanager txmanager.TxManager, logger logger.Logger) AvailabilityUseCase {
+	return &availabilityUseCase{
+		availabilityStorage: availabilityStorage,
+		userStorage:         userStorage,
+		txmanager:           txmanager,
+		logger:              logger,
+	}
+}
+
+// AddUnavailability регистрирует окно недоступности; окно, которое уже закончилось, отклоняется.
+func (a *availabilityUseCase) AddUnavailability(ctx context.Context, userID domain.UserID, startsAt time.Time,
+	endsAt time.Time, reason string) (*domain.Unavailability, error) {
+	var window domain.Unavailability
+
+	if !endsAt.After(startsAt) || !endsAt.After(time.Now()) || len(reason) > 255 {
+		a.logger.Errorw("Invalid unavailability window", "userID", userID, "startsAt", startsAt, "endsAt", endsAt)
+		return nil, errs.ErrInvalidUnavailabilityWindow
+	}
+
+	if err := a.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadWrite,
+		func(ctx context.Context) error {
+			windowModel, err := a.availabilityStorage.CreateUnavailability(ctx, userID, startsAt, endsAt, reason)
+			if err != nil {
+				if errors.Is(err, repositoryerrs.ErrNotFound) {
+					a.logger.Errorw("User not found", "userID", userID)
+					return errs.ErrUserNotFound
+				}
+				if errors.Is(err, repositoryerrs.ErrInvalidInput) {
+					a.logger.Errorw("Unavailability window rejected by storage", "userID", userID)
+					return errs.ErrInvalidUnavailabilityWindow
+				}
+				a.logger.Errorw("Failed to create unavailability", "userID", userID, "error", err)
+				return err
+			}
+
+			window = mapper.ModelToDomainUnavailability(*windowModel)
+			return nil
+		}); err != nil {
+		return nil, err
+	}
+
+	a.logger.Infow("Successfully added unavailability", "userID", userID, "unavailabilityID", window.ID)
+
+	return &window, nil
+}
+
+// ListUnavailability возвращает действующие и будущие окна пользователя; закончившиеся не показываются.
+func (a *availabilityUseCase) ListUnavailability(ctx context.Context, userID domain.UserID) ([]domain.Unavailability, error) {
+	var windows []domain.Unavailability
+
+	if err := a.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadOnly,
+		func(ctx context.Context) error {
+			if _, err := a.userStorage.GetUserByID(ctx, userID); err != nil {
+				if errors.Is(err, repositoryerrs.ErrNotFound) {
+					a.logger.Errorw("User not found", "userID", userID)
+					return errs.ErrUserNotFound
+				}
+				a.logger.Errorw("Failed to get user by ID", "userID", userID, "error", err)
+				return err
+			}
+
+			windowModels, err := a.availabilityStorage.GetUnavailabilitiesByUserID(ctx, userID, time.Now())
+			if err != nil {
+				a.logger.Errorw("Failed to get unavailabilities", "userID", userID, "error", err)
+				return err
+			}
+
+			windows = mapper.ModelsToDomainUnavailabilities(windowModels)
+			return nil
+		}); err != nil {
+		return nil, err
+	}
+
+	a.logger.Infow("Successfully listed unavailability", "userID", userID, "count", len(windows))
+
+	return windows, nil
+}
+
+func (a *availabilityUseCase) RemoveUnavailability(ctx context.Context, userID domain.UserID,
+	unavailabilityID domain.UnavailabilityID) error {
+	if err := a.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadWrite,
+		func(ctx context.Context) error {
+			if err := a.availabilityStorage.DeleteUnavailability(ctx, userID, unavailabilityID); err != nil {
+				if errors.Is(err, repositoryerrs.ErrNotFound) {
+					a.logger.Errorw("Unavailability not found", "userID", userID, "unavailabilityID", unavailabilityID)
+					return errs.ErrUnavailabilityNotFound
+				}
+				a.logger.Errorw("Failed to delete unavailability", "userID", userID, "error", err)
+				return err
+			}
+			return nil
+		}); err != nil {
+		return err
+	}
+
+	a.logger.Infow("Successfully removed unavailability", "userID", userID, "unavailabilityID", unavailabilityID)
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package availability_usecase
+
+import (
+	"app/internal/domain"
+	repoerrors "app/internal/repository/errs"
+	"app/internal/repository/models"
+	"app/internal/repository/storage/mock"
+	"app/internal/usecase/errs"
+	loggermock "app/pkg/logger/mock"
+	txmock "app/pkg/txmanager/mock"
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAddUnavailability_InvalidWindow(t *testing.T) {
+	Convey("AddUnavailability rejects empty and already finished windows", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := loggermock.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		availabilityStorage := mock.NewMockAvailabilityStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		txmock := txmock.NewMockTxManager(ctrl)
+		uc := NewAvailabilityUseCase(availabilityStorage, userStorage, txmock, mockLog)
+		ctx := context.Background()
+
+		now := time.Now()
+
+		_, err := uc.AddUnavailability(ctx, "u1", now.Add(time.Hour), now.Add(time.Hour), "")
+		So(err, ShouldEqual, errs.ErrInvalidUnavailabilityWindow)
+
+		_, err = uc.AddUnavailability(ctx, "u1", now.Add(-48*time.Hour), now.Add(-24*time.Hour), "vacation")
+		So(err, ShouldEqual, errs.ErrInvalidUnavailabilityWindow)
+	})
+}
+
+func TestAddUnavailability_UserNotFound(t *testing.T) {
+	Convey("AddUnavailability user not found", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := loggermock.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		availabilityStorage := mock.NewMockAvailabilityStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		txmock := txmock.NewMockTxManager(ctrl)
+		uc := NewAvailabilityUseCase(availabilityStorage, userStorage, txmock, mockLog)
+		ctx := context.Background()
+
+		startsAt := time.Now()
+		endsAt := startsAt.Add(24 * time.Hour)
+
+		txmock.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, a, b any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		availabilityStorage.EXPECT().
+			CreateUnavailability(ctx, domain.UserID("u1"), startsAt, endsAt, "vacation").
+			Return(nil, repoerrors.ErrNotFound)
+
+		_, err := uc.AddUnavailability(ctx, "u1", startsAt, endsAt, "vacation")
+
+		So(err, ShouldEqual, errs.ErrUserNotFound)
+	})
+}
+
+func TestAddUnavailability_Success(t *testing.T) {
+	Convey("AddUnavailability success", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := loggermock.NewMockLogger(ctrl)
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		availabilityStorage := mock.NewMockAvailabilityStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		txmock := txmock.NewMockTxManager(ctrl)
+		uc := NewAvailabilityUseCase(availabilityStorage, userStorage, txmock, mockLog)
+		ctx := context.Background()
+
+		startsAt := time.Now().Add(24 * time.Hour)
+		endsAt := startsAt.Add(7 * 24 * time.Hour)
+
+		txmock.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, a, b any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		availabilityStorage.EXPECT().
+			CreateUnavailability(ctx, domain.UserID("u1"), startsAt, endsAt, "vacation").
+			Return(&models.Unavailability{ID: 3, UserID: "u1", StartsAt: startsAt, EndsAt: endsAt, Reason: "vacation"}, nil)
+
+		window, err := uc.AddUnavailability(ctx, "u1", startsAt, endsAt, "vacation")
+
+		So(err, ShouldBeNil)
+		So(window.ID, ShouldEqual, domain.UnavailabilityID(3))
+		So(window.EndsAt, ShouldEqual, endsAt)
+	})
+}
+
+func TestRemoveUnavailability_NotFound(t *testing.T) {
+	Convey("RemoveUnavailability window of another user is not found", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := loggermock.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		availabilityStorage := mock.NewMockAvailabilityStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		txmock := txmock.NewMockTxManager(ctrl)
+		uc := NewAvailabilityUseCase(availabilityStorage, userStorage, txmock, mockLog)
+		ctx := context.Background()
+
+		txmock.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, a, b any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		availabilityStorage.EXPECT().
+			DeleteUnavailability(ctx, domain.UserID("u2"), domain.UnavailabilityID(3)).
+			Return(repoerrors.ErrNotFound)
+
+		err := uc.RemoveUnavailability(ctx, "u2", domain.UnavailabilityID(3))
+
+		So(err, ShouldEqual, errs.ErrUnavailabilityNotFound)
+	})
+}
@@ -0,0 +1,150 @@
+package code_owner_usecase
+
+import (
+	"app/internal/domain"
+	"app/internal/mapper"
+	repositoryerrs "app/internal/repository/errs"
+	"app/internal/repository/storage"
+	"app/internal/usecase/errs"
+	"app/pkg/logger"
+	"app/pkg/txmanager"
+	"context"
+	"errors"
+)
+
+type CodeOwnerUseCase interface {
+	AddRule(ctx context.Context, pattern string, ownerUserID *domain.UserID, ownerTeam *string) (*domain.CodeOwnerRule, error)
+	ListRules(ctx context.Context) ([]domain.CodeOwnerRule, error)
+	RemoveRule(ctx context.Context, ruleID domain.CodeOwnerID) error
+}
+
+type codeOwnerUseCase struct {
+	codeOwnerStorage storage.CodeOwnerStorage
+	userStorage      storage.UserStorage
+	teamStorage      storage.TeamStorage
+	txmanager        txmanager.TxManager
+	logger           logger.Logger
+}
+
+func NewCodeOwnerUseCase(codeOwnerStorage storage.CodeOwnerStorage, userStorage storage.UserStorage,
+	teamStorage storage.TeamStorage, txmanager txmanager.TxManager, logger logger.Logger) CodeOwnerUseCase {
+	return &codeOwnerUseCase{
+		codeOwnerStorage: codeOwnerStorage,
+		userStorage:      userStorage,
+		teamStorage:      teamStorage,
+		txmanager:        txmanager,
+		logger:           logger,
+	}
+}
+
+// AddRule добавляет правило владения. Владелец — либо пользователь, либо команда.
+func (c *codeOwnerUseCase) AddRule(ctx context.Context, pattern string, ownerUserID *domain.UserID, ownerTeam *string) (*domain.CodeOwnerRule, error) {
+	var rule *domain.CodeOwnerRule
+
+	if !domain.ValidCodeOwnerPattern(pattern) {
+		c.logger.Errorw("Invalid code owner pattern", "pattern", pattern)
+		return nil, errs.ErrInvalidCodeOwnerPattern
+	}
+
+	if (ownerUserID == nil) == (ownerTeam == nil) {
+		c.logger.Errorw("Code owner rule must have exactly one owner", "pattern", pattern)
+		return nil, errs.ErrInvalidCodeOwner
+	}
+
+	if err := c.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadWrite,
+		func(ctx context.Context) error {
+			var ownerTeamID *domain.TeamID
+
+			if ownerUserID != nil {
+				if _, err := c.userStorage.GetUserByID(ctx, *ownerUserID); err != nil {
+					if errors.Is(err, repositoryerrs.ErrNotFound) {
+						c.logger.Errorw("Code owner user not found", "userID", *ownerUserID)
+						return errs.ErrUserNotFound
+					}
+					c.logger.Errorw("Failed to get user by ID", "userID", *ownerUserID, "error", err)
+					return err
+				}
+			}
+
+			if ownerTeam != nil {
+				team, err := c.teamStorage.GetTeamByName(ctx, *ownerTeam)
+				if err != nil {
+					if errors.Is(err, repositoryerrs.ErrNotFound) {
+						c.logger.Errorw("Code owner team not found", "teamName", *ownerTeam)
+						return errs.ErrTeamNotFound
+					}
+					c.logger.Errorw("Failed to get team by name", "teamName", *ownerTeam, "error", err)
+					return err
+				}
+				ownerTeamID = &team.ID
+			}
+
+			ruleModel, err := c.codeOwnerStorage.CreateCodeOwnerRule(ctx, pattern, ownerUserID, ownerTeamID)
+			if err != nil {
+				if errors.Is(err, repositoryerrs.ErrAlreadyExists) {
+					c.logger.Errorw("Code owner rule already exists", "pattern", pattern)
+					return errs.ErrCodeOwnerRuleAlreadyExists
+				}
+				c.logger.Errorw("Failed to create code owner rule", "pattern", pattern, "error", err)
+				return err
+			}
+
+			ruleModel.OwnerTeamName = ownerTeam
+			domainRule := mapper.ModelToDomainCodeOwnerRule(*ruleModel)
+			rule = &domainRule
+
+			return nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	c.logger.Infow("Successfully added code owner rule", "ruleID", rule.ID, "pattern", pattern)
+
+	return rule, nil
+}
+
+func (c *codeOwnerUseCase) ListRules(ctx context.Context) ([]domain.CodeOwnerRule, error) {
+	var rules []domain.CodeOwnerRule
+
+	if err := c.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadOnly,
+		func(ctx context.Context) error {
+			ruleModels, err := c.codeOwnerStorage.GetCodeOwnerRules(ctx)
+			if err != nil {
+				c.logger.Errorw("Failed to get code owner rules", "error", err)
+				return err
+			}
+
+			rules = mapper.ModelsToDomainCodeOwnerRules(ruleModels)
+			return nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	c.logger.Infow("Successfully listed code owner rules", "count", len(rules))
+
+	return rules, nil
+}
+
+func (c *codeOwnerUseCase) RemoveRule(ctx context.Context, ruleID domain.CodeOwnerID) error {
+	if err := c.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadWrite,
+		func(ctx context.Context) error {
+			if err := c.codeOwnerStorage.DeleteCodeOwnerRule(ctx, ruleID); err != nil {
+				if errors.Is(err, repositoryerrs.ErrNotFound) {
+					c.logger.Errorw("Code owner rule not found", "ruleID", ruleID)
+					return errs.ErrCodeOwnerRuleNotFound
+				}
+				c.logger.Errorw("Failed to delete code owner rule", "ruleID", ruleID, "error", err)
+				return err
+			}
+			return nil
+		},
+	); err != nil {
+		return err
+	}
+
+	c.logger.Infow("Successfully removed code owner rule", "ruleID", ruleID)
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package code_owner_usecase
+
+import (
+	"app/internal/domain"
+	repoerrors "app/internal/repository/errs"
+	"app/internal/repository/models"
+	"app/internal/repository/storage/mock"
+	"app/internal/usecase/errs"
+	loggermock "app/pkg/logger/mock"
+	txmock "app/pkg/txmanager/mock"
+	"context"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAddRule_InvalidOwner(t *testing.T) {
+	Convey("AddRule rejects rules without exactly one owner", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := loggermock.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		codeOwnerStorage := mock.NewMockCodeOwnerStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		txmock := txmock.NewMockTxManager(ctrl)
+		uc := NewCodeOwnerUseCase(codeOwnerStorage, userStorage, teamStorage, txmock, mockLog)
+		ctx := context.Background()
+
+		userID := domain.UserID("u1")
+		teamName := "backend"
+
+		_, err := uc.AddRule(ctx, "*.go", nil, nil)
+		So(err, ShouldEqual, errs.ErrInvalidCodeOwner)
+
+		_, err = uc.AddRule(ctx, "*.go", &userID, &teamName)
+		So(err, ShouldEqual, errs.ErrInvalidCodeOwner)
+
+		_, err = uc.AddRule(ctx, "/", &userID, nil)
+		So(err, ShouldEqual, errs.ErrInvalidCodeOwnerPattern)
+	})
+}
+
+func TestAddRule_TeamNotFound(t *testing.T) {
+	Convey("AddRule team owner not found", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := loggermock.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		codeOwnerStorage := mock.NewMockCodeOwnerStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		txmock := txmock.NewMockTxManager(ctrl)
+		uc := NewCodeOwnerUseCase(codeOwnerStorage, userStorage, teamStorage, txmock, mockLog)
+		ctx := context.Background()
+
+		teamName := "backend"
+
+		txmock.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, a, b any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		teamStorage.EXPECT().
+			GetTeamByName(ctx, teamName).
+			Return(nil, repoerrors.ErrNotFound)
+
+		_, err := uc.AddRule(ctx, "/internal/", nil, &teamName)
+
+		So(err, ShouldEqual, errs.ErrTeamNotFound)
+	})
+}
+
+func TestAddRule_Success(t *testing.T) {
+	Convey("AddRule stores a team-owned rule", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := loggermock.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		codeOwnerStorage := mock.NewMockCodeOwnerStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		txmock := txmock.NewMockTxManager(ctrl)
+		uc := NewCodeOwnerUseCase(codeOwnerStorage, userStorage, teamStorage, txmock, mockLog)
+		ctx := context.Background()
+
+		teamName := "backend"
+		teamID := domain.TeamID(3)
+
+		txmock.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, a, b any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		teamStorage.EXPECT().
+			GetTeamByName(ctx, teamName).
+			Return(&models.Team{ID: teamID, TeamName: teamName}, nil)
+
+		codeOwnerStorage.EXPECT().
+			CreateCodeOwnerRule(ctx, "/internal/", nil, &teamID).
+			Return(&models.CodeOwnerRule{ID: 7, Pattern: "/internal/", OwnerTeamID: &teamID}, nil)
+
+		rule, err := uc.AddRule(ctx, "/internal/", nil, &teamName)
+
+		So(err, ShouldBeNil)
+		So(rule.ID, ShouldEqual, domain.CodeOwnerID(7))
+		So(*rule.OwnerTeam, ShouldEqual, teamName)
+		So(rule.OwnerUserID, ShouldBeNil)
+	})
+}
@@ -0,0 +1,75 @@
+package code_owner_usecase
+
+import (
+	"app/internal/domain"
+	repoerrors "app/internal/repository/errs"
+	"app/internal/repository/storage/mock"
+	"app/internal/usecase/errs"
+	loggermock "app/pkg/logger/mock"
+	txmock "app/pkg/txmanager/mock"
+	"context"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRemoveRule_NotFound(t *testing.T) {
+	Convey("RemoveRule rule not found", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := loggermock.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		codeOwnerStorage := mock.NewMockCodeOwnerStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		txmock := txmock.NewMockTxManager(ctrl)
+		uc := NewCodeOwnerUseCase(codeOwnerStorage, userStorage, teamStorage, txmock, mockLog)
+		ctx := context.Background()
+
+		txmock.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, a, b any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		codeOwnerStorage.EXPECT().
+			DeleteCodeOwnerRule(ctx, domain.CodeOwnerID(42)).
+			Return(repoerrors.ErrNotFound)
+
+		err := uc.RemoveRule(ctx, domain.CodeOwnerID(42))
+
+		So(err, ShouldEqual, errs.ErrCodeOwnerRuleNotFound)
+	})
+}
+
+func TestRemoveRule_Success(t *testing.T) {
+	Convey("RemoveRule success", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := loggermock.NewMockLogger(ctrl)
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		codeOwnerStorage := mock.NewMockCodeOwnerStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		txmock := txmock.NewMockTxManager(ctrl)
+		uc := NewCodeOwnerUseCase(codeOwnerStorage, userStorage, teamStorage, txmock, mockLog)
+		ctx := context.Background()
+
+		txmock.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, a, b any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		codeOwnerStorage.EXPECT().
+			DeleteCodeOwnerRule(ctx, domain.CodeOwnerID(7)).
+			Return(nil)
+
+		So(uc.RemoveRule(ctx, domain.CodeOwnerID(7)), ShouldBeNil)
+	})
+}
@@ -10,7 +10,6 @@ var (
 	ErrNoUsersProvided 					= errors.New("no users provided for team creation")
 	ErrNoAvailableActiveUserToAssign    = errors.New("no available active user to assign")
 	ErrNoUsersInTeam 				    = errors.New("no users in team")
-	ErrUserAlreadyHasTeam 				= errors.New("one of the users already has a team")
 	ErrAuthorNotFound     				= errors.New("author not found")
 	ErrUserNotFound    					= errors.New("user not found")
 	ErrTeamNotFound    					= errors.New("team not found")
@@ -24,4 +23,33 @@ var (
 	ErrReviewerNotFoundInPR 			= errors.New("reviewer not found in pull request")
 	ErrReviewerNotFoundInPullRequest 	= errors.New("reviewer not found in pull request")
 	ErrInvalidUserID					= errors.New("invalid user id")
+	ErrInvalidTeamSettings 				= errors.New("invalid team settings")
+	ErrInvalidPullRequestFilter 		= errors.New("invalid pull request filter")
+	ErrPullRequestClosed 				= errors.New("pull request is closed")
+	ErrInvalidPullRequestTransition 	= errors.New("invalid pull request status transition")
+	ErrInvalidReviewVerdict 			= errors.New("invalid review verdict")
+	ErrPullRequestNotOpen 				= errors.New("pull request is not open")
+	ErrMergePolicyViolation 			= errors.New("merge policy not satisfied")
+	ErrReviewerAlreadyAssigned 			= errors.New("reviewer already assigned to pull request")
+	ErrReviewerIsAuthor 				= errors.New("author can not be a reviewer")
+	ErrReviewerNotInTeam 				= errors.New("reviewer is not in author's team")
+	ErrReviewerInactive 				= errors.New("reviewer is inactive")
+	ErrTooManyReviewers 				= errors.New("pull request already has max reviewers")
+	ErrFallbackTeamNotFound 			= errors.New("fallback team not found")
+	ErrInvalidCodeOwnerPattern 			= errors.New("invalid code owner pattern")
+	ErrInvalidCodeOwner 				= errors.New("code owner rule must have exactly one owner: user or team")
+	ErrCodeOwnerRuleAlreadyExists 		= errors.New("code owner rule already exists")
+	ErrCodeOwnerRuleNotFound 			= errors.New("code owner rule not found")
+	ErrInvalidSkill 					= errors.New("invalid skill tag")
+	ErrInvalidUnavailabilityWindow 		= errors.New("invalid unavailability window")
+	ErrUnavailabilityNotFound 			= errors.New("unavailability window not found")
+	ErrReviewerUnavailable 				= errors.New("reviewer is unavailable")
+	ErrUserNotInTeam 					= errors.New("user is not a member of the team")
+	ErrUserAlreadyInTeam 				= errors.New("user is already a member of the team")
+	ErrInvalidTeamFilter 				= errors.New("invalid team filter")
+	ErrTeamHasOpenPullRequests 			= errors.New("team has open pull requests")
+	ErrDuplicateTeamMember 				= errors.New("duplicate user in team members")
+	ErrAuthorNotInTeam 					= errors.New("author is not a member of the team")
+	ErrInvalidUserName 					= errors.New("invalid user name")
+	ErrInvalidUserFilter 				= errors.New("invalid user filter")
 )
\ No newline at end of file
@@ -0,0 +1,24 @@
+package errs
+
+import (
+	"app/internal/domain"
+	"strings"
+)
+
+// MergePolicyError перечисляет невыполненные условия политики мержа;
+// errors.Is(err, ErrMergePolicyViolation) для него истинно.
+type MergePolicyError struct {
+	Unmet []domain.UnmetMergeCondition
+}
+
+func (e *MergePolicyError) Error() string {
+	conditions := make([]string, 0, len(e.Unmet))
+	for _, c := range e.Unmet {
+		conditions = append(conditions, c.Condition.String())
+	}
+	return ErrMergePolicyViolation.Error() + ": " + strings.Join(conditions, ", ")
+}
+
+func (e *MergePolicyError) Is(target error) bool {
+	return target == ErrMergePolicyViolation
+}
@@ -0,0 +1,62 @@
+package pr_usecase
+
+import (
+	"app/internal/domain"
+	"app/internal/repository/models"
+	"app/internal/repository/storage"
+	"context"
+	"time"
+)
+
+// WithAvailabilityStorage исключает из выбора ревьюверов пользователей с действующим окном недоступности.
+func WithAvailabilityStorage(availabilityStorage storage.AvailabilityStorage) Option {
+	return func(p *pullRequestUseCase) {
+		p.availabilityStorage = availabilityStorage
+	}
+}
+
+// availableSelector отбрасывает кандидатов, недоступных в момент выбора, и передаёт остальных дальше.
+type availableSelector struct {
+	next                ReviewerSelector
+	availabilityStorage storage.AvailabilityStorage
+}
+
+func (s *availableSelector) Select(ctx context.Context, candidates []models.User, count int) (Selection, error) {
+	if count <= 0 || len(candidates) == 0 {
+		return Selection{}, nil
+	}
+
+	userIDs := make([]domain.UserID, 0, len(candidates))
+	for _, c := range candidates {
+		userIDs = append(userIDs, c.ID)
+	}
+
+	unavailable, err := s.availabilityStorage.GetUnavailableUserIDs(ctx, userIDs, time.Now())
+	if err != nil {
+		return Selection{}, err
+	}
+
+	available := make([]models.User, 0, len(candidates))
+	for _, c := range candidates {
+		if !unavailable[c.ID] {
+			available = append(available, c)
+		}
+	}
+
+	return s.next.Select(ctx, available, count)
+}
+
+// isUnavailable сообщает, есть ли у пользователя действующее окно недоступности.
+func (p *pullRequestUseCase) isUnavailable(ctx context.Context, userID domain.UserID) (bool, error) {
+	if p.availabilityStorage == nil {
+		return false, nil
+	}
+
+	unavailable, err := p.availabilityStorage.GetUnavailableUserIDs(ctx, []domain.UserID{userID}, time.Now())
+	if err != nil {
+		p.logger.Errorw("Failed to get unavailable users", "userID", userID, "error", err)
+		return false, err
+	}
+
+	return unavailable[userID], nil
+}
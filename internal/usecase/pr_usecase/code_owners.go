@@ -0,0 +1,122 @@
+package pr_usecase
+
+import (
+	"app/internal/domain"
+	repositoryerrs "app/internal/repository/errs"
+	"app/internal/repository/models"
+	"app/internal/repository/storage"
+	"context"
+	"errors"
+)
+
+// WithCodeOwnerStorage включает выбор ревьюверов по владельцам изменённых файлов.
+func WithCodeOwnerStorage(codeOwnerStorage storage.CodeOwnerStorage) Option {
+	return func(p *pullRequestUseCase) {
+		p.codeOwnerStorage = codeOwnerStorage
+	}
+}
+
+// codeOwnerCandidates возвращает активных владельцев изменённых файлов, кроме автора.
+// Как в CODEOWNERS, для файла действует последнее подходящее правило (со всеми владельцами этого шаблона).
+func (p *pullRequestUseCase) codeOwnerCandidates(ctx context.Context, changedFiles []string, authorID domain.UserID) ([]models.User, error) {
+	if p.codeOwnerStorage == nil || len(changedFiles) == 0 {
+		return nil, nil
+	}
+
+	rules, err := p.codeOwnerStorage.GetCodeOwnerRules(ctx)
+	if err != nil {
+		p.logger.Errorw("Failed to get code owner rules", "error", err)
+		return nil, err
+	}
+
+	matchedPatterns := make(map[string]bool)
+	for _, file := range changedFiles {
+		for i := len(rules) - 1; i >= 0; i-- {
+			if domain.MatchCodeOwnerPattern(rules[i].Pattern, file) {
+				matchedPatterns[rules[i].Pattern] = true
+				break
+			}
+		}
+	}
+
+	var owners []models.User
+	seen := map[domain.UserID]bool{authorID: true}
+	addOwner := func(user models.User) {
+		if !user.StatusActivity || seen[user.ID] {
+			return
+		}
+		seen[user.ID] = true
+		owners = append(owners, user)
+	}
+
+	for _, rule := range rules {
+		if !matchedPatterns[rule.Pattern] {
+			continue
+		}
+
+		if rule.OwnerUserID != nil {
+			user, err := p.userStorage.GetUserByID(ctx, *rule.OwnerUserID)
+			if err != nil {
+				if errors.Is(err, repositoryerrs.ErrNotFound) {
+					continue
+				}
+				p.logger.Errorw("Failed to get user by ID", "userID", *rule.OwnerUserID, "error", err)
+				return nil, err
+			}
+			addOwner(*user)
+		}
+
+		if rule.OwnerTeamID != nil {
+			users, err := p.userStorage.GetActiveUsersByTeam(ctx, *rule.OwnerTeamID)
+			if err != nil {
+				p.logger.Errorw("Failed to get active users by team", "teamID", *rule.OwnerTeamID, "error", err)
+				return nil, err
+			}
+			for _, user := range users {
+				addOwner(user)
+			}
+		}
+	}
+
+	return owners, nil
+}
+
+// selectReviewersWithOwners сначала берёт владельцев изменённых файлов, а свободные места
+// добирает обычным выбором из команды автора (и запасных команд).
+func (p *pullRequestUseCase) selectReviewersWithOwners(ctx context.Context, team models.Team, authorID domain.UserID,
+	users []models.User, changedFiles []string, requiredSkills []string) (Selection, error) {
+	owners, err := p.codeOwnerCandidates(ctx, changedFiles, authorID)
+	if err != nil {
+		return Selection{}, err
+	}
+
+	if len(owners) == 0 {
+		return p.selectReviewers(ctx, team, authorID, requiredSkills, nil, reviewerCandidates(users, authorID), team.MaxReviewers)
+	}
+
+	selection, err := p.teamSelector(team, requiredSkills).Select(ctx, owners, team.MaxReviewers)
+	if err != nil {
+		return Selection{}, err
+	}
+
+	exclude := []domain.UserID{authorID}
+	for _, u := range selection.Reviewers {
+		exclude = append(exclude, u.ID)
+	}
+
+	rest, err := p.selectReviewers(ctx, team, authorID, requiredSkills, selection.Reviewers,
+		reviewerCandidates(users, exclude...), team.MaxReviewers-len(selection.Reviewers))
+	if err != nil {
+		return Selection{}, err
+	}
+
+	for userID, load := range rest.Loads {
+		if selection.Loads == nil {
+			selection.Loads = make(map[domain.UserID]int)
+		}
+		selection.Loads[userID] = load
+	}
+	selection.Reviewers = append(selection.Reviewers, rest.Reviewers...)
+
+	return selection, nil
+}
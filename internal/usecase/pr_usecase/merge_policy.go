@@ -0,0 +1,128 @@
+package pr_usecase
+
+import (
+	"app/internal/domain"
+	"app/internal/mapper"
+	repositoryerrs "app/internal/repository/errs"
+	"app/internal/repository/models"
+	"app/internal/usecase/errs"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// checkMergePolicy проверяет PR по политике мержа его команды и возвращает
+// *errs.MergePolicyError со всеми невыполненными условиями.
+func (p *pullRequestUseCase) checkMergePolicy(ctx context.Context, pr models.PullRequest, mergedBy *domain.UserID) error {
+	policy := domain.MergePolicy{}
+
+	team, err := p.pullRequestTeam(ctx, pr)
+	if err != nil && !errors.Is(err, repositoryerrs.ErrNotFound) {
+		return err
+	}
+	if team != nil {
+		policy = mapper.ModelToDomainMergePolicy(team.MergePolicy)
+	}
+
+	if policy.MinApprovals < p.requiredApprovals {
+		policy.MinApprovals = p.requiredApprovals
+	}
+
+	var reviewers []models.User
+	if policy.MinApprovals > 0 || policy.RequireAllResponded {
+		if reviewers, err = p.prStorage.GetReviewersFromPR(ctx, pr.ID); err != nil {
+			p.logger.Errorw("Failed to get reviewers from pull request", "prID", pr.ID, "error", err)
+			return err
+		}
+	}
+
+	unmet := evaluateMergePolicy(policy, pr, reviewers, mergedBy, time.Now())
+	if len(unmet) > 0 {
+		p.logger.Errorw("Merge policy not satisfied", "prID", pr.ID, "unmet", len(unmet))
+		return &errs.MergePolicyError{Unmet: unmet}
+	}
+
+	return nil
+}
+
+func evaluateMergePolicy(policy domain.MergePolicy, pr models.PullRequest, reviewers []models.User,
+	mergedBy *domain.UserID, now time.Time) []domain.UnmetMergeCondition {
+	var unmet []domain.UnmetMergeCondition
+
+	if policy.MinApprovals > 0 {
+		approvals := 0
+		var blocking []domain.UserID
+		for _, reviewer := range reviewers {
+			switch reviewer.ReviewState {
+			case domain.ReviewStateApproved:
+				approvals++
+			case domain.ReviewStateChangesRequested:
+				blocking = append(blocking, reviewer.ID)
+			}
+		}
+
+		if approvals < policy.MinApprovals {
+			unmet = append(unmet, domain.UnmetMergeCondition{
+				Condition: domain.MergeConditionMinApprovals,
+				Message:   fmt.Sprintf("%d of %d required approvals", approvals, policy.MinApprovals),
+			})
+		}
+		if len(blocking) > 0 {
+			unmet = append(unmet, domain.UnmetMergeCondition{
+				Condition: domain.MergeConditionNoChangesRequested,
+				Message:   "changes requested by reviewers",
+				UserIDs:   blocking,
+			})
+		}
+	}
+
+	if policy.RequireAllResponded {
+		// Неактивный ревьювер ответить не сможет, поэтому ждём только активных.
+		var pending []domain.UserID
+		for _, reviewer := range reviewers {
+			if reviewer.StatusActivity && reviewer.ReviewedAt == nil {
+				pending = append(pending, reviewer.ID)
+			}
+		}
+
+		if len(pending) > 0 {
+			unmet = append(unmet, domain.UnmetMergeCondition{
+				Condition: domain.MergeConditionAllReviewersResponded,
+				Message:   "reviewers have not responded",
+				UserIDs:   pending,
+			})
+		}
+	}
+
+	if policy.ForbidSelfMerge {
+		if mergedBy == nil {
+			unmet = append(unmet, domain.UnmetMergeCondition{
+				Condition: domain.MergeConditionNoSelfMerge,
+				Message:   "merged_by is required when self-merge is forbidden",
+			})
+		} else if *mergedBy == pr.AuthorID {
+			unmet = append(unmet, domain.UnmetMergeCondition{
+				Condition: domain.MergeConditionNoSelfMerge,
+				Message:   "author can not merge own pull request",
+			})
+		}
+	}
+
+	if policy.MinAge > 0 {
+		openSince := pr.CreatedAt
+		if pr.ReadyAt != nil {
+			openSince = *pr.ReadyAt
+		}
+
+		if age := now.Sub(openSince); age < policy.MinAge {
+			unmet = append(unmet, domain.UnmetMergeCondition{
+				Condition: domain.MergeConditionMinAge,
+				Message: fmt.Sprintf("pull request is open for %s, required %s",
+					age.Truncate(time.Second), policy.MinAge),
+			})
+		}
+	}
+
+	return unmet
+}
@@ -0,0 +1,75 @@
+package pr_usecase
+
+import (
+	"app/internal/domain"
+	repositoryerrs "app/internal/repository/errs"
+	"app/internal/repository/models"
+	"app/internal/usecase/errs"
+	"context"
+	"errors"
+)
+
+// pullRequestTeam возвращает команду, из которой выбираются ревьюверы PR:
+// указанную при создании, а если её нет — основную команду автора.
+func (p *pullRequestUseCase) pullRequestTeam(ctx context.Context, pr models.PullRequest) (*models.Team, error) {
+	if pr.TeamID != nil {
+		team, err := p.teamStorage.GetTeamByID(ctx, *pr.TeamID)
+		if err != nil {
+			p.logger.Errorw("Failed to get team by ID", "teamID", *pr.TeamID, "error", err)
+			return nil, err
+		}
+		return team, nil
+	}
+
+	team, err := p.teamStorage.GetTeamByUserID(ctx, pr.AuthorID)
+	if err != nil {
+		p.logger.Errorw("Failed to get team by user ID", "userID", pr.AuthorID, "error", err)
+		return nil, err
+	}
+	return team, nil
+}
+
+// assignPullRequestTeam закрепляет за PR команду teamName, в которой должен состоять автор.
+func (p *pullRequestUseCase) assignPullRequestTeam(ctx context.Context, prModel *models.PullRequest, teamName string) (*models.Team, error) {
+	team, err := p.teamStorage.GetTeamByName(ctx, teamName)
+	if err != nil {
+		if errors.Is(err, repositoryerrs.ErrNotFound) {
+			p.logger.Errorw("Team not found", "teamName", teamName)
+			return nil, errs.ErrTeamNotFound
+		}
+		p.logger.Errorw("Failed to get team by name", "teamName", teamName, "error", err)
+		return nil, err
+	}
+
+	member, err := p.isTeamMember(ctx, prModel.AuthorID, team.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !member {
+		p.logger.Errorw("Author is not a member of the team", "userID", prModel.AuthorID, "teamName", teamName)
+		return nil, errs.ErrAuthorNotInTeam
+	}
+
+	if err := p.prStorage.SetPullRequestTeam(ctx, prModel.ID, team.ID); err != nil {
+		p.logger.Errorw("Failed to set pull request team", "prID", prModel.ID, "teamID", team.ID, "error", err)
+		return nil, err
+	}
+	prModel.TeamID = &team.ID
+
+	return team, nil
+}
+
+func (p *pullRequestUseCase) isTeamMember(ctx context.Context, userID domain.UserID, teamID domain.TeamID) (bool, error) {
+	memberships, err := p.teamStorage.GetTeamsByUserID(ctx, userID)
+	if err != nil {
+		p.logger.Errorw("Failed to get teams by user ID", "userID", userID, "error", err)
+		return false, err
+	}
+
+	for _, membership := range memberships {
+		if membership.TeamID == teamID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
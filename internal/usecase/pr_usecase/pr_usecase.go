@@ -12,95 +12,255 @@ import (
 	"app/pkg/txmanager"
 	"context"
 	"errors"
-	"strings"
-
-	"math/rand"
 )
 
 //go:generate mockgen -source=pr_usecase.go -destination=mock/mock_pr_usecase.go -package=mock
 type PullRequestUseCase interface {
-	CreatePR(ctx context.Context, prAuthorID domain.UserID, prID domain.PRID, prName string) (*domain.PullRequest, error)
-	ReassignReviewer(ctx context.Context, prID domain.PRID, reviewerIDToChange domain.UserID) error
-	MergePR(ctx context.Context, prID domain.PRID) error
-	GetPRByUserID(ctx context.Context, userID domain.UserID) ([]domain.PullRequest, error)
+	CreatePR(ctx context.Context, prAuthorID domain.UserID, prID domain.PRID, prName string, opts domain.CreatePullRequestOptions) (*domain.PullRequest, error)
+	ReassignReviewer(ctx context.Context, prID domain.PRID, reviewerIDToChange domain.UserID, newReviewerID *domain.UserID) (*domain.PullRequest, domain.UserID, error)
+	MergePR(ctx context.Context, prID domain.PRID, mergedBy *domain.UserID) (*domain.PullRequest, error)
+	ClosePR(ctx context.Context, prID domain.PRID) (*domain.PullRequest, error)
+	ReopenPR(ctx context.Context, prID domain.PRID, reselectReviewers bool) (*domain.PullRequest, error)
+	ReadyPR(ctx context.Context, prID domain.PRID) (*domain.PullRequest, error)
+	ReviewPR(ctx context.Context, prID domain.PRID, reviewerID domain.UserID, verdict domain.ReviewVerdict) (*domain.PullRequest, error)
+	AddReviewer(ctx context.Context, prID domain.PRID, reviewerID domain.UserID) (*domain.PullRequest, error)
+	RemoveReviewer(ctx context.Context, prID domain.PRID, reviewerID domain.UserID) (*domain.PullRequest, error)
+	GetPRByID(ctx context.Context, prID domain.PRID) (*domain.PullRequest, error)
+	GetPRByUserID(ctx context.Context, userID domain.UserID, filter domain.PullRequestFilter) (*domain.PullRequestPage, error)
+	GetPRByAuthorID(ctx context.Context, authorID domain.UserID, filter domain.PullRequestFilter) (*domain.PullRequestPage, error)
+	ReassignOpenReviews(ctx context.Context, reviewerID domain.UserID) (*domain.ReassignmentReport, error)
+	ReassignTeamReviews(ctx context.Context, reviewerID domain.UserID, teamID domain.TeamID) (*domain.ReassignmentReport, error)
+	RefreshNeedMoreReviewers(ctx context.Context, reviewerID domain.UserID) error
+	FillReviewers(ctx context.Context) (int, error)
 }
 
 type pullRequestUseCase struct {
-	statsCache  cache.StatsCache
-	prStorage   storage.PRStorage
-	userStorage storage.UserStorage
-	teamStorage storage.TeamStorage
-	txmanager   txmanager.TxManager
-	logger      logger.Logger
+	statsCache      cache.StatsCache
+	prStorage       storage.PRStorage
+	userStorage     storage.UserStorage
+	teamStorage     storage.TeamStorage
+	txmanager       txmanager.TxManager
+	logger          logger.Logger
+	selectionConfig SelectionConfig
+	selectors       *reviewerSelectors
+	// codeOwnerStorage — таблица владения путями; nil отключает выбор по владельцам.
+	codeOwnerStorage storage.CodeOwnerStorage
+	// availabilityStorage — окна недоступности; nil означает, что учитывается только is_active.
+	availabilityStorage storage.AvailabilityStorage
+	// requiredApprovals — минимум апрувов для всех команд; политика команды может требовать больше.
+	requiredApprovals int
+}
+
+type Option func(p *pullRequestUseCase)
+
+func WithSelectionConfig(cfg SelectionConfig) Option {
+	return func(p *pullRequestUseCase) {
+		p.selectionConfig = cfg
+	}
+}
+
+func WithRequiredApprovals(n int) Option {
+	return func(p *pullRequestUseCase) {
+		p.requiredApprovals = n
+	}
 }
 
 func NewPRUseCase(prStorage storage.PRStorage, userStorage storage.UserStorage, cache cache.StatsCache,
-	teamStorage storage.TeamStorage, txmanager txmanager.TxManager, logger logger.Logger) PullRequestUseCase {
-	return &pullRequestUseCase{
-		prStorage:   prStorage,
-		statsCache:  cache,
-		userStorage: userStorage,
-		teamStorage: teamStorage,
-		txmanager:   txmanager,
-		logger:      logger,
+	teamStorage storage.TeamStorage, txmanager txmanager.TxManager, logger logger.Logger, opts ...Option) PullRequestUseCase {
+	p := &pullRequestUseCase{
+		prStorage:       prStorage,
+		statsCache:      cache,
+		userStorage:     userStorage,
+		teamStorage:     teamStorage,
+		txmanager:       txmanager,
+		logger:          logger,
+		selectionConfig: SelectionConfig{Strategy: domain.ReviewerStrategyRandom},
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	p.selectors = newReviewerSelectors(p.selectionConfig, p.countOpenReviews)
+
+	return p
+}
+
+func (p *pullRequestUseCase) GetPRByID(ctx context.Context, prID domain.PRID) (*domain.PullRequest, error) {
+	var pr domain.PullRequest
+
+	if len(prID) == 0 {
+		p.logger.Errorw("Pull request ID is empty")
+		return nil, errs.ErrInvalidPullRequestID
+	}
+
+	err := p.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadOnly,
+		func(ctx context.Context) error {
+			prModel, err := p.getPullRequest(ctx, prID)
+			if err != nil {
+				return err
+			}
+
+			pr, err = p.loadPullRequest(ctx, *prModel)
+			return err
+		})
+
+	if err != nil {
+		return nil, err
 	}
+
+	p.logger.Infow("Successfully retrieved pull request", "prID", prID)
+
+	return &pr, nil
 }
 
-func (p *pullRequestUseCase) GetPRByUserID(ctx context.Context, userID domain.UserID) ([]domain.PullRequest, error) {
-	var prs []domain.PullRequest
+const (
+	defaultPageLimit = 50
+	maxPageLimit     = 500
+)
+
+func (p *pullRequestUseCase) GetPRByUserID(ctx context.Context, userID domain.UserID, filter domain.PullRequestFilter) (*domain.PullRequestPage, error) {
+	var page *domain.PullRequestPage
 
 	if len(userID) == 0 {
 		p.logger.Errorw("User ID is empty")
 		return nil, errs.ErrInvalidUserID
 	}
 
-	err := p.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadOnly,
+	filter, err := normalizePullRequestFilter(filter)
+	if err != nil {
+		p.logger.Errorw("Invalid pull request filter", "userID", userID, "error", err)
+		return nil, err
+	}
+
+	err = p.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadOnly,
 		func(ctx context.Context) error {
+			limit := filter.Limit
+			// Берём на одну запись больше, чтобы понять, есть ли следующая страница.
+			filter.Limit++
 
-			prModels, err := p.prStorage.GetPullRequestsByReviewerID(ctx, userID)
+			prModels, err := p.prStorage.GetPullRequestsByReviewerID(ctx, userID, filter)
 			if err != nil {
 				p.logger.Errorw("Failed to get pull requests by reviewer ID", "userID", userID, "error", err)
 				return err
 			}
 
-			for _, pm := range prModels {
-				authorModel, err := p.userStorage.GetUserByID(ctx, pm.AuthorID)
-				if err != nil {
-					p.logger.Errorw("Failed to get user by ID", "userID", pm.AuthorID, "error", err)
-					return err
-				}
+			page, err = p.buildPullRequestPage(ctx, prModels, limit)
+			return err
+		})
 
-				reviewers, err := p.prStorage.GetReviewersFromPR(ctx, pm.ID)
-				if err != nil {
-					p.logger.Errorw("Failed to get reviewers from pull request", "prID", pm.ID, "error", err)
-					return err
-				}
+	if err != nil {
+		p.logger.Errorw("Failed to get pull requests by reviewer ID", "userID", userID, "error", err)
+		return nil, err
+	}
+
+	p.logger.Infow("Successfully retrieved pull requests for user", "userID", userID, "count", len(page.PullRequests))
+
+	return page, nil
+}
+
+func (p *pullRequestUseCase) GetPRByAuthorID(ctx context.Context, authorID domain.UserID, filter domain.PullRequestFilter) (*domain.PullRequestPage, error) {
+	var page *domain.PullRequestPage
+
+	if len(authorID) == 0 {
+		p.logger.Errorw("Author ID is empty")
+		return nil, errs.ErrInvalidUserID
+	}
+
+	filter, err := normalizePullRequestFilter(filter)
+	if err != nil {
+		p.logger.Errorw("Invalid pull request filter", "authorID", authorID, "error", err)
+		return nil, err
+	}
+
+	err = p.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadOnly,
+		func(ctx context.Context) error {
+			limit := filter.Limit
+			filter.Limit++
 
-				prs = append(prs, domain.PullRequest{
-					ID:        pm.ID,
-					Name:      pm.Name,
-					Reviewers: mapper.ModelsToDomainUsers(reviewers),
-					Author:    mapper.ModelToDomainUser(*authorModel),
-					Status:    pm.Status,
-					CreatedAt: pm.CreatedAt,
-					MergedAt:  pm.MergedAt,
-				})
+			prModels, err := p.prStorage.GetPullRequestsByAuthorID(ctx, authorID, filter)
+			if err != nil {
+				p.logger.Errorw("Failed to get pull requests by author ID", "authorID", authorID, "error", err)
+				return err
 			}
 
-			return nil
+			page, err = p.buildPullRequestPage(ctx, prModels, limit)
+			return err
 		})
 
 	if err != nil {
-		p.logger.Errorw("Failed to get pull requests by reviewer ID", "userID", userID, "error", err)
+		p.logger.Errorw("Failed to get pull requests by author ID", "authorID", authorID, "error", err)
+		return nil, err
+	}
+
+	p.logger.Infow("Successfully retrieved authored pull requests", "authorID", authorID, "count", len(page.PullRequests))
+
+	return page, nil
+}
+
+// buildPullRequestPage обрезает выборку до limit, догружает участников и вычисляет курсор следующей страницы.
+func (p *pullRequestUseCase) buildPullRequestPage(ctx context.Context, prModels []models.PullRequest, limit int) (*domain.PullRequestPage, error) {
+	page := &domain.PullRequestPage{}
+
+	if len(prModels) > limit {
+		prModels = prModels[:limit]
+		last := prModels[len(prModels)-1]
+		page.NextCursor = &domain.PageCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+
+	prIDs := make([]domain.PRID, 0, len(prModels))
+	for _, pm := range prModels {
+		prIDs = append(prIDs, pm.ID)
+	}
+
+	authors, reviewers, err := p.prStorage.GetParticipantsByPRIDs(ctx, prIDs)
+	if err != nil {
+		p.logger.Errorw("Failed to get pull request participants", "count", len(prIDs), "error", err)
 		return nil, err
 	}
 
-	p.logger.Infow("Successfully retrieved pull requests for user", "userID", userID, "count", len(prs))
+	page.PullRequests = mapper.ModelsToDomainPullRequests(prModels, authors, reviewers)
+
+	return page, nil
+}
+
+func normalizePullRequestFilter(filter domain.PullRequestFilter) (domain.PullRequestFilter, error) {
+	if filter.Limit == 0 {
+		filter.Limit = defaultPageLimit
+	}
+	if filter.Limit < 0 || filter.Limit > maxPageLimit {
+		return filter, errs.ErrInvalidPullRequestFilter
+	}
+
+	if filter.SortOrder == "" {
+		filter.SortOrder = domain.SortOrderDesc
+	}
+	if !filter.SortOrder.IsValid() {
+		return filter, errs.ErrInvalidPullRequestFilter
+	}
+
+	if filter.Status != nil && !filter.Status.IsValid() {
+		return filter, errs.ErrInvalidPullRequestFilter
+	}
+
+	if filter.CreatedAfter != nil && filter.CreatedBefore != nil && !filter.CreatedAfter.Before(*filter.CreatedBefore) {
+		return filter, errs.ErrInvalidPullRequestFilter
+	}
+
+	return filter, nil
+}
 
-	return prs, nil
+// openPullRequestsFilter выбирает все OPEN PR без пагинации.
+func openPullRequestsFilter() domain.PullRequestFilter {
+	status := domain.PRStatusOpen
+	return domain.PullRequestFilter{Status: &status}
 }
 
-func (p *pullRequestUseCase) CreatePR(ctx context.Context, prAuthorID domain.UserID, prID domain.PRID, prName string) (*domain.PullRequest, error) {
+// CreatePR создаёт PR и сразу назначает ревьюверов, в первую очередь владельцев opts.ChangedFiles;
+// среди равных кандидатов предпочитаются те, у кого больше навыков из opts.RequiredSkills.
+// Черновик (opts.Draft) создаётся без ревьюверов — они назначаются при переходе в OPEN через ReadyPR.
+// Непустой opts.TeamName закрепляет за PR одну из команд автора, иначе ревьюверы выбираются из основной.
+func (p *pullRequestUseCase) CreatePR(ctx context.Context, prAuthorID domain.UserID, prID domain.PRID, prName string, opts domain.CreatePullRequestOptions) (*domain.PullRequest, error) {
 	var pr *domain.PullRequest
 
 	if len(prName) == 0 {
@@ -118,6 +278,12 @@ func (p *pullRequestUseCase) CreatePR(ctx context.Context, prAuthorID domain.Use
 		return nil, errs.ErrInvalidPullRequestID
 	}
 
+	requiredSkills, ok := domain.NormalizeSkills(opts.RequiredSkills)
+	if !ok {
+		p.logger.Errorw("Invalid required skills", "prID", prID)
+		return nil, errs.ErrInvalidSkill
+	}
+
 	if err := p.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadWrite,
 		func(ctx context.Context) error {
 			author, err := p.userStorage.GetUserByID(ctx, prAuthorID)
@@ -130,7 +296,12 @@ func (p *pullRequestUseCase) CreatePR(ctx context.Context, prAuthorID domain.Use
 				return err
 			}
 
-			prModel, err := p.prStorage.CreatePullRequest(ctx, prID, prName, prAuthorID)
+			status := domain.PRStatusOpen
+			if opts.Draft {
+				status = domain.PRStatusDraft
+			}
+
+			prModel, err := p.prStorage.CreatePullRequest(ctx, prID, prName, prAuthorID, status)
 			if err != nil {
 				if errors.Is(err, repositoryerrs.ErrAlreadyExists) {
 					p.logger.Errorw("Pull request already exists", "prName", prName)
@@ -140,14 +311,35 @@ func (p *pullRequestUseCase) CreatePR(ctx context.Context, prAuthorID domain.Use
 				return err
 			}
 
-			team, err := p.teamStorage.GetTeamByUserID(ctx, prAuthorID)
-			if err != nil {
-				if errors.Is(err, repositoryerrs.ErrNotFound) {
-					p.logger.Errorw("User has no team", "userID", prAuthorID)
-					return errs.ErrUserHasNoTeam // что невозможно раз он уже был создан
+			var team *models.Team
+			if len(opts.TeamName) > 0 {
+				if team, err = p.assignPullRequestTeam(ctx, prModel, opts.TeamName); err != nil {
+					return err
 				}
-				p.logger.Errorw("Failed to get team by user ID", "userID", prAuthorID, "error", err)
-				return err
+			}
+
+			// Команда проверяется и для черновика, чтобы автор без команды узнал об этом при создании.
+			if team == nil {
+				team, err = p.teamStorage.GetTeamByUserID(ctx, prAuthorID)
+				if err != nil {
+					if errors.Is(err, repositoryerrs.ErrNotFound) {
+						p.logger.Errorw("User has no team", "userID", prAuthorID)
+						return errs.ErrUserHasNoTeam // что невозможно раз он уже был создан
+					}
+					p.logger.Errorw("Failed to get team by user ID", "userID", prAuthorID, "error", err)
+					return err
+				}
+			}
+
+			if opts.Draft {
+				pr = &domain.PullRequest{
+					ID:        prModel.ID,
+					Name:      prName,
+					Author:    mapper.ModelToDomainUser(*author),
+					Status:    prModel.Status,
+					CreatedAt: prModel.CreatedAt,
+				}
+				return nil
 			}
 
 			users, err := p.teamStorage.GetUsersByTeam(ctx, team.ID)
@@ -156,29 +348,17 @@ func (p *pullRequestUseCase) CreatePR(ctx context.Context, prAuthorID domain.Use
 				return err
 			}
 
-			n := len(users)
-
-			count := rand.Intn(2) + 1
-			if count > n {
-				count = n
+			selection, err := p.selectReviewersWithOwners(ctx, *team, prAuthorID, users,
+				opts.ChangedFiles, requiredSkills)
+			if err != nil {
+				p.logger.Errorw("Failed to select reviewers", "prID", prModel.ID, "teamID", team.ID, "error", err)
+				return err
 			}
 
-			shuffled := make([]models.User, n)
-			copy(shuffled, users)
-			rand.Shuffle(n, func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
-
-			selectedReviewers := make([]models.User, 0, count)
-			for _, u := range shuffled {
-				if !strings.EqualFold(u.ID.String(), prAuthorID.String()) && u.StatusActivity {
-					selectedReviewers = append(selectedReviewers, u)
-					if len(selectedReviewers) == count {
-						break
-					}
-				}
-			}
+			selectedReviewers := selection.Reviewers
 
 			for _, reviewer := range selectedReviewers {
-				if err := p.prStorage.CreatePRReviewerInstance(ctx, prModel.ID, reviewer.ID); err != nil {
+				if err := p.prStorage.CreatePRReviewerInstance(ctx, prModel.ID, reviewer.ID, reviewer.SourceTeamID); err != nil {
 					p.logger.Errorw("Failed to create PR reviewer instance", "prID", prModel.ID, "reviewerID", reviewer.ID, "error", err)
 					return err
 				}
@@ -191,13 +371,24 @@ func (p *pullRequestUseCase) CreatePR(ctx context.Context, prAuthorID domain.Use
 				}
 			}
 
+			needMoreReviewers := needsMoreReviewers(*team, selectedReviewers)
+			if err := p.prStorage.UpdateNeedMoreReviewers(ctx, prModel.ID, needMoreReviewers); err != nil {
+				p.logger.Errorw("Failed to update need more reviewers flag", "prID", prModel.ID, "error", err)
+				return err
+			}
+
 			pr = &domain.PullRequest{
-				ID:        prModel.ID,
-				Name:      prName,
-				Author:    mapper.ModelToDomainUser(*author),
-				Status:    prModel.Status,
-				CreatedAt: prModel.CreatedAt,
-				MergedAt:  prModel.MergedAt,
+				ID:                prModel.ID,
+				Name:              prName,
+				Author:            mapper.ModelToDomainUser(*author),
+				Status:            prModel.Status,
+				Reviewers:         mapper.ModelsToDomainUsers(selectedReviewers),
+				NeedMoreReviewers: needMoreReviewers,
+				CreatedAt:         prModel.CreatedAt,
+				MergedAt:          prModel.MergedAt,
+				ReadyAt:           prModel.ReadyAt,
+				CandidateLoads:    selection.Loads,
+				FallbackReviewers: mapper.ModelsToFallbackReviewers(selectedReviewers),
 			}
 
 			return nil
@@ -211,8 +402,13 @@ func (p *pullRequestUseCase) CreatePR(ctx context.Context, prAuthorID domain.Use
 	return pr, nil
 }
 
-func (p *pullRequestUseCase) ReassignReviewer(ctx context.Context, prID domain.PRID, reviewerIDToRemove domain.UserID) error {
-	return p.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadWrite,
+// ReassignReviewer заменяет ревьювера. Если newReviewerID задан, назначается он, иначе замену выбирает стратегия команды.
+func (p *pullRequestUseCase) ReassignReviewer(ctx context.Context, prID domain.PRID, reviewerIDToRemove domain.UserID,
+	newReviewerID *domain.UserID) (*domain.PullRequest, domain.UserID, error) {
+	var result domain.PullRequest
+	var replacedBy domain.UserID
+
+	err := p.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadWrite,
 		func(ctx context.Context) error {
 
 			pr, err := p.prStorage.GetPullRequestByID(ctx, prID)
@@ -230,105 +426,799 @@ func (p *pullRequestUseCase) ReassignReviewer(ctx context.Context, prID domain.P
 				return errs.ErrPRAlreadyMerged
 			}
 
-			if err := p.prStorage.DeletePRReviewerInstance(ctx, prID, reviewerIDToRemove); err != nil {
-				if errors.Is(err, repositoryerrs.ErrNotFound) {
-					p.logger.Errorw("Failed to delete PR reviewer instance", "prID", prID, "reviewerID", reviewerIDToRemove, "error", err)
-					return errs.ErrReviewerNotFoundInPullRequest
-				}
-				p.logger.Errorw("Failed to delete PR reviewer instance", "prID", prID, "reviewerID", reviewerIDToRemove, "error", err)
-				return err
+			if pr.Status == domain.PRStatusClosed {
+				p.logger.Errorw("Pull Request is closed! Can't reassign", "prID", prID)
+				return errs.ErrPullRequestClosed
 			}
 
-			team, err := p.teamStorage.GetTeamByUserID(ctx, reviewerIDToRemove)
+			var user *models.User
+			if newReviewerID != nil {
+				user, err = p.replaceReviewerWith(ctx, *pr, reviewerIDToRemove, *newReviewerID)
+			} else {
+				user, err = p.replaceReviewer(ctx, *pr, reviewerIDToRemove)
+			}
 			if err != nil {
-				if errors.Is(err, repositoryerrs.ErrNotFound) {
-					p.logger.Errorw("User has no team", "userID", reviewerIDToRemove)
-					return errs.ErrUserHasNoTeam
-				}
-				p.logger.Errorw("Failed to get team by user ID", "userID", pr.AuthorID, "error", err)
 				return err
 			}
 
-			activeUsers, err := p.userStorage.GetActiveUsersByTeam(ctx, team.ID)
-			if err != nil {
-				p.logger.Errorw("Failed to get active users by team", "teamID", team.ID, "error", err)
+			if user == nil {
+				p.logger.Errorw("No available active user to assign as reviewer", "prID", prID)
+				return errs.ErrNoAvailableActiveUserToAssign
+			}
+			replacedBy = user.ID
+
+			if pr, err = p.getPullRequest(ctx, prID); err != nil {
 				return err
 			}
 
-			reviewers, err := p.prStorage.GetReviewersFromPR(ctx, prID)
+			result, err = p.loadPullRequest(ctx, *pr)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, "", err
+	}
+
+	p.logger.Infow("Successfully reassigned reviewer", "prID", prID, "reviewerID", replacedBy)
+
+	return &result, replacedBy, nil
+}
+
+func (p *pullRequestUseCase) ReassignOpenReviews(ctx context.Context, reviewerID domain.UserID) (*domain.ReassignmentReport, error) {
+	report := &domain.ReassignmentReport{}
+
+	if err := p.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadWrite,
+		func(ctx context.Context) error {
+			prs, err := p.prStorage.GetPullRequestsByReviewerID(ctx, reviewerID, openPullRequestsFilter())
 			if err != nil {
-				p.logger.Errorw("Failed to get reviewers from pull request", "prID", prID, "error", err)
+				p.logger.Errorw("Failed to get pull requests by reviewer ID", "userID", reviewerID, "error", err)
 				return err
 			}
 
-			user := p.pickFirstAvailableActiveUser(activeUsers, reviewers, pr.AuthorID)
-			if user == nil {
-				p.logger.Errorw("No available active user to assign as reviewer", "prID", prID)
-				return errs.ErrNoAvailableActiveUserToAssign
+			for _, pr := range prs {
+				user, err := p.replaceReviewer(ctx, pr, reviewerID)
+				if err != nil {
+					return err
+				}
+
+				reassignment := domain.ReviewerReassignment{PullRequestID: pr.ID, OldReviewerID: reviewerID}
+				if user == nil {
+					report.Unfilled = append(report.Unfilled, reassignment)
+					continue
+				}
+
+				reassignment.NewReviewerID = &user.ID
+				report.Reassigned = append(report.Reassigned, reassignment)
 			}
 
-			if err := p.prStorage.CreatePRReviewerInstance(ctx, prID, user.ID); err != nil {
-				p.logger.Errorw("Failed to create PR reviewer instance", "prID", prID, "reviewerID", user.ID, "error", err)
+			return nil
+		}); err != nil {
+		p.logger.Errorw("Transaction failed while reassigning open reviews", "userID", reviewerID, "error", err)
+		return nil, err
+	}
+
+	p.logger.Infow("Reassigned open reviews", "userID", reviewerID,
+		"reassigned", len(report.Reassigned), "unfilled", len(report.Unfilled))
+
+	return report, nil
+}
+
+// ReassignTeamReviews переназначает OPEN ревью reviewerID только в PR команды teamID — например, когда он
+// покидает её, оставаясь в других. Замена подбирается из этой же команды.
+func (p *pullRequestUseCase) ReassignTeamReviews(ctx context.Context, reviewerID domain.UserID, teamID domain.TeamID) (*domain.ReassignmentReport, error) {
+	report := &domain.ReassignmentReport{}
+
+	if err := p.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadWrite,
+		func(ctx context.Context) error {
+			prs, err := p.prStorage.GetPullRequestsByReviewerID(ctx, reviewerID, openPullRequestsFilter())
+			if err != nil {
+				p.logger.Errorw("Failed to get pull requests by reviewer ID", "userID", reviewerID, "error", err)
 				return err
 			}
 
-			if err := p.statsCache.IncrementAssignCountByUserID(ctx, user.ID); err != nil {
-				p.logger.Errorw("Failed to increment assign count in stats cache", "userID", user.ID, "error", err)
+			for _, pr := range prs {
+				team, err := p.pullRequestTeam(ctx, pr)
+				if err != nil {
+					if errors.Is(err, repositoryerrs.ErrNotFound) {
+						continue
+					}
+					return err
+				}
+
+				if team.ID != teamID {
+					continue
+				}
+
+				if err := p.deleteReviewerInstance(ctx, pr, reviewerID); err != nil {
+					return err
+				}
+
+				user, err := p.assignReplacement(ctx, pr, reviewerID, *team)
+				if err != nil {
+					return err
+				}
+
+				reassignment := domain.ReviewerReassignment{PullRequestID: pr.ID, OldReviewerID: reviewerID}
+				if user == nil {
+					report.Unfilled = append(report.Unfilled, reassignment)
+					continue
+				}
+
+				reassignment.NewReviewerID = &user.ID
+				report.Reassigned = append(report.Reassigned, reassignment)
+			}
+
+			return nil
+		}); err != nil {
+		p.logger.Errorw("Transaction failed while reassigning team reviews", "userID", reviewerID, "teamID", teamID, "error", err)
+		return nil, err
+	}
+
+	p.logger.Infow("Reassigned team reviews", "userID", reviewerID, "teamID", teamID,
+		"reassigned", len(report.Reassigned), "unfilled", len(report.Unfilled))
+
+	return report, nil
+}
+
+// replaceReviewer снимает reviewerID с PR и назначает вместо него активного участника его команды.
+// Если замены нет, возвращает nil без ошибки: PR остаётся без ревьювера и помечается need_more_reviewers.
+func (p *pullRequestUseCase) replaceReviewer(ctx context.Context, pr models.PullRequest, reviewerID domain.UserID) (*models.User, error) {
+	if err := p.deleteReviewerInstance(ctx, pr, reviewerID); err != nil {
+		return nil, err
+	}
+
+	// PR, направленный в команду, получает замену из неё же; иначе — из основной команды ревьювера.
+	var team *models.Team
+	var err error
+	if pr.TeamID != nil {
+		team, err = p.pullRequestTeam(ctx, pr)
+	} else {
+		team, err = p.teamStorage.GetTeamByUserID(ctx, reviewerID)
+	}
+	if err != nil {
+		if errors.Is(err, repositoryerrs.ErrNotFound) {
+			p.logger.Errorw("User has no team", "userID", reviewerID)
+			return nil, errs.ErrUserHasNoTeam
+		}
+		p.logger.Errorw("Failed to get team for reviewer replacement", "userID", reviewerID, "error", err)
+		return nil, err
+	}
+
+	return p.assignReplacement(ctx, pr, reviewerID, *team)
+}
+
+func (p *pullRequestUseCase) deleteReviewerInstance(ctx context.Context, pr models.PullRequest, reviewerID domain.UserID) error {
+	if err := p.prStorage.DeletePRReviewerInstance(ctx, pr.ID, reviewerID); err != nil {
+		if errors.Is(err, repositoryerrs.ErrNotFound) {
+			p.logger.Errorw("Failed to delete PR reviewer instance", "prID", pr.ID, "reviewerID", reviewerID, "error", err)
+			return errs.ErrReviewerNotFoundInPullRequest
+		}
+		p.logger.Errorw("Failed to delete PR reviewer instance", "prID", pr.ID, "reviewerID", reviewerID, "error", err)
+		return err
+	}
+	return nil
+}
+
+// assignReplacement назначает на место снятого reviewerID активного участника team.
+func (p *pullRequestUseCase) assignReplacement(ctx context.Context, pr models.PullRequest, reviewerID domain.UserID, team models.Team) (*models.User, error) {
+	activeUsers, err := p.userStorage.GetActiveUsersByTeam(ctx, team.ID)
+	if err != nil {
+		p.logger.Errorw("Failed to get active users by team", "teamID", team.ID, "error", err)
+		return nil, err
+	}
+
+	reviewers, err := p.prStorage.GetReviewersFromPR(ctx, pr.ID)
+	if err != nil {
+		p.logger.Errorw("Failed to get reviewers from pull request", "prID", pr.ID, "error", err)
+		return nil, err
+	}
+
+	exclude := []domain.UserID{pr.AuthorID, reviewerID}
+	for _, r := range reviewers {
+		exclude = append(exclude, r.ID)
+	}
+
+	selection, err := p.teamSelector(team, nil).Select(ctx, reviewerCandidates(activeUsers, exclude...), 1)
+	if err != nil {
+		p.logger.Errorw("Failed to select reviewer", "prID", pr.ID, "teamID", team.ID, "error", err)
+		return nil, err
+	}
+
+	if len(selection.Reviewers) == 0 {
+		if err := p.setNeedMoreReviewers(ctx, pr, needsMoreReviewers(team, reviewers)); err != nil {
+			return nil, err
+		}
+		return nil, nil
+	}
+
+	user := selection.Reviewers[0]
+
+	if err := p.prStorage.CreatePRReviewerInstance(ctx, pr.ID, user.ID, nil); err != nil {
+		p.logger.Errorw("Failed to create PR reviewer instance", "prID", pr.ID, "reviewerID", user.ID, "error", err)
+		return nil, err
+	}
+
+	if err := p.statsCache.IncrementAssignCountByUserID(ctx, user.ID); err != nil {
+		p.logger.Errorw("Failed to increment assign count in stats cache", "userID", user.ID, "error", err)
+		return nil, err
+	}
+
+	if err := p.setNeedMoreReviewers(ctx, pr, needsMoreReviewers(team, append(reviewers, user))); err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+func (p *pullRequestUseCase) RefreshNeedMoreReviewers(ctx context.Context, reviewerID domain.UserID) error {
+	return p.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadWrite,
+		func(ctx context.Context) error {
+			prs, err := p.prStorage.GetPullRequestsByReviewerID(ctx, reviewerID, openPullRequestsFilter())
+			if err != nil {
+				p.logger.Errorw("Failed to get pull requests by reviewer ID", "userID", reviewerID, "error", err)
 				return err
 			}
 
-			p.logger.Infow("Successfully reassigned reviewer", "prID", prID, "reviewerID", user.ID)
+			for _, pr := range prs {
+				team, err := p.pullRequestTeam(ctx, pr)
+				if err != nil {
+					if errors.Is(err, repositoryerrs.ErrNotFound) {
+						continue
+					}
+					return err
+				}
+
+				reviewers, err := p.prStorage.GetReviewersFromPR(ctx, pr.ID)
+				if err != nil {
+					p.logger.Errorw("Failed to get reviewers from pull request", "prID", pr.ID, "error", err)
+					return err
+				}
+
+				if err := p.setNeedMoreReviewers(ctx, pr, needsMoreReviewers(*team, reviewers)); err != nil {
+					return err
+				}
+			}
 
 			return nil
 		},
 	)
 }
 
-func (p *pullRequestUseCase) pickFirstAvailableActiveUser(activeUsers, reviewers []models.User, authorID domain.UserID) *models.User {
-	reviewerIDs := make(map[string]struct{}, len(reviewers))
+func (p *pullRequestUseCase) FillReviewers(ctx context.Context) (int, error) {
+	var prs []models.PullRequest
+
+	if err := p.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadOnly,
+		func(ctx context.Context) error {
+			var err error
+			prs, err = p.prStorage.GetAllOpenPullRequests(ctx)
+			if err != nil {
+				p.logger.Errorw("Failed to get open pull requests", "error", err)
+				return err
+			}
+			return nil
+		}); err != nil {
+		return 0, err
+	}
+
+	assigned := 0
+	for _, pr := range prs {
+		if !pr.NeedMoreReviewers {
+			continue
+		}
+
+		// Каждый PR добирается в своей транзакции, чтобы ошибка на одном не откатывала остальные.
+		// Назначенные учитываются только после коммита.
+		var added int
+		if err := p.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadWrite,
+			func(ctx context.Context) error {
+				var err error
+				added, err = p.topUpReviewers(ctx, pr)
+				return err
+			}); err != nil {
+			p.logger.Errorw("Failed to fill reviewers", "prID", pr.ID, "error", err)
+			continue
+		}
+		assigned += added
+	}
+
+	p.logger.Infow("Finished filling reviewers", "openPRs", len(prs), "assigned", assigned)
+
+	return assigned, nil
+}
+
+// topUpReviewers добирает активных ревьюверов до max_reviewers команды PR.
+func (p *pullRequestUseCase) topUpReviewers(ctx context.Context, pr models.PullRequest) (int, error) {
+	team, err := p.pullRequestTeam(ctx, pr)
+	if err != nil {
+		return 0, err
+	}
+
+	reviewers, err := p.prStorage.GetReviewersFromPR(ctx, pr.ID)
+	if err != nil {
+		p.logger.Errorw("Failed to get reviewers from pull request", "prID", pr.ID, "error", err)
+		return 0, err
+	}
+
+	activeUsers, err := p.userStorage.GetActiveUsersByTeam(ctx, team.ID)
+	if err != nil {
+		p.logger.Errorw("Failed to get active users by team", "teamID", team.ID, "error", err)
+		return 0, err
+	}
+
+	exclude := []domain.UserID{pr.AuthorID}
 	for _, r := range reviewers {
-		reviewerIDs[r.ID.String()] = struct{}{}
+		exclude = append(exclude, r.ID)
+	}
+
+	selection, err := p.selectReviewers(ctx, *team, pr.AuthorID, nil, reviewers,
+		reviewerCandidates(activeUsers, exclude...), team.MaxReviewers-countActive(reviewers))
+	if err != nil {
+		p.logger.Errorw("Failed to select reviewers", "prID", pr.ID, "teamID", team.ID, "error", err)
+		return 0, err
+	}
+
+	for _, reviewer := range selection.Reviewers {
+		if err := p.prStorage.CreatePRReviewerInstance(ctx, pr.ID, reviewer.ID, reviewer.SourceTeamID); err != nil {
+			p.logger.Errorw("Failed to create PR reviewer instance", "prID", pr.ID, "reviewerID", reviewer.ID, "error", err)
+			return 0, err
+		}
+
+		if err := p.statsCache.IncrementAssignCountByUserID(ctx, reviewer.ID); err != nil {
+			p.logger.Errorw("Failed to increment assign count in stats cache", "userID", reviewer.ID, "error", err)
+			return 0, err
+		}
+	}
+
+	if err := p.setNeedMoreReviewers(ctx, pr, needsMoreReviewers(*team, append(reviewers, selection.Reviewers...))); err != nil {
+		return 0, err
+	}
+
+	return len(selection.Reviewers), nil
+}
+
+// selectReviewers выбирает до count ревьюверов из кандидатов команды автора. Если вместе с уже назначенными
+// их меньше min_reviewers, недостающих добирает из запасных команд в порядке приоритета и проставляет
+// каждому такому ревьюверу SourceTeamID и SourceTeamName.
+func (p *pullRequestUseCase) selectReviewers(ctx context.Context, team models.Team, authorID domain.UserID, requiredSkills []string,
+	assigned []models.User, candidates []models.User, count int) (Selection, error) {
+	selection, err := p.teamSelector(team, requiredSkills).Select(ctx, candidates, count)
+	if err != nil {
+		return Selection{}, err
+	}
+
+	shortfall := min(team.MinReviewers-countActive(assigned), count) - len(selection.Reviewers)
+	if shortfall <= 0 {
+		return selection, nil
+	}
+
+	fallbackTeams, err := p.teamStorage.GetFallbackTeams(ctx, team.ID)
+	if err != nil {
+		p.logger.Errorw("Failed to get fallback teams", "teamID", team.ID, "error", err)
+		return Selection{}, err
 	}
 
-	for _, u := range activeUsers {
-		if !strings.EqualFold(u.ID.String(), authorID.String()) && u.StatusActivity {
-			if _, exists := reviewerIDs[u.ID.String()]; !exists {
-				return &u
+	exclude := []domain.UserID{authorID}
+	for _, u := range assigned {
+		exclude = append(exclude, u.ID)
+	}
+	for _, u := range selection.Reviewers {
+		exclude = append(exclude, u.ID)
+	}
+
+	for _, fallback := range fallbackTeams {
+		if shortfall <= 0 {
+			break
+		}
+
+		users, err := p.userStorage.GetActiveUsersByTeam(ctx, fallback.ID)
+		if err != nil {
+			p.logger.Errorw("Failed to get active users by team", "teamID", fallback.ID, "error", err)
+			return Selection{}, err
+		}
+
+		picked, err := p.teamSelector(fallback, requiredSkills).Select(ctx, reviewerCandidates(users, exclude...), shortfall)
+		if err != nil {
+			return Selection{}, err
+		}
+
+		for i := range picked.Reviewers {
+			picked.Reviewers[i].SourceTeamID = &fallback.ID
+			picked.Reviewers[i].SourceTeamName = fallback.TeamName
+			exclude = append(exclude, picked.Reviewers[i].ID)
+		}
+		for userID, load := range picked.Loads {
+			if selection.Loads == nil {
+				selection.Loads = make(map[domain.UserID]int)
 			}
+			selection.Loads[userID] = load
 		}
+
+		selection.Reviewers = append(selection.Reviewers, picked.Reviewers...)
+		shortfall -= len(picked.Reviewers)
+	}
+
+	return selection, nil
+}
+
+// setNeedMoreReviewers пишет флаг, только если он изменился.
+func (p *pullRequestUseCase) setNeedMoreReviewers(ctx context.Context, pr models.PullRequest, needMoreReviewers bool) error {
+	if pr.NeedMoreReviewers == needMoreReviewers {
+		return nil
 	}
 
-	p.logger.Errorw("No available active user to assign as reviewer")
+	if err := p.prStorage.UpdateNeedMoreReviewers(ctx, pr.ID, needMoreReviewers); err != nil {
+		p.logger.Errorw("Failed to update need more reviewers flag", "prID", pr.ID, "error", err)
+		return err
+	}
 
 	return nil
 }
 
-func (p *pullRequestUseCase) MergePR(ctx context.Context, prID domain.PRID) error {
-	return p.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadWrite,
+// needsMoreReviewers сообщает, что активных ревьюверов меньше min_reviewers команды.
+func needsMoreReviewers(team models.Team, reviewers []models.User) bool {
+	return countActive(reviewers) < team.MinReviewers
+}
+
+func countActive(users []models.User) int {
+	n := 0
+	for _, u := range users {
+		if u.StatusActivity {
+			n++
+		}
+	}
+	return n
+}
+
+func (p *pullRequestUseCase) countOpenReviews(ctx context.Context, userIDs []domain.UserID) (map[domain.UserID]int, error) {
+	counts, err := p.prStorage.CountOpenReviewsByReviewerIDs(ctx, userIDs)
+	if err != nil {
+		p.logger.Errorw("Failed to count open reviews", "userIDs", userIDs, "error", err)
+		return nil, err
+	}
+
+	loads := make(map[domain.UserID]int, len(userIDs))
+	for _, userID := range userIDs {
+		loads[userID] = counts[userID]
+	}
+
+	return loads, nil
+}
+
+// MergePR идемпотентен: для уже смерженного PR возвращает его как есть, не трогая merged_at и merged_by.
+func (p *pullRequestUseCase) MergePR(ctx context.Context, prID domain.PRID, mergedBy *domain.UserID) (*domain.PullRequest, error) {
+	var pr domain.PullRequest
+
+	err := p.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadWrite,
 		func(ctx context.Context) error {
-			pr, err := p.prStorage.GetPullRequestByID(ctx, prID)
+			if mergedBy != nil {
+				if _, err := p.userStorage.GetUserByID(ctx, *mergedBy); err != nil {
+					if errors.Is(err, repositoryerrs.ErrNotFound) {
+						p.logger.Errorw("Merging user not found", "prID", prID, "userID", *mergedBy)
+						return errs.ErrUserNotFound
+					}
+					p.logger.Errorw("Failed to get merging user", "prID", prID, "userID", *mergedBy, "error", err)
+					return err
+				}
+			}
+
+			prModel, err := p.getPullRequest(ctx, prID)
 			if err != nil {
-				if errors.Is(err, repositoryerrs.ErrNotFound) {
-					p.logger.Errorw("Failed to get pull request by ID", "prID", prID, "error", err)
-					return errs.ErrPullRequestNotFound
+				return err
+			}
+
+			if prModel.Status == domain.PRStatusClosed {
+				p.logger.Errorw("Pull request is closed, reopen it before merge", "prID", prID)
+				return errs.ErrPullRequestClosed
+			}
+
+			if prModel.Status == domain.PRStatusDraft {
+				p.logger.Errorw("Pull request is a draft, mark it ready before merge", "prID", prID)
+				return errs.ErrInvalidPullRequestTransition
+			}
+
+			if prModel.Status != domain.PRStatusMerged {
+				if err := p.checkMergePolicy(ctx, *prModel, mergedBy); err != nil {
+					return err
 				}
-				p.logger.Errorw("Failed to get pull request by ID", "prID", prID, "error", err)
+
+				merged, err := p.prStorage.MergePullRequest(ctx, prModel.ID, mergedBy)
+				if err != nil && !errors.Is(err, repositoryerrs.ErrNotFound) {
+					p.logger.Errorw("Failed to merge pull request", "prID", prModel.ID, "error", err)
+					return err
+				}
+
+				// ErrNotFound здесь значит, что статус PR успели сменить параллельно — перечитываем его.
+				// Успехом это считается, только если параллельно его и смержили.
+				if merged == nil {
+					if merged, err = p.prStorage.GetPullRequestByID(ctx, prModel.ID); err != nil {
+						p.logger.Errorw("Failed to get pull request by ID", "prID", prModel.ID, "error", err)
+						return err
+					}
+
+					if merged.Status == domain.PRStatusClosed {
+						p.logger.Errorw("Pull request was closed concurrently", "prID", prModel.ID)
+						return errs.ErrPullRequestClosed
+					}
+
+					if merged.Status != domain.PRStatusMerged {
+						p.logger.Errorw("Pull request status changed concurrently", "prID", prModel.ID, "status", merged.Status)
+						return errs.ErrInvalidPullRequestTransition
+					}
+				}
+				prModel = merged
+			}
+
+			pr, err = p.loadPullRequest(ctx, *prModel)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	p.logger.Infow("Successfully merged pull request", "prID", prID, "mergedBy", mergedBy)
+
+	return &pr, nil
+}
+
+// ClosePR закрывает OPEN PR без мержа. Статистика назначений, как и при мерже, не меняется.
+// Повторное закрытие ничего не меняет.
+func (p *pullRequestUseCase) ClosePR(ctx context.Context, prID domain.PRID) (*domain.PullRequest, error) {
+	var pr domain.PullRequest
+
+	err := p.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadWrite,
+		func(ctx context.Context) error {
+			prModel, err := p.getPullRequest(ctx, prID)
+			if err != nil {
 				return err
 			}
 
-			if pr.Status == domain.PRStatusMerged {
-				p.logger.Warnw("Pull Request already merged, skipping merge operation", "prID", prID)
-				return nil
+			if prModel.Status != domain.PRStatusClosed {
+				if err := p.transitPullRequest(ctx, prModel, domain.PRStatusClosed); err != nil {
+					return err
+				}
 			}
 
-			if err := p.prStorage.UpdatePullRequestStatus(ctx, pr.ID, domain.PRStatusMerged); err != nil {
-				p.logger.Errorw("Failed to update pull request status", "prID", pr.ID, "error", err)
+			pr, err = p.loadPullRequest(ctx, *prModel)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	p.logger.Infow("Successfully closed pull request", "prID", prID)
+
+	return &pr, nil
+}
+
+// ReopenPR возвращает CLOSED PR в OPEN. С reselectReviewers прежние ревьюверы снимаются
+// и выбираются заново по стратегии команды (новые назначения учитываются в статистике), иначе прежние ревьюверы остаются.
+func (p *pullRequestUseCase) ReopenPR(ctx context.Context, prID domain.PRID, reselectReviewers bool) (*domain.PullRequest, error) {
+	var pr domain.PullRequest
+
+	err := p.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadWrite,
+		func(ctx context.Context) error {
+			prModel, err := p.getPullRequest(ctx, prID)
+			if err != nil {
 				return err
 			}
 
-			p.logger.Infow("Successfully merged pull request", "prID", pr.ID)
+			if prModel.Status == domain.PRStatusDraft {
+				p.logger.Errorw("Draft pull request can not be reopened, mark it ready instead", "prID", prID)
+				return errs.ErrInvalidPullRequestTransition
+			}
+
+			if prModel.Status != domain.PRStatusOpen {
+				if err := p.transitPullRequest(ctx, prModel, domain.PRStatusOpen); err != nil {
+					return err
+				}
 
-			return nil
+				if err := p.restoreReviewers(ctx, *prModel, reselectReviewers); err != nil {
+					return err
+				}
+
+				// Флаг need_more_reviewers мог измениться при восстановлении ревьюверов.
+				if prModel, err = p.getPullRequest(ctx, prID); err != nil {
+					return err
+				}
+			}
+
+			pr, err = p.loadPullRequest(ctx, *prModel)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	p.logger.Infow("Successfully reopened pull request", "prID", prID, "reselectReviewers", reselectReviewers)
+
+	return &pr, nil
+}
+
+// ReadyPR переводит черновик в OPEN, фиксирует ready_at и назначает ревьюверов по стратегии команды.
+// Для уже открытого PR ничего не меняет.
+func (p *pullRequestUseCase) ReadyPR(ctx context.Context, prID domain.PRID) (*domain.PullRequest, error) {
+	var pr domain.PullRequest
+
+	err := p.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadWrite,
+		func(ctx context.Context) error {
+			prModel, err := p.getPullRequest(ctx, prID)
+			if err != nil {
+				return err
+			}
+
+			if prModel.Status != domain.PRStatusOpen {
+				if prModel.Status != domain.PRStatusDraft {
+					p.logger.Errorw("Only draft pull request can be marked ready", "prID", prID, "status", prModel.Status)
+					return errs.ErrInvalidPullRequestTransition
+				}
+
+				ready, err := p.prStorage.MarkPullRequestReady(ctx, prModel.ID)
+				if err != nil {
+					if errors.Is(err, repositoryerrs.ErrNotFound) {
+						p.logger.Errorw("Pull request status changed concurrently", "prID", prID)
+						return errs.ErrInvalidPullRequestTransition
+					}
+					p.logger.Errorw("Failed to mark pull request ready", "prID", prID, "error", err)
+					return err
+				}
+
+				if _, err := p.topUpReviewers(ctx, *ready); err != nil {
+					return err
+				}
+
+				// Флаг need_more_reviewers мог измениться при назначении ревьюверов.
+				if prModel, err = p.getPullRequest(ctx, prID); err != nil {
+					return err
+				}
+			}
+
+			pr, err = p.loadPullRequest(ctx, *prModel)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	p.logger.Infow("Successfully marked pull request ready", "prID", prID)
+
+	return &pr, nil
+}
+
+// ReviewPR записывает вердикт назначенного ревьювера по OPEN PR.
+func (p *pullRequestUseCase) ReviewPR(ctx context.Context, prID domain.PRID, reviewerID domain.UserID, verdict domain.ReviewVerdict) (*domain.PullRequest, error) {
+	var pr domain.PullRequest
+
+	if !verdict.IsValid() {
+		p.logger.Errorw("Invalid review verdict", "prID", prID, "verdict", verdict)
+		return nil, errs.ErrInvalidReviewVerdict
+	}
+
+	err := p.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadWrite,
+		func(ctx context.Context) error {
+			prModel, err := p.getPullRequest(ctx, prID)
+			if err != nil {
+				return err
+			}
+
+			if prModel.Status != domain.PRStatusOpen {
+				p.logger.Errorw("Pull request is not open for review", "prID", prID, "status", prModel.Status)
+				return errs.ErrPullRequestNotOpen
+			}
+
+			reviewers, err := p.prStorage.GetReviewersFromPR(ctx, prModel.ID)
+			if err != nil {
+				p.logger.Errorw("Failed to get reviewers from pull request", "prID", prModel.ID, "error", err)
+				return err
+			}
+
+			var reviewer *models.User
+			for i := range reviewers {
+				if sameUserID(reviewers[i].ID, reviewerID) {
+					reviewer = &reviewers[i]
+					break
+				}
+			}
+			if reviewer == nil {
+				p.logger.Errorw("Reviewer is not assigned to pull request", "prID", prID, "reviewerID", reviewerID)
+				return errs.ErrReviewerNotFoundInPullRequest
+			}
+
+			state := verdict.Apply(reviewer.ReviewState)
+			if err := p.prStorage.UpdateReviewState(ctx, prModel.ID, reviewer.ID, state); err != nil {
+				if errors.Is(err, repositoryerrs.ErrNotFound) {
+					p.logger.Errorw("Reviewer was removed from pull request concurrently", "prID", prID, "reviewerID", reviewerID)
+					return errs.ErrReviewerNotFoundInPullRequest
+				}
+				p.logger.Errorw("Failed to update review state", "prID", prID, "reviewerID", reviewerID, "error", err)
+				return err
+			}
+
+			pr, err = p.loadPullRequest(ctx, *prModel)
+			return err
 		},
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	p.logger.Infow("Successfully reviewed pull request", "prID", prID, "reviewerID", reviewerID, "verdict", verdict)
+
+	return &pr, nil
+}
+
+func (p *pullRequestUseCase) restoreReviewers(ctx context.Context, pr models.PullRequest, reselect bool) error {
+	reviewers, err := p.prStorage.GetReviewersFromPR(ctx, pr.ID)
+	if err != nil {
+		p.logger.Errorw("Failed to get reviewers from pull request", "prID", pr.ID, "error", err)
+		return err
+	}
+
+	if reselect {
+		for _, reviewer := range reviewers {
+			if err := p.prStorage.DeletePRReviewerInstance(ctx, pr.ID, reviewer.ID); err != nil {
+				p.logger.Errorw("Failed to delete PR reviewer instance", "prID", pr.ID, "reviewerID", reviewer.ID, "error", err)
+				return err
+			}
+		}
+
+		_, err := p.topUpReviewers(ctx, pr)
+		return err
+	}
+
+	team, err := p.pullRequestTeam(ctx, pr)
+	if err != nil {
+		return err
+	}
+
+	return p.setNeedMoreReviewers(ctx, pr, needsMoreReviewers(*team, reviewers))
+}
+
+// transitPullRequest проверяет переход по жизненному циклу PR и атомарно меняет статус.
+func (p *pullRequestUseCase) transitPullRequest(ctx context.Context, pr *models.PullRequest, to domain.PRStatus) error {
+	if !pr.Status.CanTransitionTo(to) {
+		p.logger.Errorw("Invalid pull request status transition", "prID", pr.ID, "from", pr.Status, "to", to)
+		return errs.ErrInvalidPullRequestTransition
+	}
+
+	if err := p.prStorage.UpdatePullRequestStatus(ctx, pr.ID, pr.Status, to); err != nil {
+		if errors.Is(err, repositoryerrs.ErrNotFound) {
+			p.logger.Errorw("Pull request status changed concurrently", "prID", pr.ID, "from", pr.Status, "to", to)
+			return errs.ErrInvalidPullRequestTransition
+		}
+		p.logger.Errorw("Failed to update pull request status", "prID", pr.ID, "error", err)
+		return err
+	}
+
+	pr.Status = to
+
+	return nil
+}
+
+func (p *pullRequestUseCase) getPullRequest(ctx context.Context, prID domain.PRID) (*models.PullRequest, error) {
+	pr, err := p.prStorage.GetPullRequestByID(ctx, prID)
+	if err != nil {
+		if errors.Is(err, repositoryerrs.ErrNotFound) {
+			p.logger.Errorw("Pull request not found", "prID", prID)
+			return nil, errs.ErrPullRequestNotFound
+		}
+		p.logger.Errorw("Failed to get pull request by ID", "prID", prID, "error", err)
+		return nil, err
+	}
+
+	return pr, nil
+}
+
+// loadPullRequest догружает автора и ревьюверов PR.
+func (p *pullRequestUseCase) loadPullRequest(ctx context.Context, pr models.PullRequest) (domain.PullRequest, error) {
+	authors, reviewers, err := p.prStorage.GetParticipantsByPRIDs(ctx, []domain.PRID{pr.ID})
+	if err != nil {
+		p.logger.Errorw("Failed to get pull request participants", "prID", pr.ID, "error", err)
+		return domain.PullRequest{}, err
+	}
+
+	return mapper.ModelToDomainPullRequest(pr, authors[pr.AuthorID], reviewers[pr.ID]), nil
 }
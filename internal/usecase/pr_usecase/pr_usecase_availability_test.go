@@ -0,0 +1,112 @@
+package pr_usecase
+
+import (
+	"app/internal/domain"
+	cachemock "app/internal/repository/cache/mock"
+	"app/internal/repository/models"
+	mock "app/internal/repository/storage/mock"
+	"app/internal/usecase/errs"
+	mocklog "app/pkg/logger/mock"
+	txmock "app/pkg/txmanager/mock"
+	"context"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreatePR_SkipsUnavailableReviewers(t *testing.T) {
+	Convey("CreatePR: teammates inside an unavailability window are not selected", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		availabilityStorage := mock.NewMockAvailabilityStorage(ctrl)
+		statsCache := cachemock.NewMockStatsCache(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, userStorage, statsCache, teamStorage, mocktx, mockLog,
+			WithAvailabilityStorage(availabilityStorage))
+
+		authorID := domain.UserID("u1")
+		prID := domain.PRID("p1")
+		teamID := domain.TeamID(5)
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		userStorage.EXPECT().GetUserByID(gomock.Any(), authorID).Return(&models.User{ID: authorID}, nil)
+		prStorage.EXPECT().CreatePullRequest(gomock.Any(), prID, "pr", authorID, domain.PRStatusOpen).
+			Return(&models.PullRequest{ID: prID, Name: "pr", AuthorID: authorID}, nil)
+		teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), authorID).
+			Return(&models.Team{ID: teamID, TeamSettings: models.TeamSettings{MinReviewers: 2, MaxReviewers: 2}}, nil)
+		teamStorage.EXPECT().GetUsersByTeam(gomock.Any(), teamID).
+			Return([]models.User{
+				{ID: authorID, StatusActivity: true},
+				{ID: "u2", StatusActivity: true},
+				{ID: "u3", StatusActivity: true},
+			}, nil)
+
+		availabilityStorage.EXPECT().GetUnavailableUserIDs(gomock.Any(), []domain.UserID{"u2", "u3"}, gomock.Any()).
+			Return(map[domain.UserID]bool{"u2": true}, nil)
+		teamStorage.EXPECT().GetFallbackTeams(gomock.Any(), teamID).Return(nil, nil)
+
+		prStorage.EXPECT().CreatePRReviewerInstance(gomock.Any(), prID, domain.UserID("u3"), nil).Return(nil)
+		statsCache.EXPECT().IncrementAssignCountByUserID(gomock.Any(), domain.UserID("u3")).Return(nil)
+		prStorage.EXPECT().UpdateNeedMoreReviewers(gomock.Any(), prID, true).Return(nil)
+
+		pr, err := uc.CreatePR(context.Background(), authorID, prID, "pr", domain.CreatePullRequestOptions{})
+
+		So(err, ShouldBeNil)
+		So(pr.Reviewers, ShouldHaveLength, 1)
+		So(pr.Reviewers[0].ID, ShouldEqual, domain.UserID("u3"))
+		So(pr.NeedMoreReviewers, ShouldBeTrue)
+	})
+}
+
+func TestAddReviewer_Unavailable(t *testing.T) {
+	Convey("AddReviewer: a teammate on vacation can not be assigned manually", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		availabilityStorage := mock.NewMockAvailabilityStorage(ctrl)
+		statsCache := cachemock.NewMockStatsCache(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, userStorage, statsCache, teamStorage, mocktx, mockLog,
+			WithAvailabilityStorage(availabilityStorage))
+
+		prID := domain.PRID("p1")
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		prStorage.EXPECT().GetPullRequestByID(gomock.Any(), prID).
+			Return(&models.PullRequest{ID: prID, AuthorID: "u1", Status: domain.PRStatusOpen}, nil)
+		prStorage.EXPECT().GetReviewersFromPR(gomock.Any(), prID).Return(nil, nil)
+		userStorage.EXPECT().GetUserByID(gomock.Any(), domain.UserID("u3")).
+			Return(&models.User{ID: "u3", StatusActivity: true}, nil)
+		availabilityStorage.EXPECT().GetUnavailableUserIDs(gomock.Any(), []domain.UserID{"u3"}, gomock.Any()).
+			Return(map[domain.UserID]bool{"u3": true}, nil)
+
+		_, err := uc.AddReviewer(context.Background(), prID, "u3")
+
+		So(err, ShouldEqual, errs.ErrReviewerUnavailable)
+	})
+}
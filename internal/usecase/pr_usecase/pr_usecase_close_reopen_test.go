@@ -0,0 +1,257 @@
+package pr_usecase
+
+import (
+	"app/internal/domain"
+	cachemock "app/internal/repository/cache/mock"
+	repoerrors "app/internal/repository/errs"
+	"app/internal/repository/models"
+	mock "app/internal/repository/storage/mock"
+	"app/internal/usecase/errs"
+	mocklog "app/pkg/logger/mock"
+	txmock "app/pkg/txmanager/mock"
+	"context"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestClosePR_KeepsAssignStats(t *testing.T) {
+	Convey("ClosePR: OPEN PR becomes CLOSED and reviewers' assign counts are kept", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		statsCache := cachemock.NewMockStatsCache(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, mock.NewMockUserStorage(ctrl), statsCache, mock.NewMockTeamStorage(ctrl), mocktx, mockLog)
+
+		prID := domain.PRID("p1")
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		prStorage.EXPECT().GetPullRequestByID(gomock.Any(), prID).
+			Return(&models.PullRequest{ID: prID, AuthorID: "u1", Status: domain.PRStatusOpen}, nil)
+		prStorage.EXPECT().UpdatePullRequestStatus(gomock.Any(), prID, domain.PRStatusOpen, domain.PRStatusClosed).Return(nil)
+
+		statsCache.EXPECT().DecrementAssignCountByUserID(gomock.Any(), gomock.Any()).Times(0)
+
+		prStorage.EXPECT().GetParticipantsByPRIDs(gomock.Any(), []domain.PRID{prID}).
+			Return(map[domain.UserID]models.User{}, map[domain.PRID][]models.User{}, nil)
+
+		pr, err := uc.ClosePR(context.Background(), prID)
+
+		So(err, ShouldBeNil)
+		So(pr.Status, ShouldEqual, domain.PRStatusClosed)
+	})
+}
+
+func TestClosePR_Merged(t *testing.T) {
+	Convey("ClosePR: MERGED PR can't be closed", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, mock.NewMockUserStorage(ctrl), cachemock.NewMockStatsCache(ctrl),
+			mock.NewMockTeamStorage(ctrl), mocktx, mockLog)
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		prStorage.EXPECT().GetPullRequestByID(gomock.Any(), domain.PRID("p1")).
+			Return(&models.PullRequest{ID: "p1", Status: domain.PRStatusMerged}, nil)
+
+		_, err := uc.ClosePR(context.Background(), "p1")
+
+		So(err, ShouldEqual, errs.ErrInvalidPullRequestTransition)
+	})
+}
+
+func TestClosePR_ConcurrentChange(t *testing.T) {
+	Convey("ClosePR: status changed between read and update", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, mock.NewMockUserStorage(ctrl), cachemock.NewMockStatsCache(ctrl),
+			mock.NewMockTeamStorage(ctrl), mocktx, mockLog)
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		prStorage.EXPECT().GetPullRequestByID(gomock.Any(), domain.PRID("p1")).
+			Return(&models.PullRequest{ID: "p1", Status: domain.PRStatusOpen}, nil)
+		prStorage.EXPECT().UpdatePullRequestStatus(gomock.Any(), domain.PRID("p1"), domain.PRStatusOpen, domain.PRStatusClosed).
+			Return(repoerrors.ErrNotFound)
+
+		_, err := uc.ClosePR(context.Background(), "p1")
+
+		So(err, ShouldEqual, errs.ErrInvalidPullRequestTransition)
+	})
+}
+
+func TestReopenPR_KeepsReviewers(t *testing.T) {
+	Convey("ReopenPR: previous reviewers stay without new assignments and the flag is recomputed", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		statsCache := cachemock.NewMockStatsCache(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, mock.NewMockUserStorage(ctrl), statsCache, teamStorage, mocktx, mockLog)
+
+		prID := domain.PRID("p1")
+		authorID := domain.UserID("u1")
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		gomock.InOrder(
+			prStorage.EXPECT().GetPullRequestByID(gomock.Any(), prID).
+				Return(&models.PullRequest{ID: prID, AuthorID: authorID, Status: domain.PRStatusClosed}, nil),
+			prStorage.EXPECT().UpdatePullRequestStatus(gomock.Any(), prID, domain.PRStatusClosed, domain.PRStatusOpen).Return(nil),
+			prStorage.EXPECT().GetReviewersFromPR(gomock.Any(), prID).
+				Return([]models.User{{ID: "u2", StatusActivity: true}, {ID: "u3", StatusActivity: false}}, nil),
+		)
+
+		statsCache.EXPECT().IncrementAssignCountByUserID(gomock.Any(), gomock.Any()).Times(0)
+
+		teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), authorID).
+			Return(&models.Team{ID: 1, TeamSettings: models.TeamSettings{MinReviewers: 2, MaxReviewers: 2}}, nil)
+
+		prStorage.EXPECT().UpdateNeedMoreReviewers(gomock.Any(), prID, true).Return(nil)
+
+		prStorage.EXPECT().GetPullRequestByID(gomock.Any(), prID).
+			Return(&models.PullRequest{ID: prID, AuthorID: authorID, Status: domain.PRStatusOpen, NeedMoreReviewers: true}, nil)
+		prStorage.EXPECT().GetParticipantsByPRIDs(gomock.Any(), []domain.PRID{prID}).
+			Return(map[domain.UserID]models.User{}, map[domain.PRID][]models.User{}, nil)
+
+		pr, err := uc.ReopenPR(context.Background(), prID, false)
+
+		So(err, ShouldBeNil)
+		So(pr.Status, ShouldEqual, domain.PRStatusOpen)
+		So(pr.NeedMoreReviewers, ShouldBeTrue)
+	})
+}
+
+func TestReopenPR_ReselectReviewers(t *testing.T) {
+	Convey("ReopenPR: with reselect previous reviewers are dropped and new ones selected", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		statsCache := cachemock.NewMockStatsCache(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, userStorage, statsCache, teamStorage, mocktx, mockLog)
+
+		prID := domain.PRID("p1")
+		authorID := domain.UserID("u1")
+		teamID := domain.TeamID(1)
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		gomock.InOrder(
+			prStorage.EXPECT().GetPullRequestByID(gomock.Any(), prID).
+				Return(&models.PullRequest{ID: prID, AuthorID: authorID, Status: domain.PRStatusClosed, NeedMoreReviewers: true}, nil),
+			prStorage.EXPECT().UpdatePullRequestStatus(gomock.Any(), prID, domain.PRStatusClosed, domain.PRStatusOpen).Return(nil),
+			prStorage.EXPECT().GetReviewersFromPR(gomock.Any(), prID).
+				Return([]models.User{{ID: "u2", StatusActivity: false}}, nil),
+			prStorage.EXPECT().DeletePRReviewerInstance(gomock.Any(), prID, domain.UserID("u2")).Return(nil),
+			prStorage.EXPECT().GetReviewersFromPR(gomock.Any(), prID).Return([]models.User{}, nil),
+		)
+
+		teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), authorID).
+			Return(&models.Team{ID: teamID, TeamSettings: models.TeamSettings{MinReviewers: 1, MaxReviewers: 1}}, nil)
+
+		userStorage.EXPECT().GetActiveUsersByTeam(gomock.Any(), teamID).
+			Return([]models.User{{ID: authorID, StatusActivity: true}, {ID: "u3", StatusActivity: true}}, nil)
+
+		prStorage.EXPECT().CreatePRReviewerInstance(gomock.Any(), prID, domain.UserID("u3"), nil).Return(nil)
+		statsCache.EXPECT().IncrementAssignCountByUserID(gomock.Any(), domain.UserID("u3")).Return(nil)
+		prStorage.EXPECT().UpdateNeedMoreReviewers(gomock.Any(), prID, false).Return(nil)
+
+		prStorage.EXPECT().GetPullRequestByID(gomock.Any(), prID).
+			Return(&models.PullRequest{ID: prID, AuthorID: authorID, Status: domain.PRStatusOpen}, nil)
+		prStorage.EXPECT().GetParticipantsByPRIDs(gomock.Any(), []domain.PRID{prID}).
+			Return(
+				map[domain.UserID]models.User{authorID: {ID: authorID}},
+				map[domain.PRID][]models.User{prID: {{ID: "u3", StatusActivity: true}}},
+				nil,
+			)
+
+		pr, err := uc.ReopenPR(context.Background(), prID, true)
+
+		So(err, ShouldBeNil)
+		So(pr.Status, ShouldEqual, domain.PRStatusOpen)
+		So(pr.Reviewers, ShouldHaveLength, 1)
+		So(pr.Reviewers[0].ID, ShouldEqual, domain.UserID("u3"))
+	})
+}
+
+func TestMergePR_Closed(t *testing.T) {
+	Convey("MergePR: CLOSED PR must be reopened first", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, mock.NewMockUserStorage(ctrl), cachemock.NewMockStatsCache(ctrl),
+			mock.NewMockTeamStorage(ctrl), mocktx, mockLog)
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		prStorage.EXPECT().GetPullRequestByID(gomock.Any(), domain.PRID("p1")).
+			Return(&models.PullRequest{ID: "p1", Status: domain.PRStatusClosed}, nil)
+
+		_, err := uc.MergePR(context.Background(), "p1", nil)
+
+		So(err, ShouldEqual, errs.ErrPullRequestClosed)
+	})
+}
@@ -0,0 +1,134 @@
+package pr_usecase
+
+import (
+	"app/internal/domain"
+	cachemock "app/internal/repository/cache/mock"
+	"app/internal/repository/models"
+	mock "app/internal/repository/storage/mock"
+	mocklog "app/pkg/logger/mock"
+	txmock "app/pkg/txmanager/mock"
+	"context"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreatePR_CodeOwnersPreferred(t *testing.T) {
+	Convey("CreatePR: owners of changed files are assigned first, the rest comes from the team", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		codeOwnerStorage := mock.NewMockCodeOwnerStorage(ctrl)
+		statsCache := cachemock.NewMockStatsCache(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, userStorage, statsCache, teamStorage, mocktx, mockLog,
+			WithCodeOwnerStorage(codeOwnerStorage))
+
+		authorID := domain.UserID("u1")
+		prID := domain.PRID("p1")
+		teamID := domain.TeamID(5)
+		ownerUserID := domain.UserID("u9")
+		ownerTeamID := domain.TeamID(8)
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		userStorage.EXPECT().GetUserByID(gomock.Any(), authorID).Return(&models.User{ID: authorID}, nil)
+		prStorage.EXPECT().CreatePullRequest(gomock.Any(), prID, "pr", authorID, domain.PRStatusOpen).
+			Return(&models.PullRequest{ID: prID, Name: "pr", AuthorID: authorID}, nil)
+		teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), authorID).
+			Return(&models.Team{ID: teamID, TeamSettings: models.TeamSettings{MinReviewers: 2, MaxReviewers: 3}}, nil)
+		teamStorage.EXPECT().GetUsersByTeam(gomock.Any(), teamID).
+			Return([]models.User{
+				{ID: authorID, StatusActivity: true},
+				{ID: "u2", StatusActivity: true},
+				{ID: "u3", StatusActivity: true},
+			}, nil)
+
+		// Для index.go действует последнее подходящее правило — каталог поиска, поэтому u9 не запрашивается.
+		codeOwnerStorage.EXPECT().GetCodeOwnerRules(gomock.Any()).
+			Return([]models.CodeOwnerRule{
+				{ID: 1, Pattern: "*.go", OwnerUserID: &ownerUserID},
+				{ID: 2, Pattern: "/internal/search/", OwnerTeamID: &ownerTeamID},
+			}, nil)
+		userStorage.EXPECT().GetActiveUsersByTeam(gomock.Any(), ownerTeamID).
+			Return([]models.User{{ID: authorID, StatusActivity: true}, {ID: "u5", StatusActivity: true}}, nil)
+
+		prStorage.EXPECT().CreatePRReviewerInstance(gomock.Any(), prID, gomock.Any(), nil).Return(nil).Times(3)
+		statsCache.EXPECT().IncrementAssignCountByUserID(gomock.Any(), gomock.Any()).Return(nil).Times(3)
+		prStorage.EXPECT().UpdateNeedMoreReviewers(gomock.Any(), prID, false).Return(nil)
+
+		pr, err := uc.CreatePR(context.Background(), authorID, prID, "pr", domain.CreatePullRequestOptions{ChangedFiles: []string{"internal/search/index.go", "README.md"}})
+
+		So(err, ShouldBeNil)
+		So(pr.Reviewers, ShouldHaveLength, 3)
+		So(pr.Reviewers[0].ID, ShouldEqual, domain.UserID("u5"))
+		So(pr.NeedMoreReviewers, ShouldBeFalse)
+	})
+}
+
+func TestCreatePR_NoMatchingCodeOwners(t *testing.T) {
+	Convey("CreatePR: without matching rules reviewers come from the author's team", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		codeOwnerStorage := mock.NewMockCodeOwnerStorage(ctrl)
+		statsCache := cachemock.NewMockStatsCache(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, userStorage, statsCache, teamStorage, mocktx, mockLog,
+			WithCodeOwnerStorage(codeOwnerStorage))
+
+		authorID := domain.UserID("u1")
+		prID := domain.PRID("p1")
+		teamID := domain.TeamID(5)
+		ownerUserID := domain.UserID("u9")
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		userStorage.EXPECT().GetUserByID(gomock.Any(), authorID).Return(&models.User{ID: authorID}, nil)
+		prStorage.EXPECT().CreatePullRequest(gomock.Any(), prID, "pr", authorID, domain.PRStatusOpen).
+			Return(&models.PullRequest{ID: prID, Name: "pr", AuthorID: authorID}, nil)
+		teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), authorID).
+			Return(&models.Team{ID: teamID, TeamSettings: models.TeamSettings{MinReviewers: 2, MaxReviewers: 2}}, nil)
+		teamStorage.EXPECT().GetUsersByTeam(gomock.Any(), teamID).
+			Return([]models.User{
+				{ID: authorID, StatusActivity: true},
+				{ID: "u2", StatusActivity: true},
+				{ID: "u3", StatusActivity: true},
+			}, nil)
+
+		codeOwnerStorage.EXPECT().GetCodeOwnerRules(gomock.Any()).
+			Return([]models.CodeOwnerRule{{ID: 1, Pattern: "/docs/", OwnerUserID: &ownerUserID}}, nil)
+
+		prStorage.EXPECT().CreatePRReviewerInstance(gomock.Any(), prID, gomock.Any(), nil).Return(nil).Times(2)
+		statsCache.EXPECT().IncrementAssignCountByUserID(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+		prStorage.EXPECT().UpdateNeedMoreReviewers(gomock.Any(), prID, false).Return(nil)
+
+		pr, err := uc.CreatePR(context.Background(), authorID, prID, "pr", domain.CreatePullRequestOptions{ChangedFiles: []string{"internal/search/index.go"}})
+
+		So(err, ShouldBeNil)
+		So(pr.Reviewers, ShouldHaveLength, 2)
+	})
+}
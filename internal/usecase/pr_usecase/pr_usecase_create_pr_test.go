@@ -47,7 +47,7 @@ func TestCreatePR_UserNotFound(t *testing.T) {
 				return fn(ctx)
 			})
 
-		_, err := uc.CreatePR(context.Background(), authorID, prID, prName)
+		_, err := uc.CreatePR(context.Background(), authorID, prID, prName, domain.CreatePullRequestOptions{})
 
 		So(err, ShouldEqual, errs.ErrUserNotFound)
 	})
@@ -81,7 +81,7 @@ func TestCreatePR_AlreadyExists(t *testing.T) {
 			Return(&models.User{ID: userID}, nil)
 
 		prStorage.EXPECT().
-			CreatePullRequest(gomock.Any(), prID, prName, authorID).
+			CreatePullRequest(gomock.Any(), prID, prName, authorID, domain.PRStatusOpen).
 			Return(nil, repoerrors.ErrAlreadyExists)
 
 		mocktx.EXPECT().
@@ -90,7 +90,7 @@ func TestCreatePR_AlreadyExists(t *testing.T) {
 				return fn(ctx)
 			})
 
-		_, err := uc.CreatePR(context.Background(), authorID, prID, prName)
+		_, err := uc.CreatePR(context.Background(), authorID, prID, prName, domain.CreatePullRequestOptions{})
 		So(err, ShouldEqual, errs.ErrPullRequestAlreadyExists)
 	})
 }
@@ -122,7 +122,7 @@ func TestCreatePR_NoTeam(t *testing.T) {
 			Return(&models.User{ID: userID}, nil)
 
 		prStorage.EXPECT().
-			CreatePullRequest(gomock.Any(), prID, prName, authorID).
+			CreatePullRequest(gomock.Any(), prID, prName, authorID, domain.PRStatusOpen).
 			Return(&models.PullRequest{ID: "1"}, nil)
 
 		teamStorage.EXPECT().
@@ -134,7 +134,7 @@ func TestCreatePR_NoTeam(t *testing.T) {
 				return fn(ctx)
 			})
 
-		_, err := uc.CreatePR(context.Background(), authorID, prID, prName)
+		_, err := uc.CreatePR(context.Background(), authorID, prID, prName, domain.CreatePullRequestOptions{})
 		So(err, ShouldEqual, errs.ErrUserHasNoTeam)
 	})
 }
@@ -160,7 +160,7 @@ func TestCreatePR_InvalidPRName(t *testing.T) {
 		prID := domain.PRID("p1")
 		prName := ""
 
-		_, err := uc.CreatePR(context.Background(), authorID, prID, prName)
+		_, err := uc.CreatePR(context.Background(), authorID, prID, prName, domain.CreatePullRequestOptions{})
 		So(err, ShouldEqual, errs.ErrInvalidPullRequestName)
 	})
 }
@@ -186,7 +186,7 @@ func TestCreatePR_InvalidAuthorID(t *testing.T) {
 		prID := domain.PRID("p1")
 		prName := "few"
 
-		_, err := uc.CreatePR(context.Background(), authorID, prID, prName)
+		_, err := uc.CreatePR(context.Background(), authorID, prID, prName, domain.CreatePullRequestOptions{})
 		So(err, ShouldEqual, errs.ErrInvalidUserID)
 	})
 }
@@ -212,7 +212,7 @@ func TestCreatePR_InvalidPRID(t *testing.T) {
 		prID := domain.PRID("")
 		prName := "wf"
 
-		_, err := uc.CreatePR(context.Background(), authorID, prID, prName)
+		_, err := uc.CreatePR(context.Background(), authorID, prID, prName, domain.CreatePullRequestOptions{})
 		So(err, ShouldEqual, errs.ErrInvalidPullRequestID)
 	})
 }
@@ -248,7 +248,7 @@ func TestCreatePR_Success(t *testing.T) {
 			Return(&models.User{ID: authorID}, nil)
 
 		prStorage.EXPECT().
-			CreatePullRequest(gomock.Any(), prID, prName, authorID).
+			CreatePullRequest(gomock.Any(), prID, prName, authorID, domain.PRStatusOpen).
 			Return(&models.PullRequest{ID: prID, Name: prName, AuthorID: authorID}, nil)
 
 		teamStorage.EXPECT().
@@ -264,20 +264,24 @@ func TestCreatePR_Success(t *testing.T) {
 			}, nil)
 
 		prStorage.EXPECT().
-			CreatePRReviewerInstance(gomock.Any(), prID, userID3).
+			CreatePRReviewerInstance(gomock.Any(), prID, userID3, nil).
 			AnyTimes()
 
 		prStorage.EXPECT().
-			CreatePRReviewerInstance(gomock.Any(), prID, userID4).
+			CreatePRReviewerInstance(gomock.Any(), prID, userID4, nil).
 			AnyTimes()
 
+		prStorage.EXPECT().
+			UpdateNeedMoreReviewers(gomock.Any(), prID, gomock.Any()).
+			Return(nil)
+
 
 		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
 				return fn(ctx)
 			})
 
-		pr, err := uc.CreatePR(context.Background(), authorID, prID, prName)
+		pr, err := uc.CreatePR(context.Background(), authorID, prID, prName, domain.CreatePullRequestOptions{})
 
 		So(err, ShouldBeNil)
 		So(pr.ID.String(), ShouldEqual, prID.String())
@@ -313,7 +317,7 @@ func TestCreatePR_SuccessEmptyTeam(t *testing.T) {
 			Return(&models.User{ID: authorID}, nil)
 
 		prStorage.EXPECT().
-			CreatePullRequest(gomock.Any(), prID, prName, authorID).
+			CreatePullRequest(gomock.Any(), prID, prName, authorID, domain.PRStatusOpen).
 			Return(&models.PullRequest{ID: prID, Name: prName, AuthorID: authorID}, nil)
 
 		teamStorage.EXPECT().
@@ -326,15 +330,208 @@ func TestCreatePR_SuccessEmptyTeam(t *testing.T) {
 				{ID: authorID},
 			}, nil)
 
+		prStorage.EXPECT().
+			UpdateNeedMoreReviewers(gomock.Any(), prID, false).
+			Return(nil)
+
 		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
 				return fn(ctx)
 			})
 
-		pr, err := uc.CreatePR(context.Background(), authorID, prID, prName)
+		pr, err := uc.CreatePR(context.Background(), authorID, prID, prName, domain.CreatePullRequestOptions{})
 
 		So(err, ShouldBeNil)
 		So(pr.ID.String(), ShouldEqual, prID.String())
 	})
 }
 
+
+func TestCreatePR_LeastLoaded(t *testing.T) {
+	Convey("CreatePR: least loaded strategy picks reviewers with fewest open reviews", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		statsCache := cachemock.NewMockStatsCache(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, userStorage, statsCache, teamStorage, mocktx, mockLog,
+			WithSelectionConfig(SelectionConfig{Strategy: domain.ReviewerStrategyLeastLoaded, Seed: 1}))
+
+		authorID := domain.UserID("u1")
+		prID := domain.PRID("p1")
+		prName := "pr"
+		teamID := domain.TeamID(5)
+
+		userStorage.EXPECT().
+			GetUserByID(gomock.Any(), authorID).
+			Return(&models.User{ID: authorID}, nil)
+
+		prStorage.EXPECT().
+			CreatePullRequest(gomock.Any(), prID, prName, authorID, domain.PRStatusOpen).
+			Return(&models.PullRequest{ID: prID, Name: prName, AuthorID: authorID}, nil)
+
+		teamStorage.EXPECT().
+			GetTeamByUserID(gomock.Any(), authorID).
+			Return(&models.Team{ID: teamID, TeamSettings: models.TeamSettings{MinReviewers: 2, MaxReviewers: 2}}, nil)
+
+		teamStorage.EXPECT().
+			GetUsersByTeam(gomock.Any(), teamID).
+			Return([]models.User{
+				{ID: authorID, StatusActivity: true},
+				{ID: "u2", StatusActivity: true},
+				{ID: "u3", StatusActivity: true},
+				{ID: "u4", StatusActivity: true},
+			}, nil)
+
+		prStorage.EXPECT().
+			CountOpenReviewsByReviewerIDs(gomock.Any(), gomock.Any()).
+			Return(map[domain.UserID]int{"u2": 4, "u3": 1}, nil)
+
+		prStorage.EXPECT().CreatePRReviewerInstance(gomock.Any(), prID, domain.UserID("u4"), nil).Return(nil).MaxTimes(1)
+		prStorage.EXPECT().CreatePRReviewerInstance(gomock.Any(), prID, domain.UserID("u3"), nil).Return(nil).MaxTimes(1)
+		statsCache.EXPECT().IncrementAssignCountByUserID(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		prStorage.EXPECT().UpdateNeedMoreReviewers(gomock.Any(), prID, false).Return(nil)
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		pr, err := uc.CreatePR(context.Background(), authorID, prID, prName, domain.CreatePullRequestOptions{})
+
+		So(err, ShouldBeNil)
+		So(pr.Reviewers[0].ID, ShouldEqual, domain.UserID("u4"))
+		So(pr.CandidateLoads, ShouldResemble, map[domain.UserID]int{"u2": 4, "u3": 1, "u4": 0})
+	})
+}
+
+func TestCreatePR_NeedMoreReviewers(t *testing.T) {
+	Convey("CreatePR: too few active teammates sets need_more_reviewers", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		statsCache := cachemock.NewMockStatsCache(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, userStorage, statsCache, teamStorage, mocktx, mockLog)
+
+		authorID := domain.UserID("u1")
+		prID := domain.PRID("p1")
+		prName := "pr"
+		teamID := domain.TeamID(5)
+
+		userStorage.EXPECT().
+			GetUserByID(gomock.Any(), authorID).
+			Return(&models.User{ID: authorID}, nil)
+
+		prStorage.EXPECT().
+			CreatePullRequest(gomock.Any(), prID, prName, authorID, domain.PRStatusOpen).
+			Return(&models.PullRequest{ID: prID, Name: prName, AuthorID: authorID}, nil)
+
+		teamStorage.EXPECT().
+			GetTeamByUserID(gomock.Any(), authorID).
+			Return(&models.Team{ID: teamID, TeamSettings: models.TeamSettings{MinReviewers: 2, MaxReviewers: 3}}, nil)
+
+		teamStorage.EXPECT().
+			GetUsersByTeam(gomock.Any(), teamID).
+			Return([]models.User{
+				{ID: authorID, StatusActivity: true},
+				{ID: "u2", StatusActivity: true},
+				{ID: "u3", StatusActivity: false},
+			}, nil)
+
+		teamStorage.EXPECT().GetFallbackTeams(gomock.Any(), teamID).Return(nil, nil)
+
+		prStorage.EXPECT().CreatePRReviewerInstance(gomock.Any(), prID, domain.UserID("u2"), nil).Return(nil)
+		statsCache.EXPECT().IncrementAssignCountByUserID(gomock.Any(), domain.UserID("u2")).Return(nil)
+		prStorage.EXPECT().UpdateNeedMoreReviewers(gomock.Any(), prID, true).Return(nil)
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		pr, err := uc.CreatePR(context.Background(), authorID, prID, prName, domain.CreatePullRequestOptions{})
+
+		So(err, ShouldBeNil)
+		So(pr.Reviewers, ShouldHaveLength, 1)
+		So(pr.NeedMoreReviewers, ShouldBeTrue)
+	})
+}
+
+func TestCreatePR_FallbackTeams(t *testing.T) {
+	Convey("CreatePR: short home team is topped up from fallback teams by priority", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		statsCache := cachemock.NewMockStatsCache(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, userStorage, statsCache, teamStorage, mocktx, mockLog)
+
+		authorID := domain.UserID("u1")
+		prID := domain.PRID("p1")
+		teamID := domain.TeamID(5)
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		userStorage.EXPECT().GetUserByID(gomock.Any(), authorID).Return(&models.User{ID: authorID}, nil)
+		prStorage.EXPECT().CreatePullRequest(gomock.Any(), prID, "pr", authorID, domain.PRStatusOpen).
+			Return(&models.PullRequest{ID: prID, Name: "pr", AuthorID: authorID}, nil)
+		teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), authorID).
+			Return(&models.Team{ID: teamID, TeamName: "small", TeamSettings: models.TeamSettings{MinReviewers: 3, MaxReviewers: 3}}, nil)
+		teamStorage.EXPECT().GetUsersByTeam(gomock.Any(), teamID).
+			Return([]models.User{
+				{ID: authorID, StatusActivity: true},
+				{ID: "u2", StatusActivity: true},
+			}, nil)
+
+		// В первой запасной команде свободен только u3 (u1 — автор), остальное берётся из второй.
+		teamStorage.EXPECT().GetFallbackTeams(gomock.Any(), teamID).
+			Return([]models.Team{{ID: 6, TeamName: "first"}, {ID: 7, TeamName: "second"}}, nil)
+		userStorage.EXPECT().GetActiveUsersByTeam(gomock.Any(), domain.TeamID(6)).
+			Return([]models.User{{ID: authorID, StatusActivity: true}, {ID: "u3", StatusActivity: true}}, nil)
+		userStorage.EXPECT().GetActiveUsersByTeam(gomock.Any(), domain.TeamID(7)).
+			Return([]models.User{{ID: "u4", StatusActivity: true}}, nil)
+
+		// Происхождение ревьювера сохраняется вместе с назначением.
+		firstID, secondID := domain.TeamID(6), domain.TeamID(7)
+		prStorage.EXPECT().CreatePRReviewerInstance(gomock.Any(), prID, domain.UserID("u2"), nil).Return(nil)
+		prStorage.EXPECT().CreatePRReviewerInstance(gomock.Any(), prID, domain.UserID("u3"), &firstID).Return(nil)
+		prStorage.EXPECT().CreatePRReviewerInstance(gomock.Any(), prID, domain.UserID("u4"), &secondID).Return(nil)
+		statsCache.EXPECT().IncrementAssignCountByUserID(gomock.Any(), gomock.Any()).Return(nil).Times(3)
+		prStorage.EXPECT().UpdateNeedMoreReviewers(gomock.Any(), prID, false).Return(nil)
+
+		pr, err := uc.CreatePR(context.Background(), authorID, prID, "pr", domain.CreatePullRequestOptions{})
+
+		So(err, ShouldBeNil)
+		So(pr.Reviewers, ShouldHaveLength, 3)
+		So(pr.NeedMoreReviewers, ShouldBeFalse)
+		So(pr.FallbackReviewers, ShouldResemble, map[domain.UserID]string{"u3": "first", "u4": "second"})
+	})
+}
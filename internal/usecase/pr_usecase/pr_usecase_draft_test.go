@@ -0,0 +1,253 @@
+package pr_usecase
+
+import (
+	"app/internal/domain"
+	cachemock "app/internal/repository/cache/mock"
+	repoerrors "app/internal/repository/errs"
+	"app/internal/repository/models"
+	mock "app/internal/repository/storage/mock"
+	"app/internal/usecase/errs"
+	mocklog "app/pkg/logger/mock"
+	txmock "app/pkg/txmanager/mock"
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreatePR_DraftSkipsReviewerSelection(t *testing.T) {
+	Convey("CreatePR: draft is created without reviewers, but the author's team is checked", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, userStorage, cachemock.NewMockStatsCache(ctrl),
+			teamStorage, mocktx, mockLog)
+
+		prID := domain.PRID("p1")
+		authorID := domain.UserID("u1")
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		userStorage.EXPECT().GetUserByID(gomock.Any(), authorID).
+			Return(&models.User{ID: authorID, Name: "author", StatusActivity: true}, nil)
+		prStorage.EXPECT().CreatePullRequest(gomock.Any(), prID, "draft", authorID, domain.PRStatusDraft).
+			Return(&models.PullRequest{ID: prID, Name: "draft", AuthorID: authorID, Status: domain.PRStatusDraft}, nil)
+
+		Convey("author with a team gets a draft", func() {
+			teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), authorID).
+				Return(&models.Team{ID: 1, TeamName: "backend"}, nil)
+
+			pr, err := uc.CreatePR(context.Background(), authorID, prID, "draft", domain.CreatePullRequestOptions{Draft: true})
+
+			So(err, ShouldBeNil)
+			So(pr.Status, ShouldEqual, domain.PRStatusDraft)
+			So(pr.Reviewers, ShouldBeEmpty)
+			So(pr.ReadyAt, ShouldBeNil)
+		})
+
+		Convey("author without a team is rejected at creation", func() {
+			teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), authorID).Return(nil, repoerrors.ErrNotFound)
+
+			pr, err := uc.CreatePR(context.Background(), authorID, prID, "draft", domain.CreatePullRequestOptions{Draft: true})
+
+			So(pr, ShouldBeNil)
+			So(err, ShouldEqual, errs.ErrUserHasNoTeam)
+		})
+	})
+}
+
+func TestReadyPR_AssignsReviewers(t *testing.T) {
+	Convey("ReadyPR: draft becomes OPEN, ready_at is recorded and reviewers are selected", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		statsCache := cachemock.NewMockStatsCache(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, userStorage, statsCache, teamStorage, mocktx, mockLog)
+
+		prID := domain.PRID("p1")
+		authorID := domain.UserID("u1")
+		teamID := domain.TeamID(1)
+		readyAt := time.Now()
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		gomock.InOrder(
+			prStorage.EXPECT().GetPullRequestByID(gomock.Any(), prID).
+				Return(&models.PullRequest{ID: prID, AuthorID: authorID, Status: domain.PRStatusDraft}, nil),
+			prStorage.EXPECT().MarkPullRequestReady(gomock.Any(), prID).
+				Return(&models.PullRequest{ID: prID, AuthorID: authorID, Status: domain.PRStatusOpen, ReadyAt: &readyAt}, nil),
+			prStorage.EXPECT().GetReviewersFromPR(gomock.Any(), prID).Return([]models.User{}, nil),
+		)
+
+		teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), authorID).
+			Return(&models.Team{ID: teamID, TeamSettings: models.TeamSettings{MinReviewers: 1, MaxReviewers: 2}}, nil)
+
+		userStorage.EXPECT().GetActiveUsersByTeam(gomock.Any(), teamID).
+			Return([]models.User{{ID: authorID, StatusActivity: true}, {ID: "u2", StatusActivity: true}}, nil)
+
+		prStorage.EXPECT().CreatePRReviewerInstance(gomock.Any(), prID, domain.UserID("u2"), nil).Return(nil)
+		statsCache.EXPECT().IncrementAssignCountByUserID(gomock.Any(), domain.UserID("u2")).Return(nil)
+
+		prStorage.EXPECT().GetPullRequestByID(gomock.Any(), prID).
+			Return(&models.PullRequest{ID: prID, AuthorID: authorID, Status: domain.PRStatusOpen, ReadyAt: &readyAt}, nil)
+		prStorage.EXPECT().GetParticipantsByPRIDs(gomock.Any(), []domain.PRID{prID}).
+			Return(
+				map[domain.UserID]models.User{authorID: {ID: authorID}},
+				map[domain.PRID][]models.User{prID: {{ID: "u2", StatusActivity: true}}},
+				nil,
+			)
+
+		pr, err := uc.ReadyPR(context.Background(), prID)
+
+		So(err, ShouldBeNil)
+		So(pr.Status, ShouldEqual, domain.PRStatusOpen)
+		So(pr.ReadyAt, ShouldNotBeNil)
+		So(pr.Reviewers, ShouldHaveLength, 1)
+		So(pr.Reviewers[0].ID, ShouldEqual, domain.UserID("u2"))
+	})
+}
+
+func TestReadyPR_AlreadyOpen(t *testing.T) {
+	Convey("ReadyPR: OPEN PR is returned as is", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, mock.NewMockUserStorage(ctrl), cachemock.NewMockStatsCache(ctrl),
+			mock.NewMockTeamStorage(ctrl), mocktx, mockLog)
+
+		prID := domain.PRID("p1")
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		prStorage.EXPECT().GetPullRequestByID(gomock.Any(), prID).
+			Return(&models.PullRequest{ID: prID, AuthorID: "u1", Status: domain.PRStatusOpen}, nil)
+		prStorage.EXPECT().GetParticipantsByPRIDs(gomock.Any(), []domain.PRID{prID}).
+			Return(map[domain.UserID]models.User{}, map[domain.PRID][]models.User{}, nil)
+
+		pr, err := uc.ReadyPR(context.Background(), prID)
+
+		So(err, ShouldBeNil)
+		So(pr.Status, ShouldEqual, domain.PRStatusOpen)
+	})
+}
+
+func TestReadyPR_NotDraft(t *testing.T) {
+	Convey("ReadyPR: MERGED PR can't be marked ready", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, mock.NewMockUserStorage(ctrl), cachemock.NewMockStatsCache(ctrl),
+			mock.NewMockTeamStorage(ctrl), mocktx, mockLog)
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		prStorage.EXPECT().GetPullRequestByID(gomock.Any(), domain.PRID("p1")).
+			Return(&models.PullRequest{ID: "p1", Status: domain.PRStatusMerged}, nil)
+
+		_, err := uc.ReadyPR(context.Background(), "p1")
+
+		So(err, ShouldEqual, errs.ErrInvalidPullRequestTransition)
+	})
+}
+
+func TestReadyPR_ConcurrentChange(t *testing.T) {
+	Convey("ReadyPR: draft left DRAFT between read and update", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, mock.NewMockUserStorage(ctrl), cachemock.NewMockStatsCache(ctrl),
+			mock.NewMockTeamStorage(ctrl), mocktx, mockLog)
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		prStorage.EXPECT().GetPullRequestByID(gomock.Any(), domain.PRID("p1")).
+			Return(&models.PullRequest{ID: "p1", Status: domain.PRStatusDraft}, nil)
+		prStorage.EXPECT().MarkPullRequestReady(gomock.Any(), domain.PRID("p1")).Return(nil, repoerrors.ErrNotFound)
+
+		_, err := uc.ReadyPR(context.Background(), "p1")
+
+		So(err, ShouldEqual, errs.ErrInvalidPullRequestTransition)
+	})
+}
+
+func TestMergePR_Draft(t *testing.T) {
+	Convey("MergePR: draft can't be merged", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, mock.NewMockUserStorage(ctrl), cachemock.NewMockStatsCache(ctrl),
+			mock.NewMockTeamStorage(ctrl), mocktx, mockLog)
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		prStorage.EXPECT().GetPullRequestByID(gomock.Any(), domain.PRID("p1")).
+			Return(&models.PullRequest{ID: "p1", Status: domain.PRStatusDraft}, nil)
+
+		_, err := uc.MergePR(context.Background(), "p1", nil)
+
+		So(err, ShouldEqual, errs.ErrInvalidPullRequestTransition)
+	})
+}
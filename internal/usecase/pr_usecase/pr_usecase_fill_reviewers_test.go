@@ -0,0 +1,233 @@
+package pr_usecase
+
+import (
+	"app/internal/domain"
+	cachemock "app/internal/repository/cache/mock"
+	"app/internal/repository/models"
+	mock "app/internal/repository/storage/mock"
+	mocklog "app/pkg/logger/mock"
+	txmock "app/pkg/txmanager/mock"
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFillReviewers_TopsUpFlaggedPRs(t *testing.T) {
+	Convey("FillReviewers: flagged OPEN PR gets reviewers up to max", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		statsCache := cachemock.NewMockStatsCache(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, userStorage, statsCache, teamStorage, mocktx, mockLog)
+
+		authorID := domain.UserID("u1")
+		teamID := domain.TeamID(5)
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			}).Times(2)
+
+		prStorage.EXPECT().GetAllOpenPullRequests(gomock.Any()).
+			Return([]models.PullRequest{
+				{ID: "p1", AuthorID: authorID, Status: domain.PRStatusOpen, NeedMoreReviewers: true},
+				{ID: "p2", AuthorID: authorID, Status: domain.PRStatusOpen},
+			}, nil)
+
+		teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), authorID).
+			Return(&models.Team{ID: teamID, TeamSettings: models.TeamSettings{MinReviewers: 2, MaxReviewers: 2}}, nil)
+
+		prStorage.EXPECT().GetReviewersFromPR(gomock.Any(), domain.PRID("p1")).
+			Return([]models.User{{ID: "u2", StatusActivity: true}}, nil)
+
+		userStorage.EXPECT().GetActiveUsersByTeam(gomock.Any(), teamID).
+			Return([]models.User{
+				{ID: authorID, StatusActivity: true},
+				{ID: "u2", StatusActivity: true},
+				{ID: "u3", StatusActivity: true},
+			}, nil)
+
+		prStorage.EXPECT().CreatePRReviewerInstance(gomock.Any(), domain.PRID("p1"), domain.UserID("u3"), nil).Return(nil)
+		statsCache.EXPECT().IncrementAssignCountByUserID(gomock.Any(), domain.UserID("u3")).Return(nil)
+		prStorage.EXPECT().UpdateNeedMoreReviewers(gomock.Any(), domain.PRID("p1"), false).Return(nil)
+
+		assigned, err := uc.FillReviewers(context.Background())
+
+		So(err, ShouldBeNil)
+		So(assigned, ShouldEqual, 1)
+	})
+}
+
+func TestFillReviewers_CommitFailed(t *testing.T) {
+	Convey("FillReviewers: reviewers of a rolled back transaction are not counted", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		statsCache := cachemock.NewMockStatsCache(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, userStorage, statsCache, teamStorage, mocktx, mockLog)
+
+		authorID := domain.UserID("u1")
+		teamID := domain.TeamID(5)
+
+		gomock.InOrder(
+			mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+					return fn(ctx)
+				}),
+			// Замыкание отрабатывает, но коммит падает.
+			mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+					if err := fn(ctx); err != nil {
+						return err
+					}
+					return errors.New("commit failed")
+				}),
+		)
+
+		prStorage.EXPECT().GetAllOpenPullRequests(gomock.Any()).
+			Return([]models.PullRequest{
+				{ID: "p1", AuthorID: authorID, Status: domain.PRStatusOpen, NeedMoreReviewers: true},
+			}, nil)
+
+		teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), authorID).
+			Return(&models.Team{ID: teamID, TeamSettings: models.TeamSettings{MinReviewers: 2, MaxReviewers: 2}}, nil)
+
+		prStorage.EXPECT().GetReviewersFromPR(gomock.Any(), domain.PRID("p1")).
+			Return([]models.User{{ID: "u2", StatusActivity: true}}, nil)
+
+		userStorage.EXPECT().GetActiveUsersByTeam(gomock.Any(), teamID).
+			Return([]models.User{
+				{ID: authorID, StatusActivity: true},
+				{ID: "u2", StatusActivity: true},
+				{ID: "u3", StatusActivity: true},
+			}, nil)
+
+		prStorage.EXPECT().CreatePRReviewerInstance(gomock.Any(), domain.PRID("p1"), domain.UserID("u3"), nil).Return(nil)
+		statsCache.EXPECT().IncrementAssignCountByUserID(gomock.Any(), domain.UserID("u3")).Return(nil)
+		prStorage.EXPECT().UpdateNeedMoreReviewers(gomock.Any(), domain.PRID("p1"), false).Return(nil)
+
+		assigned, err := uc.FillReviewers(context.Background())
+
+		So(err, ShouldBeNil)
+		So(assigned, ShouldEqual, 0)
+	})
+}
+
+func TestFillReviewers_StillShort(t *testing.T) {
+	Convey("FillReviewers: flag stays when team has no free reviewers", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		statsCache := cachemock.NewMockStatsCache(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, userStorage, statsCache, teamStorage, mocktx, mockLog)
+
+		authorID := domain.UserID("u1")
+		teamID := domain.TeamID(5)
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			}).Times(2)
+
+		prStorage.EXPECT().GetAllOpenPullRequests(gomock.Any()).
+			Return([]models.PullRequest{
+				{ID: "p1", AuthorID: authorID, Status: domain.PRStatusOpen, NeedMoreReviewers: true},
+			}, nil)
+
+		teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), authorID).
+			Return(&models.Team{ID: teamID, TeamSettings: models.TeamSettings{MinReviewers: 2, MaxReviewers: 2}}, nil)
+
+		prStorage.EXPECT().GetReviewersFromPR(gomock.Any(), domain.PRID("p1")).
+			Return([]models.User{{ID: "u2", StatusActivity: true}}, nil)
+
+		userStorage.EXPECT().GetActiveUsersByTeam(gomock.Any(), teamID).
+			Return([]models.User{
+				{ID: authorID, StatusActivity: true},
+				{ID: "u2", StatusActivity: true},
+			}, nil)
+
+		teamStorage.EXPECT().GetFallbackTeams(gomock.Any(), teamID).Return(nil, nil)
+
+		assigned, err := uc.FillReviewers(context.Background())
+
+		So(err, ShouldBeNil)
+		So(assigned, ShouldEqual, 0)
+	})
+}
+
+func TestRefreshNeedMoreReviewers_InactiveReviewer(t *testing.T) {
+	Convey("RefreshNeedMoreReviewers: inactive reviewer no longer counts", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		statsCache := cachemock.NewMockStatsCache(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, userStorage, statsCache, teamStorage, mocktx, mockLog)
+
+		reviewerID := domain.UserID("u2")
+		authorID := domain.UserID("u1")
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		prStorage.EXPECT().GetPullRequestsByReviewerID(gomock.Any(), reviewerID, openPullRequestsFilter()).
+			Return([]models.PullRequest{
+				{ID: "p1", AuthorID: authorID, Status: domain.PRStatusOpen},
+			}, nil)
+
+		teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), authorID).
+			Return(&models.Team{ID: 5, TeamSettings: models.TeamSettings{MinReviewers: 2, MaxReviewers: 2}}, nil)
+
+		prStorage.EXPECT().GetReviewersFromPR(gomock.Any(), domain.PRID("p1")).
+			Return([]models.User{
+				{ID: reviewerID, StatusActivity: false},
+				{ID: "u3", StatusActivity: true},
+			}, nil)
+
+		prStorage.EXPECT().UpdateNeedMoreReviewers(gomock.Any(), domain.PRID("p1"), true).Return(nil)
+
+		err := uc.RefreshNeedMoreReviewers(context.Background(), reviewerID)
+
+		So(err, ShouldBeNil)
+	})
+}
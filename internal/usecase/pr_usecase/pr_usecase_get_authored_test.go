@@ -0,0 +1,83 @@
+package pr_usecase
+
+import (
+	"app/internal/domain"
+	cachemock "app/internal/repository/cache/mock"
+	"app/internal/repository/models"
+	mock "app/internal/repository/storage/mock"
+	"app/internal/usecase/errs"
+	mocklog "app/pkg/logger/mock"
+	txmock "app/pkg/txmanager/mock"
+	"context"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetPRByAuthorID_Success(t *testing.T) {
+	Convey("GetPRByAuthorID: authored PRs are paged like reviewed ones", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, mock.NewMockUserStorage(ctrl), cachemock.NewMockStatsCache(ctrl),
+			mock.NewMockTeamStorage(ctrl), mocktx, mockLog)
+
+		authorID := domain.UserID("u1")
+		status := domain.PRStatusOpen
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		prStorage.EXPECT().GetPullRequestsByAuthorID(gomock.Any(), authorID,
+			domain.PullRequestFilter{Status: &status, SortOrder: domain.SortOrderDesc, Limit: 2}).
+			Return([]models.PullRequest{
+				{ID: "p2", AuthorID: authorID, Status: domain.PRStatusOpen},
+				{ID: "p1", AuthorID: authorID, Status: domain.PRStatusOpen},
+			}, nil)
+
+		prStorage.EXPECT().GetParticipantsByPRIDs(gomock.Any(), []domain.PRID{"p2"}).
+			Return(
+				map[domain.UserID]models.User{authorID: {ID: authorID, Name: "Alice", StatusActivity: true}},
+				map[domain.PRID][]models.User{"p2": {{ID: "u2", StatusActivity: true}}},
+				nil,
+			)
+
+		page, err := uc.GetPRByAuthorID(context.Background(), authorID, domain.PullRequestFilter{Status: &status, Limit: 1})
+
+		So(err, ShouldBeNil)
+		So(page.PullRequests, ShouldHaveLength, 1)
+		So(page.PullRequests[0].Author.Name, ShouldEqual, "Alice")
+		So(page.PullRequests[0].Reviewers, ShouldHaveLength, 1)
+		So(page.NextCursor, ShouldNotBeNil)
+		So(page.NextCursor.ID, ShouldEqual, domain.PRID("p2"))
+	})
+}
+
+func TestGetPRByAuthorID_InvalidInput(t *testing.T) {
+	Convey("GetPRByAuthorID: empty author and bad filter are rejected", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		uc := NewPRUseCase(mock.NewMockPRStorage(ctrl), mock.NewMockUserStorage(ctrl), cachemock.NewMockStatsCache(ctrl),
+			mock.NewMockTeamStorage(ctrl), txmock.NewMockTxManager(ctrl), mockLog)
+
+		_, err := uc.GetPRByAuthorID(context.Background(), "", domain.PullRequestFilter{})
+		So(err, ShouldEqual, errs.ErrInvalidUserID)
+
+		_, err = uc.GetPRByAuthorID(context.Background(), "u1", domain.PullRequestFilter{Limit: -5})
+		So(err, ShouldEqual, errs.ErrInvalidPullRequestFilter)
+	})
+}
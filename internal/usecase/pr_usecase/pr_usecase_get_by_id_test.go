@@ -0,0 +1,116 @@
+package pr_usecase
+
+import (
+	"app/internal/domain"
+	cachemock "app/internal/repository/cache/mock"
+	repoerrors "app/internal/repository/errs"
+	"app/internal/repository/models"
+	mock "app/internal/repository/storage/mock"
+	"app/internal/usecase/errs"
+	mocklog "app/pkg/logger/mock"
+	txmock "app/pkg/txmanager/mock"
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetPRByID_Success(t *testing.T) {
+	Convey("GetPRByID: author and reviewers with assigned_at and fallback origin are returned", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, mock.NewMockUserStorage(ctrl), cachemock.NewMockStatsCache(ctrl),
+			mock.NewMockTeamStorage(ctrl), mocktx, mockLog)
+
+		prID := domain.PRID("p1")
+		createdAt := time.Date(2025, 10, 24, 12, 0, 0, 0, time.UTC)
+		assignedAt := createdAt.Add(time.Minute)
+		fallbackID := domain.TeamID(7)
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		prStorage.EXPECT().GetPullRequestByID(gomock.Any(), prID).
+			Return(&models.PullRequest{ID: prID, Name: "first", AuthorID: "u1", Status: domain.PRStatusOpen,
+				NeedMoreReviewers: true, CreatedAt: createdAt}, nil)
+
+		prStorage.EXPECT().GetParticipantsByPRIDs(gomock.Any(), []domain.PRID{prID}).
+			Return(
+				map[domain.UserID]models.User{"u1": {ID: "u1", Name: "Alice", StatusActivity: true}},
+				map[domain.PRID][]models.User{prID: {
+					{ID: "u2", Name: "Bob", StatusActivity: true, AssignedAt: &assignedAt},
+					{ID: "u3", Name: "Carol", StatusActivity: true, AssignedAt: &assignedAt, SourceTeamID: &fallbackID, SourceTeamName: "pool"},
+				}},
+				nil,
+			)
+
+		pr, err := uc.GetPRByID(context.Background(), prID)
+
+		So(err, ShouldBeNil)
+		So(pr.Author.Name, ShouldEqual, "Alice")
+		So(pr.Reviewers, ShouldHaveLength, 2)
+		So(pr.ReviewersAssignedAt[domain.UserID("u2")], ShouldEqual, assignedAt)
+		So(pr.FallbackReviewers, ShouldResemble, map[domain.UserID]string{"u3": "pool"})
+		So(pr.NeedMoreReviewers, ShouldBeTrue)
+		So(pr.CreatedAt, ShouldEqual, createdAt)
+		So(pr.MergedAt, ShouldBeNil)
+	})
+}
+
+func TestGetPRByID_NotFound(t *testing.T) {
+	Convey("GetPRByID: missing PR", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, mock.NewMockUserStorage(ctrl), cachemock.NewMockStatsCache(ctrl),
+			mock.NewMockTeamStorage(ctrl), mocktx, mockLog)
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		prStorage.EXPECT().GetPullRequestByID(gomock.Any(), domain.PRID("missing")).
+			Return(nil, repoerrors.ErrNotFound)
+
+		pr, err := uc.GetPRByID(context.Background(), "missing")
+
+		So(pr, ShouldBeNil)
+		So(err, ShouldEqual, errs.ErrPullRequestNotFound)
+	})
+}
+
+func TestGetPRByID_EmptyID(t *testing.T) {
+	Convey("GetPRByID: empty PR ID", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		uc := NewPRUseCase(mock.NewMockPRStorage(ctrl), mock.NewMockUserStorage(ctrl), cachemock.NewMockStatsCache(ctrl),
+			mock.NewMockTeamStorage(ctrl), txmock.NewMockTxManager(ctrl), mockLog)
+
+		_, err := uc.GetPRByID(context.Background(), "")
+
+		So(err, ShouldEqual, errs.ErrInvalidPullRequestID)
+	})
+}
@@ -0,0 +1,208 @@
+package pr_usecase
+
+import (
+	"app/internal/domain"
+	cachemock "app/internal/repository/cache/mock"
+	"app/internal/repository/models"
+	mock "app/internal/repository/storage/mock"
+	"app/internal/usecase/errs"
+	mocklog "app/pkg/logger/mock"
+	txmock "app/pkg/txmanager/mock"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetPRByUserID_Success(t *testing.T) {
+	Convey("GetPRByUserID: authors and reviewers are loaded in one batch", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		statsCache := cachemock.NewMockStatsCache(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, userStorage, statsCache, teamStorage, mocktx, mockLog)
+
+		reviewerID := domain.UserID("u2")
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		prStorage.EXPECT().GetPullRequestsByReviewerID(gomock.Any(), reviewerID,
+			domain.PullRequestFilter{SortOrder: domain.SortOrderDesc, Limit: defaultPageLimit + 1}).
+			Return([]models.PullRequest{
+				{ID: "p1", Name: "first", AuthorID: "u1", Status: domain.PRStatusOpen},
+				{ID: "p2", Name: "second", AuthorID: "u3", Status: domain.PRStatusMerged},
+			}, nil)
+
+		prStorage.EXPECT().GetParticipantsByPRIDs(gomock.Any(), []domain.PRID{"p1", "p2"}).
+			Return(
+				map[domain.UserID]models.User{
+					"u1": {ID: "u1", Name: "Alice", StatusActivity: true},
+					"u3": {ID: "u3", Name: "Carol", StatusActivity: true},
+				},
+				map[domain.PRID][]models.User{
+					"p1": {{ID: reviewerID, StatusActivity: true}},
+					"p2": {{ID: reviewerID, StatusActivity: true}, {ID: "u1", StatusActivity: true}},
+				},
+				nil,
+			)
+
+		page, err := uc.GetPRByUserID(context.Background(), reviewerID, domain.PullRequestFilter{})
+
+		So(err, ShouldBeNil)
+		So(page.NextCursor, ShouldBeNil)
+		prs := page.PullRequests
+		So(prs, ShouldHaveLength, 2)
+		So(prs[0].Author.Name, ShouldEqual, "Alice")
+		So(prs[0].Reviewers, ShouldHaveLength, 1)
+		So(prs[1].Author.ID, ShouldEqual, domain.UserID("u3"))
+		So(prs[1].Status, ShouldEqual, domain.PRStatusMerged)
+		So(prs[1].Reviewers, ShouldHaveLength, 2)
+	})
+}
+
+func TestGetPRByUserID_ParticipantsError(t *testing.T) {
+	Convey("GetPRByUserID: participants query error is returned", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, mock.NewMockUserStorage(ctrl), cachemock.NewMockStatsCache(ctrl),
+			mock.NewMockTeamStorage(ctrl), mocktx, mockLog)
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		prStorage.EXPECT().GetPullRequestsByReviewerID(gomock.Any(), domain.UserID("u2"), gomock.Any()).
+			Return([]models.PullRequest{{ID: "p1", AuthorID: "u1"}}, nil)
+
+		prStorage.EXPECT().GetParticipantsByPRIDs(gomock.Any(), gomock.Any()).
+			Return(nil, nil, errors.New("database error"))
+
+		page, err := uc.GetPRByUserID(context.Background(), "u2", domain.PullRequestFilter{})
+
+		So(page, ShouldBeNil)
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func TestGetPRByUserID_EmptyUserID(t *testing.T) {
+	Convey("GetPRByUserID: empty user ID", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		uc := NewPRUseCase(mock.NewMockPRStorage(ctrl), mock.NewMockUserStorage(ctrl), cachemock.NewMockStatsCache(ctrl),
+			mock.NewMockTeamStorage(ctrl), txmock.NewMockTxManager(ctrl), mockLog)
+
+		_, err := uc.GetPRByUserID(context.Background(), "", domain.PullRequestFilter{})
+
+		So(err, ShouldEqual, errs.ErrInvalidUserID)
+	})
+}
+
+func TestGetPRByUserID_NextCursor(t *testing.T) {
+	Convey("GetPRByUserID: extra row is trimmed and becomes the next cursor", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, mock.NewMockUserStorage(ctrl), cachemock.NewMockStatsCache(ctrl),
+			mock.NewMockTeamStorage(ctrl), mocktx, mockLog)
+
+		now := time.Now()
+		status := domain.PRStatusOpen
+		cursor := &domain.PageCursor{CreatedAt: now, ID: "p0"}
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		prStorage.EXPECT().GetPullRequestsByReviewerID(gomock.Any(), domain.UserID("u2"), domain.PullRequestFilter{
+			Status:    &status,
+			SortOrder: domain.SortOrderAsc,
+			Cursor:    cursor,
+			Limit:     3,
+		}).Return([]models.PullRequest{
+			{ID: "p1", AuthorID: "u1", CreatedAt: now.Add(time.Minute)},
+			{ID: "p2", AuthorID: "u1", CreatedAt: now.Add(2 * time.Minute)},
+			{ID: "p3", AuthorID: "u1", CreatedAt: now.Add(3 * time.Minute)},
+		}, nil)
+
+		prStorage.EXPECT().GetParticipantsByPRIDs(gomock.Any(), []domain.PRID{"p1", "p2"}).
+			Return(map[domain.UserID]models.User{"u1": {ID: "u1"}}, map[domain.PRID][]models.User{}, nil)
+
+		page, err := uc.GetPRByUserID(context.Background(), "u2", domain.PullRequestFilter{
+			Status:    &status,
+			SortOrder: domain.SortOrderAsc,
+			Cursor:    cursor,
+			Limit:     2,
+		})
+
+		So(err, ShouldBeNil)
+		So(page.PullRequests, ShouldHaveLength, 2)
+		So(page.NextCursor, ShouldNotBeNil)
+		So(page.NextCursor.ID, ShouldEqual, domain.PRID("p2"))
+		So(page.NextCursor.CreatedAt, ShouldEqual, now.Add(2*time.Minute))
+	})
+}
+
+func TestGetPRByUserID_InvalidFilter(t *testing.T) {
+	Convey("GetPRByUserID: invalid filters are rejected before hitting storage", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		uc := NewPRUseCase(mock.NewMockPRStorage(ctrl), mock.NewMockUserStorage(ctrl), cachemock.NewMockStatsCache(ctrl),
+			mock.NewMockTeamStorage(ctrl), txmock.NewMockTxManager(ctrl), mockLog)
+
+		now := time.Now()
+		later := now.Add(time.Hour)
+		unknown := domain.PRStatus("UNKNOWN")
+
+		filters := []domain.PullRequestFilter{
+			{Limit: -1},
+			{Limit: maxPageLimit + 1},
+			{SortOrder: "sideways"},
+			{Status: &unknown},
+			{CreatedAfter: &later, CreatedBefore: &now},
+		}
+
+		for _, filter := range filters {
+			_, err := uc.GetPRByUserID(context.Background(), "u2", filter)
+			So(err, ShouldEqual, errs.ErrInvalidPullRequestFilter)
+		}
+	})
+}
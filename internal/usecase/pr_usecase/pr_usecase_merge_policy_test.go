@@ -0,0 +1,186 @@
+package pr_usecase
+
+import (
+	"app/internal/domain"
+	cachemock "app/internal/repository/cache/mock"
+	repoerrors "app/internal/repository/errs"
+	"app/internal/repository/models"
+	mock "app/internal/repository/storage/mock"
+	"app/internal/usecase/errs"
+	mocklog "app/pkg/logger/mock"
+	txmock "app/pkg/txmanager/mock"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func unmetConditions(unmet []domain.UnmetMergeCondition) []domain.MergeCondition {
+	result := make([]domain.MergeCondition, 0, len(unmet))
+	for _, c := range unmet {
+		result = append(result, c.Condition)
+	}
+	return result
+}
+
+func TestEvaluateMergePolicy(t *testing.T) {
+	Convey("evaluateMergePolicy", t, func() {
+		now := time.Now()
+		author := domain.UserID("u1")
+		pr := models.PullRequest{ID: "p1", AuthorID: author, CreatedAt: now.Add(-2 * time.Hour)}
+		reviewedAt := now.Add(-time.Minute)
+
+		Convey("zero policy allows anything", func() {
+			So(evaluateMergePolicy(domain.MergePolicy{}, pr, nil, &author, now), ShouldBeEmpty)
+		})
+
+		Convey("approvals and requested changes", func() {
+			reviewers := []models.User{
+				{ID: "u2", ReviewState: domain.ReviewStateApproved, ReviewedAt: &reviewedAt},
+				{ID: "u3", ReviewState: domain.ReviewStateChangesRequested, ReviewedAt: &reviewedAt},
+			}
+
+			unmet := evaluateMergePolicy(domain.MergePolicy{MinApprovals: 2}, pr, reviewers, nil, now)
+
+			So(unmetConditions(unmet), ShouldResemble, []domain.MergeCondition{
+				domain.MergeConditionMinApprovals, domain.MergeConditionNoChangesRequested,
+			})
+			So(unmet[1].UserIDs, ShouldResemble, []domain.UserID{"u3"})
+		})
+
+		Convey("only active reviewers have to respond", func() {
+			reviewers := []models.User{
+				{ID: "u2", StatusActivity: true, ReviewState: domain.ReviewStateApproved, ReviewedAt: &reviewedAt},
+				{ID: "u3", StatusActivity: true, ReviewState: domain.ReviewStatePending},
+				{ID: "u4", StatusActivity: false, ReviewState: domain.ReviewStatePending},
+			}
+
+			unmet := evaluateMergePolicy(domain.MergePolicy{RequireAllResponded: true}, pr, reviewers, nil, now)
+
+			So(unmetConditions(unmet), ShouldResemble, []domain.MergeCondition{domain.MergeConditionAllReviewersResponded})
+			So(unmet[0].UserIDs, ShouldResemble, []domain.UserID{"u3"})
+		})
+
+		Convey("self-merge", func() {
+			policy := domain.MergePolicy{ForbidSelfMerge: true}
+			other := domain.UserID("u2")
+
+			So(unmetConditions(evaluateMergePolicy(policy, pr, nil, &author, now)), ShouldResemble,
+				[]domain.MergeCondition{domain.MergeConditionNoSelfMerge})
+			So(unmetConditions(evaluateMergePolicy(policy, pr, nil, nil, now)), ShouldResemble,
+				[]domain.MergeCondition{domain.MergeConditionNoSelfMerge})
+			So(evaluateMergePolicy(policy, pr, nil, &other, now), ShouldBeEmpty)
+		})
+
+		Convey("age is counted from leaving draft", func() {
+			policy := domain.MergePolicy{MinAge: time.Hour}
+			So(evaluateMergePolicy(policy, pr, nil, nil, now), ShouldBeEmpty)
+
+			readyAt := now.Add(-10 * time.Minute)
+			pr.ReadyAt = &readyAt
+			So(unmetConditions(evaluateMergePolicy(policy, pr, nil, nil, now)), ShouldResemble,
+				[]domain.MergeCondition{domain.MergeConditionMinAge})
+		})
+	})
+}
+
+func TestMergePR_PolicyViolation(t *testing.T) {
+	Convey("MergePR: team policy rejects merge with every unmet condition", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, userStorage, cachemock.NewMockStatsCache(ctrl), teamStorage, mocktx, mockLog)
+
+		prID := domain.PRID("p1")
+		authorID := domain.UserID("u1")
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		userStorage.EXPECT().GetUserByID(gomock.Any(), authorID).Return(&models.User{ID: authorID}, nil)
+		prStorage.EXPECT().GetPullRequestByID(gomock.Any(), prID).
+			Return(&models.PullRequest{ID: prID, AuthorID: authorID, Status: domain.PRStatusOpen, CreatedAt: time.Now()}, nil)
+		teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), authorID).
+			Return(&models.Team{ID: 1, TeamSettings: models.TeamSettings{MergePolicy: models.MergePolicy{
+				MinApprovals: 1, ForbidSelfMerge: true, MinAgeSeconds: 3600,
+			}}}, nil)
+		prStorage.EXPECT().GetReviewersFromPR(gomock.Any(), prID).
+			Return([]models.User{{ID: "u2", ReviewState: domain.ReviewStatePending}}, nil)
+
+		_, err := uc.MergePR(context.Background(), prID, &authorID)
+
+		So(errors.Is(err, errs.ErrMergePolicyViolation), ShouldBeTrue)
+
+		var policyErr *errs.MergePolicyError
+		So(errors.As(err, &policyErr), ShouldBeTrue)
+		So(unmetConditions(policyErr.Unmet), ShouldResemble, []domain.MergeCondition{
+			domain.MergeConditionMinApprovals, domain.MergeConditionNoSelfMerge, domain.MergeConditionMinAge,
+		})
+	})
+}
+
+func TestMergePR_RequiredApprovalsWithoutTeam(t *testing.T) {
+	Convey("MergePR: service-wide required approvals apply when author has no team", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, mock.NewMockUserStorage(ctrl), cachemock.NewMockStatsCache(ctrl),
+			teamStorage, mocktx, mockLog, WithRequiredApprovals(1))
+
+		prID := domain.PRID("p1")
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			}).AnyTimes()
+
+		prStorage.EXPECT().GetPullRequestByID(gomock.Any(), prID).
+			Return(&models.PullRequest{ID: prID, AuthorID: "u1", Status: domain.PRStatusOpen}, nil).AnyTimes()
+		teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), domain.UserID("u1")).
+			Return(nil, repoerrors.ErrNotFound).AnyTimes()
+
+		Convey("not enough approvals", func() {
+			prStorage.EXPECT().GetReviewersFromPR(gomock.Any(), prID).
+				Return([]models.User{{ID: "u2", ReviewState: domain.ReviewStatePending}}, nil)
+
+			_, err := uc.MergePR(context.Background(), prID, nil)
+
+			So(errors.Is(err, errs.ErrMergePolicyViolation), ShouldBeTrue)
+		})
+
+		Convey("enough approvals", func() {
+			prStorage.EXPECT().GetReviewersFromPR(gomock.Any(), prID).
+				Return([]models.User{{ID: "u2", ReviewState: domain.ReviewStateApproved}}, nil)
+			prStorage.EXPECT().MergePullRequest(gomock.Any(), prID, nil).
+				Return(&models.PullRequest{ID: prID, AuthorID: "u1", Status: domain.PRStatusMerged}, nil)
+			prStorage.EXPECT().GetParticipantsByPRIDs(gomock.Any(), []domain.PRID{prID}).
+				Return(map[domain.UserID]models.User{}, map[domain.PRID][]models.User{}, nil)
+
+			pr, err := uc.MergePR(context.Background(), prID, nil)
+
+			So(err, ShouldBeNil)
+			So(pr.Status, ShouldEqual, domain.PRStatusMerged)
+		})
+	})
+}
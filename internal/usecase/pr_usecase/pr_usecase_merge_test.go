@@ -11,6 +11,7 @@ import (
 	txmock "app/pkg/txmanager/mock"
 	"context"
 	"testing"
+	"time"
 
 	. "github.com/smartystreets/goconvey/convey"
 	"go.uber.org/mock/gomock"
@@ -43,7 +44,7 @@ func TestMergePR_NotFound(t *testing.T) {
 				return fn(ctx)
 			})
 
-		err := uc.MergePR(context.Background(), prID)
+		_, err := uc.MergePR(context.Background(), prID, nil)
 		So(err, ShouldEqual, errs.ErrPullRequestNotFound)
 	})
 }
@@ -67,12 +68,25 @@ func TestMergePR_Success(t *testing.T) {
 
 		prID := domain.PRID("u1")
 
+		mergedBy := domain.UserID("u2")
+		mergedAt := time.Now()
+
+		userStorage.EXPECT().GetUserByID(gomock.Any(), mergedBy).
+			Return(&models.User{ID: mergedBy}, nil)
+
 		prStorage.EXPECT().GetPullRequestByID(gomock.Any(), prID).
-			Return(&models.PullRequest{ID: prID}, nil)
+			Return(&models.PullRequest{ID: prID, AuthorID: "a1", Status: domain.PRStatusOpen}, nil)
+
+		teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), domain.UserID("a1")).
+			Return(&models.Team{ID: 1}, nil)
 
 		prStorage.EXPECT().
-			UpdatePullRequestStatus(gomock.Any(), prID, domain.PRStatusMerged).
-			Return(nil)
+			MergePullRequest(gomock.Any(), prID, &mergedBy).
+			Return(&models.PullRequest{ID: prID, AuthorID: "a1", Status: domain.PRStatusMerged,
+				MergedAt: &mergedAt, MergedBy: &mergedBy}, nil)
+
+		prStorage.EXPECT().GetParticipantsByPRIDs(gomock.Any(), []domain.PRID{prID}).
+			Return(map[domain.UserID]models.User{"a1": {ID: "a1"}}, map[domain.PRID][]models.User{}, nil)
 
 		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
@@ -80,7 +94,118 @@ func TestMergePR_Success(t *testing.T) {
 			})
 
 
-		err := uc.MergePR(context.Background(), prID)
+		pr, err := uc.MergePR(context.Background(), prID, &mergedBy)
 		So(err, ShouldBeNil)
+		So(pr.Status, ShouldEqual, domain.PRStatusMerged)
+		So(*pr.MergedBy, ShouldEqual, mergedBy)
+		So(*pr.MergedAt, ShouldEqual, mergedAt)
+	})
+}
+func TestMergePR_AlreadyMerged(t *testing.T) {
+	Convey("MergePR: repeated merge keeps original merged_at and merged_by", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, mock.NewMockUserStorage(ctrl), cachemock.NewMockStatsCache(ctrl),
+			mock.NewMockTeamStorage(ctrl), mocktx, mockLog)
+
+		prID := domain.PRID("p1")
+		firstMerger := domain.UserID("u1")
+		mergedAt := time.Now().Add(-time.Hour)
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		prStorage.EXPECT().GetPullRequestByID(gomock.Any(), prID).
+			Return(&models.PullRequest{ID: prID, AuthorID: "a1", Status: domain.PRStatusMerged,
+				MergedAt: &mergedAt, MergedBy: &firstMerger}, nil)
+
+		prStorage.EXPECT().GetParticipantsByPRIDs(gomock.Any(), []domain.PRID{prID}).
+			Return(map[domain.UserID]models.User{}, map[domain.PRID][]models.User{}, nil)
+
+		pr, err := uc.MergePR(context.Background(), prID, nil)
+
+		So(err, ShouldBeNil)
+		So(*pr.MergedBy, ShouldEqual, firstMerger)
+		So(*pr.MergedAt, ShouldEqual, mergedAt)
+	})
+}
+
+func TestMergePR_ConcurrentStatusChange(t *testing.T) {
+	Convey("MergePR: PR whose status changed concurrently is merged only if the change was a merge", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, mock.NewMockUserStorage(ctrl), cachemock.NewMockStatsCache(ctrl),
+			teamStorage, mocktx, mockLog)
+
+		prID := domain.PRID("p1")
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		gomock.InOrder(
+			prStorage.EXPECT().GetPullRequestByID(gomock.Any(), prID).
+				Return(&models.PullRequest{ID: prID, AuthorID: "a1", Status: domain.PRStatusOpen}, nil),
+			teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), domain.UserID("a1")).
+				Return(&models.Team{ID: 1}, nil),
+			prStorage.EXPECT().MergePullRequest(gomock.Any(), prID, nil).
+				Return(nil, repoerrors.ErrNotFound),
+			prStorage.EXPECT().GetPullRequestByID(gomock.Any(), prID).
+				Return(&models.PullRequest{ID: prID, AuthorID: "a1", Status: domain.PRStatusClosed}, nil),
+		)
+
+		pr, err := uc.MergePR(context.Background(), prID, nil)
+
+		So(pr, ShouldBeNil)
+		So(err, ShouldEqual, errs.ErrPullRequestClosed)
 	})
-}
\ No newline at end of file
+}
+
+func TestMergePR_UnknownMerger(t *testing.T) {
+	Convey("MergePR: merged_by must be an existing user", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		userStorage := mock.NewMockUserStorage(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(mock.NewMockPRStorage(ctrl), userStorage, cachemock.NewMockStatsCache(ctrl),
+			mock.NewMockTeamStorage(ctrl), mocktx, mockLog)
+
+		mergedBy := domain.UserID("ghost")
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		userStorage.EXPECT().GetUserByID(gomock.Any(), mergedBy).Return(nil, repoerrors.ErrNotFound)
+
+		_, err := uc.MergePR(context.Background(), "p1", &mergedBy)
+
+		So(err, ShouldEqual, errs.ErrUserNotFound)
+	})
+}
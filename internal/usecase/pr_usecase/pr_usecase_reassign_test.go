@@ -81,11 +81,22 @@ func TestReAssignSuccess(t *testing.T) {
 				{ID: "other-reviewer", StatusActivity: true},
 			}, nil)
 
-		prStorage.EXPECT().CreatePRReviewerInstance(gomock.Any(), prID, gomock.Any()).
+		prStorage.EXPECT().CreatePRReviewerInstance(gomock.Any(), prID, gomock.Any(), nil).
 			Return(nil)
 
-		err := uc.ReassignReviewer(context.Background(), prID, reviewerIDToChange)
+		prStorage.EXPECT().GetPullRequestByID(gomock.Any(), prID).
+			Return(&models.PullRequest{
+				ID:       prID,
+				AuthorID: authorID,
+				Status:   domain.PRStatusOpen,
+			}, nil)
+
+		prStorage.EXPECT().GetParticipantsByPRIDs(gomock.Any(), []domain.PRID{prID}).
+			Return(map[domain.UserID]models.User{}, map[domain.PRID][]models.User{}, nil)
+
+		_, replacedBy, err := uc.ReassignReviewer(context.Background(), prID, reviewerIDToChange, nil)
 		So(err, ShouldBeNil)
+		So(replacedBy, ShouldBeIn, []domain.UserID{userID1, userID2, newReviewerID})
 	})
 }
 
@@ -127,7 +138,7 @@ func TestReAssign_UserHasNoTeam(t *testing.T) {
 		teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), reviewerIDToChange).
 			Return(nil, repositoryerrs.ErrNotFound)
 
-		err := uc.ReassignReviewer(context.Background(), prID, reviewerIDToChange)
+		_, _, err := uc.ReassignReviewer(context.Background(), prID, reviewerIDToChange, nil)
 		So(err, ShouldEqual, errs.ErrUserHasNoTeam)
 	})
 }
@@ -188,7 +199,7 @@ func TestReAssign_AllUsersAreReviewers(t *testing.T) {
 				{ID: otherReviewer, StatusActivity: true},
 			}, nil)
 
-		err := uc.ReassignReviewer(context.Background(), prID, reviewerIDToChange)
+		_, _, err := uc.ReassignReviewer(context.Background(), prID, reviewerIDToChange, nil)
 		So(err, ShouldEqual, errs.ErrNoAvailableActiveUserToAssign)
 	})
 }
@@ -247,10 +258,10 @@ func TestReAssign_CreateReviewerFails(t *testing.T) {
 				{ID: reviewerIDToChange, StatusActivity: true},
 			}, nil)
 
-		prStorage.EXPECT().CreatePRReviewerInstance(gomock.Any(), prID, newReviewerID).
+		prStorage.EXPECT().CreatePRReviewerInstance(gomock.Any(), prID, newReviewerID, nil).
 			Return(errors.New("database error"))
 
-		err := uc.ReassignReviewer(context.Background(), prID, reviewerIDToChange)
+		_, _, err := uc.ReassignReviewer(context.Background(), prID, reviewerIDToChange, nil)
 		So(err, ShouldNotBeNil)
 		So(err.Error(), ShouldContainSubstring, "database error")
 	})
@@ -308,7 +319,7 @@ func TestReAssign_OnlyAuthorInTeam(t *testing.T) {
 				{ID: reviewerIDToChange, StatusActivity: true},
 			}, nil)
 
-		err := uc.ReassignReviewer(context.Background(), prID, reviewerIDToChange)
+		_, _, err := uc.ReassignReviewer(context.Background(), prID, reviewerIDToChange, nil)
 		So(err, ShouldEqual, errs.ErrNoAvailableActiveUserToAssign)
 	})
 }
@@ -367,7 +378,7 @@ func TestReAssignNoActiveUsersInTeam(t *testing.T) {
 				{ID: authorID, StatusActivity: true},
 			}, nil)
 
-		err := uc.ReassignReviewer(context.Background(), prID, reviewerIDToChange)
+		_, _, err := uc.ReassignReviewer(context.Background(), prID, reviewerIDToChange, nil)
 		So(err, ShouldEqual, errs.ErrNoAvailableActiveUserToAssign)
 	})
 }
@@ -404,7 +415,311 @@ func TestReAssignAlreadyMerged(t *testing.T) {
 				Status:   domain.PRStatusMerged,
 			}, nil)
 
-		err := uc.ReassignReviewer(context.Background(), prID, reviewerToChangeID)
+		_, _, err := uc.ReassignReviewer(context.Background(), prID, reviewerToChangeID, nil)
 		So(err, ShouldEqual, errs.ErrPRAlreadyMerged)
 	})
 }
+
+func TestReAssign_ClearsNeedMoreReviewers(t *testing.T) {
+	Convey("ReAssign: replacing an inactive reviewer clears need_more_reviewers", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		statsCache := cachemock.NewMockStatsCache(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, userStorage, statsCache, teamStorage, mocktx, mockLog)
+
+		prID := domain.PRID("100")
+		authorID := domain.UserID("u1")
+		teamID := domain.TeamID(1)
+
+		statsCache.EXPECT().IncrementAssignCountByUserID(gomock.Any(), domain.UserID("u4")).Return(nil)
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		prStorage.EXPECT().GetPullRequestByID(gomock.Any(), prID).
+			Return(&models.PullRequest{ID: prID, AuthorID: authorID, Status: domain.PRStatusOpen, NeedMoreReviewers: true}, nil)
+
+		prStorage.EXPECT().DeletePRReviewerInstance(gomock.Any(), prID, domain.UserID("u2")).Return(nil)
+
+		teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), domain.UserID("u2")).
+			Return(&models.Team{ID: teamID, TeamSettings: models.TeamSettings{MinReviewers: 2, MaxReviewers: 2}}, nil)
+
+		userStorage.EXPECT().GetActiveUsersByTeam(gomock.Any(), teamID).
+			Return([]models.User{
+				{ID: authorID, StatusActivity: true},
+				{ID: "u3", StatusActivity: true},
+				{ID: "u4", StatusActivity: true},
+			}, nil)
+
+		prStorage.EXPECT().GetReviewersFromPR(gomock.Any(), prID).
+			Return([]models.User{{ID: "u3", StatusActivity: true}}, nil)
+
+		prStorage.EXPECT().CreatePRReviewerInstance(gomock.Any(), prID, domain.UserID("u4"), nil).Return(nil)
+		prStorage.EXPECT().UpdateNeedMoreReviewers(gomock.Any(), prID, false).Return(nil)
+
+		prStorage.EXPECT().GetPullRequestByID(gomock.Any(), prID).
+			Return(&models.PullRequest{ID: prID, AuthorID: authorID, Status: domain.PRStatusOpen}, nil)
+		prStorage.EXPECT().GetParticipantsByPRIDs(gomock.Any(), []domain.PRID{prID}).
+			Return(map[domain.UserID]models.User{}, map[domain.PRID][]models.User{}, nil)
+
+		_, replacedBy, err := uc.ReassignReviewer(context.Background(), prID, domain.UserID("u2"), nil)
+		So(err, ShouldBeNil)
+		So(replacedBy, ShouldEqual, domain.UserID("u4"))
+	})
+}
+
+func TestReassignOpenReviews_Report(t *testing.T) {
+	Convey("ReassignOpenReviews: replaces reviewer where possible and reports the rest", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		statsCache := cachemock.NewMockStatsCache(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, userStorage, statsCache, teamStorage, mocktx, mockLog)
+
+		reviewerID := domain.UserID("u2")
+		teamID := domain.TeamID(1)
+		team := &models.Team{ID: teamID, TeamSettings: models.TeamSettings{MinReviewers: 1, MaxReviewers: 2}}
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		prStorage.EXPECT().GetPullRequestsByReviewerID(gomock.Any(), reviewerID, openPullRequestsFilter()).
+			Return([]models.PullRequest{
+				{ID: "p1", AuthorID: "u1", Status: domain.PRStatusOpen},
+				{ID: "p2", AuthorID: "u3", Status: domain.PRStatusOpen},
+			}, nil)
+
+		prStorage.EXPECT().DeletePRReviewerInstance(gomock.Any(), domain.PRID("p1"), reviewerID).Return(nil)
+		prStorage.EXPECT().DeletePRReviewerInstance(gomock.Any(), domain.PRID("p2"), reviewerID).Return(nil)
+		teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), reviewerID).Return(team, nil).Times(2)
+		userStorage.EXPECT().GetActiveUsersByTeam(gomock.Any(), teamID).
+			Return([]models.User{
+				{ID: "u1", StatusActivity: true},
+				{ID: "u3", StatusActivity: true},
+			}, nil).Times(2)
+
+		// В p1 свободен u3, в p2 автор u3, а u1 уже ревьювер — замены нет.
+		prStorage.EXPECT().GetReviewersFromPR(gomock.Any(), domain.PRID("p1")).Return(nil, nil)
+		prStorage.EXPECT().GetReviewersFromPR(gomock.Any(), domain.PRID("p2")).
+			Return([]models.User{{ID: "u1", StatusActivity: true}}, nil)
+
+		prStorage.EXPECT().CreatePRReviewerInstance(gomock.Any(), domain.PRID("p1"), domain.UserID("u3"), nil).Return(nil)
+		statsCache.EXPECT().IncrementAssignCountByUserID(gomock.Any(), domain.UserID("u3")).Return(nil)
+
+		report, err := uc.ReassignOpenReviews(context.Background(), reviewerID)
+
+		So(err, ShouldBeNil)
+		So(report.Reassigned, ShouldHaveLength, 1)
+		So(report.Reassigned[0].PullRequestID, ShouldEqual, domain.PRID("p1"))
+		So(*report.Reassigned[0].NewReviewerID, ShouldEqual, domain.UserID("u3"))
+		So(report.Unfilled, ShouldResemble, []domain.ReviewerReassignment{{PullRequestID: "p2", OldReviewerID: reviewerID}})
+	})
+}
+
+func TestReassignTeamReviews_OnlyTeamPullRequests(t *testing.T) {
+	Convey("ReassignTeamReviews: replaces reviewer only in PRs of the given team", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		statsCache := cachemock.NewMockStatsCache(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, userStorage, statsCache, teamStorage, mocktx, mockLog)
+
+		reviewerID := domain.UserID("u2")
+		leftTeamID := domain.TeamID(1)
+		leftTeam := &models.Team{ID: leftTeamID, TeamSettings: models.TeamSettings{MinReviewers: 1, MaxReviewers: 2}}
+		otherTeam := &models.Team{ID: 2, TeamSettings: models.TeamSettings{MinReviewers: 1, MaxReviewers: 2}}
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		prStorage.EXPECT().GetPullRequestsByReviewerID(gomock.Any(), reviewerID, openPullRequestsFilter()).
+			Return([]models.PullRequest{
+				{ID: "p1", AuthorID: "u1", Status: domain.PRStatusOpen, TeamID: &leftTeamID},
+				{ID: "p2", AuthorID: "u5", Status: domain.PRStatusOpen},
+			}, nil)
+
+		teamStorage.EXPECT().GetTeamByID(gomock.Any(), leftTeamID).Return(leftTeam, nil)
+		// p2 без явной команды относится к основной команде автора — она другая, ревью остаётся.
+		teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), domain.UserID("u5")).Return(otherTeam, nil)
+
+		prStorage.EXPECT().DeletePRReviewerInstance(gomock.Any(), domain.PRID("p1"), reviewerID).Return(nil)
+		userStorage.EXPECT().GetActiveUsersByTeam(gomock.Any(), leftTeamID).
+			Return([]models.User{
+				{ID: "u1", StatusActivity: true},
+				{ID: "u3", StatusActivity: true},
+			}, nil)
+		prStorage.EXPECT().GetReviewersFromPR(gomock.Any(), domain.PRID("p1")).Return(nil, nil)
+		prStorage.EXPECT().CreatePRReviewerInstance(gomock.Any(), domain.PRID("p1"), domain.UserID("u3"), nil).Return(nil)
+		statsCache.EXPECT().IncrementAssignCountByUserID(gomock.Any(), domain.UserID("u3")).Return(nil)
+
+		report, err := uc.ReassignTeamReviews(context.Background(), reviewerID, leftTeamID)
+
+		So(err, ShouldBeNil)
+		So(report.Reassigned, ShouldHaveLength, 1)
+		So(report.Reassigned[0].PullRequestID, ShouldEqual, domain.PRID("p1"))
+		So(*report.Reassigned[0].NewReviewerID, ShouldEqual, domain.UserID("u3"))
+		So(report.Unfilled, ShouldBeEmpty)
+	})
+}
+
+func TestReAssign_TargetedReviewer(t *testing.T) {
+	Convey("ReAssign: caller-proposed reviewer replaces the old one", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		statsCache := cachemock.NewMockStatsCache(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, userStorage, statsCache, teamStorage, mocktx, mockLog)
+
+		prID := domain.PRID("100")
+		newReviewerID := domain.UserID("u4")
+		team := &models.Team{ID: 1, TeamSettings: models.TeamSettings{MinReviewers: 2, MaxReviewers: 2}}
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		gomock.InOrder(
+			prStorage.EXPECT().GetPullRequestByID(gomock.Any(), prID).
+				Return(&models.PullRequest{ID: prID, AuthorID: "u1", Status: domain.PRStatusOpen}, nil),
+			prStorage.EXPECT().GetReviewersFromPR(gomock.Any(), prID).
+				Return([]models.User{{ID: "u2", StatusActivity: true}, {ID: "u3", StatusActivity: true}}, nil),
+			userStorage.EXPECT().GetUserByID(gomock.Any(), newReviewerID).
+				Return(&models.User{ID: newReviewerID, StatusActivity: true}, nil),
+			teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), domain.UserID("u1")).Return(team, nil),
+			teamStorage.EXPECT().GetTeamsByUserID(gomock.Any(), newReviewerID).
+				Return([]models.TeamMembership{{TeamID: team.ID, IsPrimary: true}}, nil),
+			prStorage.EXPECT().DeletePRReviewerInstance(gomock.Any(), prID, domain.UserID("u2")).Return(nil),
+			prStorage.EXPECT().CreatePRReviewerInstance(gomock.Any(), prID, newReviewerID, nil).Return(nil),
+			statsCache.EXPECT().IncrementAssignCountByUserID(gomock.Any(), newReviewerID).Return(nil),
+			prStorage.EXPECT().GetPullRequestByID(gomock.Any(), prID).
+				Return(&models.PullRequest{ID: prID, AuthorID: "u1", Status: domain.PRStatusOpen}, nil),
+			prStorage.EXPECT().GetParticipantsByPRIDs(gomock.Any(), []domain.PRID{prID}).
+				Return(map[domain.UserID]models.User{}, map[domain.PRID][]models.User{}, nil),
+		)
+		// Снятый ревьювер остаётся в статистике назначений.
+		statsCache.EXPECT().DecrementAssignCountByUserID(gomock.Any(), gomock.Any()).Times(0)
+
+		// Снимаемый ревьювер ищется без учёта регистра, удаляется сохранённый ID.
+		_, replacedBy, err := uc.ReassignReviewer(context.Background(), prID, "U2", &newReviewerID)
+
+		So(err, ShouldBeNil)
+		So(replacedBy, ShouldEqual, newReviewerID)
+	})
+}
+
+func TestReAssign_TargetedReviewerRejected(t *testing.T) {
+	Convey("ReAssign: caller-proposed reviewer is validated before any change", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, userStorage, cachemock.NewMockStatsCache(ctrl),
+			mock.NewMockTeamStorage(ctrl), mocktx, mockLog)
+
+		prID := domain.PRID("100")
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			}).AnyTimes()
+
+		prStorage.EXPECT().GetPullRequestByID(gomock.Any(), prID).
+			Return(&models.PullRequest{ID: prID, AuthorID: "u1", Status: domain.PRStatusOpen}, nil)
+		prStorage.EXPECT().GetReviewersFromPR(gomock.Any(), prID).
+			Return([]models.User{{ID: "u2", StatusActivity: true}, {ID: "u3", StatusActivity: true}}, nil)
+
+		Convey("old user is not a reviewer", func() {
+			newReviewerID := domain.UserID("u4")
+
+			_, _, err := uc.ReassignReviewer(context.Background(), prID, "u9", &newReviewerID)
+			So(err, ShouldEqual, errs.ErrReviewerNotFoundInPullRequest)
+		})
+
+		Convey("proposed reviewer is the author", func() {
+			newReviewerID := domain.UserID("u1")
+
+			_, _, err := uc.ReassignReviewer(context.Background(), prID, "u2", &newReviewerID)
+			So(err, ShouldEqual, errs.ErrReviewerIsAuthor)
+		})
+
+		Convey("proposed reviewer is already assigned", func() {
+			newReviewerID := domain.UserID("u3")
+
+			_, _, err := uc.ReassignReviewer(context.Background(), prID, "u2", &newReviewerID)
+			So(err, ShouldEqual, errs.ErrReviewerAlreadyAssigned)
+		})
+
+		Convey("proposed reviewer is the author in another case", func() {
+			newReviewerID := domain.UserID("U1")
+
+			_, _, err := uc.ReassignReviewer(context.Background(), prID, "u2", &newReviewerID)
+			So(err, ShouldEqual, errs.ErrReviewerIsAuthor)
+		})
+
+		Convey("proposed reviewer is assigned in another case", func() {
+			newReviewerID := domain.UserID("U3")
+
+			_, _, err := uc.ReassignReviewer(context.Background(), prID, "u2", &newReviewerID)
+			So(err, ShouldEqual, errs.ErrReviewerAlreadyAssigned)
+		})
+
+		Convey("proposed reviewer is inactive", func() {
+			newReviewerID := domain.UserID("u4")
+			userStorage.EXPECT().GetUserByID(gomock.Any(), newReviewerID).
+				Return(&models.User{ID: newReviewerID, StatusActivity: false}, nil)
+
+			_, _, err := uc.ReassignReviewer(context.Background(), prID, "u2", &newReviewerID)
+			So(err, ShouldEqual, errs.ErrReviewerInactive)
+		})
+	})
+}
@@ -0,0 +1,209 @@
+package pr_usecase
+
+import (
+	"app/internal/domain"
+	cachemock "app/internal/repository/cache/mock"
+	"app/internal/repository/models"
+	mock "app/internal/repository/storage/mock"
+	"app/internal/usecase/errs"
+	mocklog "app/pkg/logger/mock"
+	txmock "app/pkg/txmanager/mock"
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReviewPR_Approve(t *testing.T) {
+	Convey("ReviewPR: assigned reviewer approves OPEN PR", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, mock.NewMockUserStorage(ctrl), cachemock.NewMockStatsCache(ctrl),
+			mock.NewMockTeamStorage(ctrl), mocktx, mockLog)
+
+		prID := domain.PRID("p1")
+		reviewerID := domain.UserID("u2")
+		reviewedAt := time.Now()
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		prStorage.EXPECT().GetPullRequestByID(gomock.Any(), prID).
+			Return(&models.PullRequest{ID: prID, AuthorID: "u1", Status: domain.PRStatusOpen}, nil)
+		prStorage.EXPECT().GetReviewersFromPR(gomock.Any(), prID).
+			Return([]models.User{{ID: reviewerID, ReviewState: domain.ReviewStatePending}}, nil)
+		prStorage.EXPECT().UpdateReviewState(gomock.Any(), prID, reviewerID, domain.ReviewStateApproved).Return(nil)
+		prStorage.EXPECT().GetParticipantsByPRIDs(gomock.Any(), []domain.PRID{prID}).
+			Return(
+				map[domain.UserID]models.User{"u1": {ID: "u1"}},
+				map[domain.PRID][]models.User{prID: {{ID: reviewerID, ReviewState: domain.ReviewStateApproved, ReviewedAt: &reviewedAt}}},
+				nil,
+			)
+
+		pr, err := uc.ReviewPR(context.Background(), prID, reviewerID, domain.ReviewVerdictApprove)
+
+		So(err, ShouldBeNil)
+		So(pr.Reviews[reviewerID].State, ShouldEqual, domain.ReviewStateApproved)
+		So(pr.Reviews[reviewerID].ReviewedAt, ShouldNotBeNil)
+	})
+}
+
+func TestReviewPR_CommentKeepsState(t *testing.T) {
+	Convey("ReviewPR: comment does not dismiss requested changes", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, mock.NewMockUserStorage(ctrl), cachemock.NewMockStatsCache(ctrl),
+			mock.NewMockTeamStorage(ctrl), mocktx, mockLog)
+
+		prID := domain.PRID("p1")
+		reviewerID := domain.UserID("u2")
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		prStorage.EXPECT().GetPullRequestByID(gomock.Any(), prID).
+			Return(&models.PullRequest{ID: prID, AuthorID: "u1", Status: domain.PRStatusOpen}, nil)
+		prStorage.EXPECT().GetReviewersFromPR(gomock.Any(), prID).
+			Return([]models.User{{ID: reviewerID, ReviewState: domain.ReviewStateChangesRequested}}, nil)
+		prStorage.EXPECT().UpdateReviewState(gomock.Any(), prID, reviewerID, domain.ReviewStateChangesRequested).Return(nil)
+		prStorage.EXPECT().GetParticipantsByPRIDs(gomock.Any(), []domain.PRID{prID}).
+			Return(map[domain.UserID]models.User{}, map[domain.PRID][]models.User{}, nil)
+
+		_, err := uc.ReviewPR(context.Background(), prID, reviewerID, domain.ReviewVerdictComment)
+
+		So(err, ShouldBeNil)
+	})
+}
+
+func TestReviewPR_ReviewerIDCase(t *testing.T) {
+	Convey("ReviewPR: reviewer is matched case-insensitively", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, mock.NewMockUserStorage(ctrl), cachemock.NewMockStatsCache(ctrl),
+			mock.NewMockTeamStorage(ctrl), mocktx, mockLog)
+
+		prID := domain.PRID("p1")
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		prStorage.EXPECT().GetPullRequestByID(gomock.Any(), prID).
+			Return(&models.PullRequest{ID: prID, AuthorID: "u1", Status: domain.PRStatusOpen}, nil)
+		prStorage.EXPECT().GetReviewersFromPR(gomock.Any(), prID).
+			Return([]models.User{{ID: "u2", ReviewState: domain.ReviewStatePending}}, nil)
+		prStorage.EXPECT().UpdateReviewState(gomock.Any(), prID, domain.UserID("u2"), domain.ReviewStateApproved).Return(nil)
+		prStorage.EXPECT().GetParticipantsByPRIDs(gomock.Any(), []domain.PRID{prID}).
+			Return(map[domain.UserID]models.User{}, map[domain.PRID][]models.User{}, nil)
+
+		_, err := uc.ReviewPR(context.Background(), prID, "U2", domain.ReviewVerdictApprove)
+
+		So(err, ShouldBeNil)
+	})
+}
+
+func TestReviewPR_InvalidVerdict(t *testing.T) {
+	Convey("ReviewPR: unknown verdict is rejected before touching storage", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		uc := NewPRUseCase(mock.NewMockPRStorage(ctrl), mock.NewMockUserStorage(ctrl), cachemock.NewMockStatsCache(ctrl),
+			mock.NewMockTeamStorage(ctrl), txmock.NewMockTxManager(ctrl), mockLog)
+
+		_, err := uc.ReviewPR(context.Background(), "p1", "u2", "LGTM")
+
+		So(err, ShouldEqual, errs.ErrInvalidReviewVerdict)
+	})
+}
+
+func TestReviewPR_NotAssigned(t *testing.T) {
+	Convey("ReviewPR: user is not a reviewer of the PR", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, mock.NewMockUserStorage(ctrl), cachemock.NewMockStatsCache(ctrl),
+			mock.NewMockTeamStorage(ctrl), mocktx, mockLog)
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		prStorage.EXPECT().GetPullRequestByID(gomock.Any(), domain.PRID("p1")).
+			Return(&models.PullRequest{ID: "p1", Status: domain.PRStatusOpen}, nil)
+		prStorage.EXPECT().GetReviewersFromPR(gomock.Any(), domain.PRID("p1")).
+			Return([]models.User{{ID: "u3"}}, nil)
+
+		_, err := uc.ReviewPR(context.Background(), "p1", "u2", domain.ReviewVerdictApprove)
+
+		So(err, ShouldEqual, errs.ErrReviewerNotFoundInPullRequest)
+	})
+}
+
+func TestReviewPR_NotOpen(t *testing.T) {
+	Convey("ReviewPR: MERGED PR can't be reviewed", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, mock.NewMockUserStorage(ctrl), cachemock.NewMockStatsCache(ctrl),
+			mock.NewMockTeamStorage(ctrl), mocktx, mockLog)
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		prStorage.EXPECT().GetPullRequestByID(gomock.Any(), domain.PRID("p1")).
+			Return(&models.PullRequest{ID: "p1", Status: domain.PRStatusMerged}, nil)
+
+		_, err := uc.ReviewPR(context.Background(), "p1", "u2", domain.ReviewVerdictApprove)
+
+		So(err, ShouldEqual, errs.ErrPullRequestNotOpen)
+	})
+}
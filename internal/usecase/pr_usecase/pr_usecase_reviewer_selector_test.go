@@ -0,0 +1,144 @@
+package pr_usecase
+
+import (
+	"app/internal/domain"
+	"app/internal/repository/models"
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func selectedIDs(selection Selection) []domain.UserID {
+	ids := make([]domain.UserID, 0, len(selection.Reviewers))
+	for _, u := range selection.Reviewers {
+		ids = append(ids, u.ID)
+	}
+	return ids
+}
+
+func TestRandomSelector_SeededIsDeterministic(t *testing.T) {
+	Convey("RandomSelector: same seed gives same reviewers", t, func() {
+		candidates := []models.User{{ID: "u1"}, {ID: "u2"}, {ID: "u3"}, {ID: "u4"}}
+
+		first, err := NewRandomSelector(rand.New(rand.NewSource(42))).Select(context.Background(), candidates, 2)
+		So(err, ShouldBeNil)
+
+		second, err := NewRandomSelector(rand.New(rand.NewSource(42))).Select(context.Background(), candidates, 2)
+		So(err, ShouldBeNil)
+
+		So(first.Reviewers, ShouldHaveLength, 2)
+		So(selectedIDs(first), ShouldResemble, selectedIDs(second))
+	})
+}
+
+func TestRandomSelector_CountGreaterThanCandidates(t *testing.T) {
+	Convey("RandomSelector: count is capped by candidates", t, func() {
+		candidates := []models.User{{ID: "u1"}}
+
+		selected, err := NewRandomSelector(rand.New(rand.NewSource(1))).Select(context.Background(), candidates, 2)
+		So(err, ShouldBeNil)
+		So(selectedIDs(selected), ShouldResemble, []domain.UserID{"u1"})
+	})
+}
+
+func TestRoundRobinSelector_Rotates(t *testing.T) {
+	Convey("RoundRobinSelector: continues after last assigned reviewer", t, func() {
+		candidates := []models.User{{ID: "u3"}, {ID: "u1"}, {ID: "u2"}}
+		selector := NewRoundRobinSelector()
+
+		first, err := selector.Select(context.Background(), candidates, 1)
+		So(err, ShouldBeNil)
+		So(selectedIDs(first), ShouldResemble, []domain.UserID{"u1"})
+
+		second, err := selector.Select(context.Background(), candidates, 2)
+		So(err, ShouldBeNil)
+		So(selectedIDs(second), ShouldResemble, []domain.UserID{"u2", "u3"})
+
+		third, err := selector.Select(context.Background(), candidates[1:], 1)
+		So(err, ShouldBeNil)
+		So(selectedIDs(third), ShouldResemble, []domain.UserID{"u1"})
+	})
+}
+
+func TestLeastLoadedSelector_PicksLeastLoaded(t *testing.T) {
+	Convey("LeastLoadedSelector: picks reviewers with fewest open reviews", t, func() {
+		candidates := []models.User{{ID: "u1"}, {ID: "u2"}, {ID: "u3"}}
+		load := func(_ context.Context, _ []domain.UserID) (map[domain.UserID]int, error) {
+			return map[domain.UserID]int{"u1": 5, "u2": 0, "u3": 2}, nil
+		}
+
+		selected, err := NewLeastLoadedSelector(rand.New(rand.NewSource(7)), load).Select(context.Background(), candidates, 2)
+		So(err, ShouldBeNil)
+		So(selectedIDs(selected), ShouldResemble, []domain.UserID{"u2", "u3"})
+		So(selected.Loads, ShouldResemble, map[domain.UserID]int{"u1": 5, "u2": 0, "u3": 2})
+	})
+}
+
+func TestLeastLoadedSelector_TiesAreRandom(t *testing.T) {
+	Convey("LeastLoadedSelector: equal load is broken randomly", t, func() {
+		candidates := []models.User{{ID: "u1"}, {ID: "u2"}, {ID: "u3"}}
+		load := func(_ context.Context, _ []domain.UserID) (map[domain.UserID]int, error) {
+			return map[domain.UserID]int{"u1": 1, "u2": 1, "u3": 4}, nil
+		}
+		selector := NewLeastLoadedSelector(rand.New(rand.NewSource(11)), load)
+
+		picked := make(map[domain.UserID]int)
+		for i := 0; i < 200; i++ {
+			selected, err := selector.Select(context.Background(), candidates, 1)
+			So(err, ShouldBeNil)
+			picked[selected.Reviewers[0].ID]++
+		}
+
+		So(picked["u1"], ShouldBeGreaterThan, 0)
+		So(picked["u2"], ShouldBeGreaterThan, 0)
+		So(picked["u3"], ShouldEqual, 0)
+	})
+}
+
+func TestLeastLoadedSelector_LoadError(t *testing.T) {
+	Convey("LeastLoadedSelector: load error is returned", t, func() {
+		candidates := []models.User{{ID: "u1"}}
+		load := func(_ context.Context, _ []domain.UserID) (map[domain.UserID]int, error) {
+			return nil, errors.New("database error")
+		}
+
+		_, err := NewLeastLoadedSelector(rand.New(rand.NewSource(7)), load).Select(context.Background(), candidates, 1)
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func TestWeightedSelector_PrefersHeavyWeight(t *testing.T) {
+	Convey("WeightedSelector: heavier reviewers are picked more often", t, func() {
+		candidates := []models.User{{ID: "u1"}, {ID: "u2"}}
+		selector := NewWeightedSelector(rand.New(rand.NewSource(3)), map[domain.UserID]int{"u1": 99})
+
+		hits := 0
+		for i := 0; i < 1000; i++ {
+			selected, err := selector.Select(context.Background(), candidates, 1)
+			So(err, ShouldBeNil)
+			if selected.Reviewers[0].ID == "u1" {
+				hits++
+			}
+		}
+
+		So(hits, ShouldBeGreaterThan, 900)
+	})
+}
+
+func TestReviewerSelectors_TeamStrategy(t *testing.T) {
+	Convey("reviewerSelectors: team strategy overrides default", t, func() {
+		selectors := newReviewerSelectors(SelectionConfig{
+			Strategy:       domain.ReviewerStrategyRandom,
+			TeamStrategies: map[string]domain.ReviewerStrategy{"backend": domain.ReviewerStrategyRoundRobin},
+			Seed:           1,
+		}, nil)
+
+		backend := models.Team{ID: 1, TeamName: "Backend"}
+		So(selectors.forTeam(backend), ShouldHaveSameTypeAs, &roundRobinSelector{})
+		So(selectors.forTeam(backend), ShouldEqual, selectors.forTeam(backend))
+		So(selectors.forTeam(models.Team{ID: 2, TeamName: "frontend"}), ShouldHaveSameTypeAs, &randomSelector{})
+	})
+}
@@ -0,0 +1,254 @@
+package pr_usecase
+
+import (
+	"app/internal/domain"
+	cachemock "app/internal/repository/cache/mock"
+	repositoryerrs "app/internal/repository/errs"
+	"app/internal/repository/models"
+	mock "app/internal/repository/storage/mock"
+	"app/internal/usecase/errs"
+	mocklog "app/pkg/logger/mock"
+	txmock "app/pkg/txmanager/mock"
+	"context"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAddReviewer_Success(t *testing.T) {
+	Convey("AddReviewer: active teammate is assigned and counted in stats", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		statsCache := cachemock.NewMockStatsCache(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, userStorage, statsCache, teamStorage, mocktx, mockLog)
+
+		prID := domain.PRID("p1")
+		team := &models.Team{ID: 1, TeamSettings: models.TeamSettings{MinReviewers: 2, MaxReviewers: 2}}
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		gomock.InOrder(
+			prStorage.EXPECT().GetPullRequestByID(gomock.Any(), prID).
+				Return(&models.PullRequest{ID: prID, AuthorID: "u1", Status: domain.PRStatusOpen, NeedMoreReviewers: true}, nil),
+			prStorage.EXPECT().GetReviewersFromPR(gomock.Any(), prID).
+				Return([]models.User{{ID: "u2", StatusActivity: true}}, nil),
+			userStorage.EXPECT().GetUserByID(gomock.Any(), domain.UserID("u3")).
+				Return(&models.User{ID: "u3", StatusActivity: true}, nil),
+			teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), domain.UserID("u1")).Return(team, nil),
+			teamStorage.EXPECT().GetTeamsByUserID(gomock.Any(), domain.UserID("u3")).
+				Return([]models.TeamMembership{{TeamID: team.ID, IsPrimary: true}}, nil),
+			prStorage.EXPECT().CreatePRReviewerInstance(gomock.Any(), prID, domain.UserID("u3"), nil).Return(nil),
+			statsCache.EXPECT().IncrementAssignCountByUserID(gomock.Any(), domain.UserID("u3")).Return(nil),
+			prStorage.EXPECT().UpdateNeedMoreReviewers(gomock.Any(), prID, false).Return(nil),
+			prStorage.EXPECT().GetPullRequestByID(gomock.Any(), prID).
+				Return(&models.PullRequest{ID: prID, AuthorID: "u1", Status: domain.PRStatusOpen}, nil),
+			prStorage.EXPECT().GetParticipantsByPRIDs(gomock.Any(), []domain.PRID{prID}).
+				Return(
+					map[domain.UserID]models.User{"u1": {ID: "u1"}},
+					map[domain.PRID][]models.User{prID: {{ID: "u2"}, {ID: "u3"}}},
+					nil,
+				),
+		)
+
+		pr, err := uc.AddReviewer(context.Background(), prID, "u3")
+
+		So(err, ShouldBeNil)
+		So(pr.Reviewers, ShouldHaveLength, 2)
+		So(pr.Reviewers[1].ID, ShouldEqual, domain.UserID("u3"))
+	})
+}
+
+func TestAddReviewer_Rejected(t *testing.T) {
+	Convey("AddReviewer: invalid candidates are rejected without changes", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, userStorage, cachemock.NewMockStatsCache(ctrl), teamStorage, mocktx, mockLog)
+
+		prID := domain.PRID("p1")
+		openPR := &models.PullRequest{ID: prID, AuthorID: "u1", Status: domain.PRStatusOpen}
+		team := &models.Team{ID: 1, TeamSettings: models.TeamSettings{MinReviewers: 1, MaxReviewers: 1}}
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			}).AnyTimes()
+
+		Convey("PR is not open", func() {
+			prStorage.EXPECT().GetPullRequestByID(gomock.Any(), prID).
+				Return(&models.PullRequest{ID: prID, AuthorID: "u1", Status: domain.PRStatusMerged}, nil)
+
+			_, err := uc.AddReviewer(context.Background(), prID, "u3")
+			So(err, ShouldEqual, errs.ErrPullRequestNotOpen)
+		})
+
+		Convey("candidate is the author", func() {
+			prStorage.EXPECT().GetPullRequestByID(gomock.Any(), prID).Return(openPR, nil)
+			prStorage.EXPECT().GetReviewersFromPR(gomock.Any(), prID).Return(nil, nil)
+
+			_, err := uc.AddReviewer(context.Background(), prID, "u1")
+			So(err, ShouldEqual, errs.ErrReviewerIsAuthor)
+		})
+
+		Convey("candidate is already assigned", func() {
+			prStorage.EXPECT().GetPullRequestByID(gomock.Any(), prID).Return(openPR, nil)
+			prStorage.EXPECT().GetReviewersFromPR(gomock.Any(), prID).
+				Return([]models.User{{ID: "u3", StatusActivity: true}}, nil)
+
+			_, err := uc.AddReviewer(context.Background(), prID, "u3")
+			So(err, ShouldEqual, errs.ErrReviewerAlreadyAssigned)
+		})
+
+		Convey("candidate does not exist", func() {
+			prStorage.EXPECT().GetPullRequestByID(gomock.Any(), prID).Return(openPR, nil)
+			prStorage.EXPECT().GetReviewersFromPR(gomock.Any(), prID).Return(nil, nil)
+			userStorage.EXPECT().GetUserByID(gomock.Any(), domain.UserID("u3")).Return(nil, repositoryerrs.ErrNotFound)
+
+			_, err := uc.AddReviewer(context.Background(), prID, "u3")
+			So(err, ShouldEqual, errs.ErrUserNotFound)
+		})
+
+		Convey("candidate is inactive", func() {
+			prStorage.EXPECT().GetPullRequestByID(gomock.Any(), prID).Return(openPR, nil)
+			prStorage.EXPECT().GetReviewersFromPR(gomock.Any(), prID).Return(nil, nil)
+			userStorage.EXPECT().GetUserByID(gomock.Any(), domain.UserID("u3")).
+				Return(&models.User{ID: "u3", StatusActivity: false}, nil)
+
+			_, err := uc.AddReviewer(context.Background(), prID, "u3")
+			So(err, ShouldEqual, errs.ErrReviewerInactive)
+		})
+
+		Convey("candidate is from another team", func() {
+			prStorage.EXPECT().GetPullRequestByID(gomock.Any(), prID).Return(openPR, nil)
+			prStorage.EXPECT().GetReviewersFromPR(gomock.Any(), prID).Return(nil, nil)
+			userStorage.EXPECT().GetUserByID(gomock.Any(), domain.UserID("u3")).
+				Return(&models.User{ID: "u3", StatusActivity: true}, nil)
+			teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), domain.UserID("u1")).Return(team, nil)
+			teamStorage.EXPECT().GetTeamsByUserID(gomock.Any(), domain.UserID("u3")).
+				Return([]models.TeamMembership{{TeamID: 2, IsPrimary: true}}, nil)
+
+			_, err := uc.AddReviewer(context.Background(), prID, "u3")
+			So(err, ShouldEqual, errs.ErrReviewerNotInTeam)
+		})
+
+		Convey("max reviewers already reached", func() {
+			prStorage.EXPECT().GetPullRequestByID(gomock.Any(), prID).Return(openPR, nil)
+			prStorage.EXPECT().GetReviewersFromPR(gomock.Any(), prID).
+				Return([]models.User{{ID: "u2", StatusActivity: true}}, nil)
+			userStorage.EXPECT().GetUserByID(gomock.Any(), domain.UserID("u3")).
+				Return(&models.User{ID: "u3", StatusActivity: true}, nil)
+			teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), domain.UserID("u1")).Return(team, nil)
+			teamStorage.EXPECT().GetTeamsByUserID(gomock.Any(), domain.UserID("u3")).
+				Return([]models.TeamMembership{{TeamID: team.ID, IsPrimary: true}}, nil)
+
+			_, err := uc.AddReviewer(context.Background(), prID, "u3")
+			So(err, ShouldEqual, errs.ErrTooManyReviewers)
+		})
+	})
+}
+
+func TestRemoveReviewer_Success(t *testing.T) {
+	Convey("RemoveReviewer: reviewer is dropped and PR waits for more reviewers", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		statsCache := cachemock.NewMockStatsCache(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, mock.NewMockUserStorage(ctrl), statsCache, teamStorage, mocktx, mockLog)
+
+		prID := domain.PRID("p1")
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		gomock.InOrder(
+			prStorage.EXPECT().GetPullRequestByID(gomock.Any(), prID).
+				Return(&models.PullRequest{ID: prID, AuthorID: "u1", Status: domain.PRStatusOpen}, nil),
+			prStorage.EXPECT().DeletePRReviewerInstance(gomock.Any(), prID, domain.UserID("u2")).Return(nil),
+			teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), domain.UserID("u1")).
+				Return(&models.Team{ID: 1, TeamSettings: models.TeamSettings{MinReviewers: 2, MaxReviewers: 2}}, nil),
+			prStorage.EXPECT().GetReviewersFromPR(gomock.Any(), prID).
+				Return([]models.User{{ID: "u3", StatusActivity: true}}, nil),
+			prStorage.EXPECT().UpdateNeedMoreReviewers(gomock.Any(), prID, true).Return(nil),
+			prStorage.EXPECT().GetPullRequestByID(gomock.Any(), prID).
+				Return(&models.PullRequest{ID: prID, AuthorID: "u1", Status: domain.PRStatusOpen, NeedMoreReviewers: true}, nil),
+			prStorage.EXPECT().GetParticipantsByPRIDs(gomock.Any(), []domain.PRID{prID}).
+				Return(
+					map[domain.UserID]models.User{"u1": {ID: "u1"}},
+					map[domain.PRID][]models.User{prID: {{ID: "u3"}}},
+					nil,
+				),
+		)
+		// Снятый ревьювер остаётся в статистике назначений.
+		statsCache.EXPECT().DecrementAssignCountByUserID(gomock.Any(), gomock.Any()).Times(0)
+
+		pr, err := uc.RemoveReviewer(context.Background(), prID, "u2")
+
+		So(err, ShouldBeNil)
+		So(pr.Reviewers, ShouldHaveLength, 1)
+		So(pr.NeedMoreReviewers, ShouldBeTrue)
+	})
+}
+
+func TestRemoveReviewer_NotAssigned(t *testing.T) {
+	Convey("RemoveReviewer: user is not a reviewer of the PR", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, mock.NewMockUserStorage(ctrl), cachemock.NewMockStatsCache(ctrl),
+			mock.NewMockTeamStorage(ctrl), mocktx, mockLog)
+
+		prID := domain.PRID("p1")
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		prStorage.EXPECT().GetPullRequestByID(gomock.Any(), prID).
+			Return(&models.PullRequest{ID: prID, AuthorID: "u1", Status: domain.PRStatusOpen}, nil)
+		prStorage.EXPECT().DeletePRReviewerInstance(gomock.Any(), prID, domain.UserID("u9")).
+			Return(repositoryerrs.ErrNotFound)
+
+		_, err := uc.RemoveReviewer(context.Background(), prID, "u9")
+
+		So(err, ShouldEqual, errs.ErrReviewerNotFoundInPullRequest)
+	})
+}
@@ -0,0 +1,93 @@
+package pr_usecase
+
+import (
+	"app/internal/domain"
+	cachemock "app/internal/repository/cache/mock"
+	"app/internal/repository/models"
+	mock "app/internal/repository/storage/mock"
+	"app/internal/usecase/errs"
+	mocklog "app/pkg/logger/mock"
+	txmock "app/pkg/txmanager/mock"
+	"context"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreatePR_RequiredSkills(t *testing.T) {
+	Convey("CreatePR: candidates with more matching skills are selected first", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		statsCache := cachemock.NewMockStatsCache(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, userStorage, statsCache, teamStorage, mocktx, mockLog)
+
+		authorID := domain.UserID("u1")
+		prID := domain.PRID("p1")
+		teamID := domain.TeamID(5)
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		userStorage.EXPECT().GetUserByID(gomock.Any(), authorID).Return(&models.User{ID: authorID}, nil)
+		prStorage.EXPECT().CreatePullRequest(gomock.Any(), prID, "pr", authorID, domain.PRStatusOpen).
+			Return(&models.PullRequest{ID: prID, Name: "pr", AuthorID: authorID}, nil)
+		teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), authorID).
+			Return(&models.Team{ID: teamID, TeamSettings: models.TeamSettings{MinReviewers: 2, MaxReviewers: 2}}, nil)
+		teamStorage.EXPECT().GetUsersByTeam(gomock.Any(), teamID).
+			Return([]models.User{
+				{ID: authorID, StatusActivity: true},
+				{ID: "u2", StatusActivity: true},
+				{ID: "u3", StatusActivity: true},
+				{ID: "u4", StatusActivity: true},
+			}, nil)
+
+		userStorage.EXPECT().GetSkillsByUserIDs(gomock.Any(), gomock.Any()).
+			Return(map[domain.UserID][]string{
+				"u2": {"frontend"},
+				"u3": {"go", "sql"},
+				"u4": {"sql"},
+			}, nil)
+
+		prStorage.EXPECT().CreatePRReviewerInstance(gomock.Any(), prID, domain.UserID("u3"), nil).Return(nil)
+		prStorage.EXPECT().CreatePRReviewerInstance(gomock.Any(), prID, domain.UserID("u4"), nil).Return(nil)
+		statsCache.EXPECT().IncrementAssignCountByUserID(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+		prStorage.EXPECT().UpdateNeedMoreReviewers(gomock.Any(), prID, false).Return(nil)
+
+		pr, err := uc.CreatePR(context.Background(), authorID, prID, "pr", domain.CreatePullRequestOptions{RequiredSkills: []string{"SQL", "go"}})
+
+		So(err, ShouldBeNil)
+		So(pr.Reviewers, ShouldHaveLength, 2)
+		So(pr.Reviewers[0].ID, ShouldEqual, domain.UserID("u3"))
+		So(pr.Reviewers[1].ID, ShouldEqual, domain.UserID("u4"))
+	})
+}
+
+func TestCreatePR_InvalidRequiredSkills(t *testing.T) {
+	Convey("CreatePR: invalid skill tag", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		uc := NewPRUseCase(mock.NewMockPRStorage(ctrl), mock.NewMockUserStorage(ctrl), cachemock.NewMockStatsCache(ctrl),
+			mock.NewMockTeamStorage(ctrl), txmock.NewMockTxManager(ctrl), mockLog)
+
+		_, err := uc.CreatePR(context.Background(), "u1", "p1", "pr", domain.CreatePullRequestOptions{RequiredSkills: []string{"sql server"}})
+
+		So(err, ShouldEqual, errs.ErrInvalidSkill)
+	})
+}
@@ -0,0 +1,121 @@
+package pr_usecase
+
+import (
+	"app/internal/domain"
+	cachemock "app/internal/repository/cache/mock"
+	repoerrors "app/internal/repository/errs"
+	"app/internal/repository/models"
+	mock "app/internal/repository/storage/mock"
+	"app/internal/usecase/errs"
+	mocklog "app/pkg/logger/mock"
+	txmock "app/pkg/txmanager/mock"
+	"context"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreatePR_TargetTeam(t *testing.T) {
+	Convey("CreatePR: reviewers are selected from the requested team instead of the primary one", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		statsCache := cachemock.NewMockStatsCache(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, userStorage, statsCache, teamStorage, mocktx, mockLog)
+
+		authorID := domain.UserID("u1")
+		prID := domain.PRID("p1")
+		team := &models.Team{ID: 2, TeamName: "platform", TeamSettings: models.TeamSettings{MinReviewers: 1, MaxReviewers: 1}}
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		gomock.InOrder(
+			userStorage.EXPECT().GetUserByID(gomock.Any(), authorID).Return(&models.User{ID: authorID}, nil),
+			prStorage.EXPECT().CreatePullRequest(gomock.Any(), prID, "pr", authorID, domain.PRStatusOpen).
+				Return(&models.PullRequest{ID: prID, Name: "pr", AuthorID: authorID, Status: domain.PRStatusOpen}, nil),
+			teamStorage.EXPECT().GetTeamByName(gomock.Any(), "platform").Return(team, nil),
+			teamStorage.EXPECT().GetTeamsByUserID(gomock.Any(), authorID).
+				Return([]models.TeamMembership{
+					{TeamID: 1, TeamName: "backend", IsPrimary: true},
+					{TeamID: 2, TeamName: "platform"},
+				}, nil),
+			prStorage.EXPECT().SetPullRequestTeam(gomock.Any(), prID, domain.TeamID(2)).Return(nil),
+			teamStorage.EXPECT().GetUsersByTeam(gomock.Any(), domain.TeamID(2)).
+				Return([]models.User{
+					{ID: authorID, StatusActivity: true},
+					{ID: "u5", StatusActivity: true},
+				}, nil),
+			prStorage.EXPECT().CreatePRReviewerInstance(gomock.Any(), prID, domain.UserID("u5"), nil).Return(nil),
+			statsCache.EXPECT().IncrementAssignCountByUserID(gomock.Any(), domain.UserID("u5")).Return(nil),
+			prStorage.EXPECT().UpdateNeedMoreReviewers(gomock.Any(), prID, false).Return(nil),
+		)
+
+		pr, err := uc.CreatePR(context.Background(), authorID, prID, "pr", domain.CreatePullRequestOptions{TeamName: "platform"})
+
+		So(err, ShouldBeNil)
+		So(pr.Reviewers, ShouldHaveLength, 1)
+		So(pr.Reviewers[0].ID, ShouldEqual, domain.UserID("u5"))
+	})
+}
+
+func TestCreatePR_TargetTeamRejected(t *testing.T) {
+	Convey("CreatePR: requested team must exist and contain the author", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := mocklog.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		prStorage := mock.NewMockPRStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		mocktx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewPRUseCase(prStorage, userStorage, cachemock.NewMockStatsCache(ctrl), teamStorage, mocktx, mockLog)
+
+		authorID := domain.UserID("u1")
+		prID := domain.PRID("p1")
+
+		mocktx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		userStorage.EXPECT().GetUserByID(gomock.Any(), authorID).Return(&models.User{ID: authorID}, nil)
+		prStorage.EXPECT().CreatePullRequest(gomock.Any(), prID, "pr", authorID, domain.PRStatusOpen).
+			Return(&models.PullRequest{ID: prID, Name: "pr", AuthorID: authorID, Status: domain.PRStatusOpen}, nil)
+
+		Convey("team does not exist", func() {
+			teamStorage.EXPECT().GetTeamByName(gomock.Any(), "ghost").Return(nil, repoerrors.ErrNotFound)
+
+			pr, err := uc.CreatePR(context.Background(), authorID, prID, "pr", domain.CreatePullRequestOptions{TeamName: "ghost"})
+
+			So(pr, ShouldBeNil)
+			So(err, ShouldEqual, errs.ErrTeamNotFound)
+		})
+
+		Convey("author is not a member", func() {
+			teamStorage.EXPECT().GetTeamByName(gomock.Any(), "platform").Return(&models.Team{ID: 2, TeamName: "platform"}, nil)
+			teamStorage.EXPECT().GetTeamsByUserID(gomock.Any(), authorID).
+				Return([]models.TeamMembership{{TeamID: 1, TeamName: "backend", IsPrimary: true}}, nil)
+
+			pr, err := uc.CreatePR(context.Background(), authorID, prID, "pr", domain.CreatePullRequestOptions{TeamName: "platform"})
+
+			So(pr, ShouldBeNil)
+			So(err, ShouldEqual, errs.ErrAuthorNotInTeam)
+		})
+	})
+}
@@ -0,0 +1,248 @@
+package pr_usecase
+
+import (
+	"app/internal/domain"
+	repositoryerrs "app/internal/repository/errs"
+	"app/internal/repository/models"
+	"app/internal/usecase/errs"
+	"app/pkg/txmanager"
+	"context"
+	"errors"
+)
+
+// AddReviewer вручную назначает ревьювером конкретного участника команды PR.
+func (p *pullRequestUseCase) AddReviewer(ctx context.Context, prID domain.PRID, reviewerID domain.UserID) (*domain.PullRequest, error) {
+	var pr domain.PullRequest
+
+	err := p.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadWrite,
+		func(ctx context.Context) error {
+			prModel, err := p.getOpenPullRequest(ctx, prID)
+			if err != nil {
+				return err
+			}
+
+			reviewers, err := p.prStorage.GetReviewersFromPR(ctx, prModel.ID)
+			if err != nil {
+				p.logger.Errorw("Failed to get reviewers from pull request", "prID", prModel.ID, "error", err)
+				return err
+			}
+
+			team, err := p.validateReviewerCandidate(ctx, *prModel, reviewers, reviewerID)
+			if err != nil {
+				return err
+			}
+
+			if countActive(reviewers) >= team.MaxReviewers {
+				p.logger.Errorw("Pull request already has max reviewers", "prID", prID, "maxReviewers", team.MaxReviewers)
+				return errs.ErrTooManyReviewers
+			}
+
+			if err := p.prStorage.CreatePRReviewerInstance(ctx, prModel.ID, reviewerID, nil); err != nil {
+				p.logger.Errorw("Failed to create PR reviewer instance", "prID", prModel.ID, "reviewerID", reviewerID, "error", err)
+				return err
+			}
+
+			if err := p.statsCache.IncrementAssignCountByUserID(ctx, reviewerID); err != nil {
+				p.logger.Errorw("Failed to increment assign count in stats cache", "userID", reviewerID, "error", err)
+				return err
+			}
+
+			added := append(reviewers, models.User{ID: reviewerID, StatusActivity: true})
+			if err := p.setNeedMoreReviewers(ctx, *prModel, needsMoreReviewers(*team, added)); err != nil {
+				return err
+			}
+
+			if prModel, err = p.getPullRequest(ctx, prID); err != nil {
+				return err
+			}
+
+			pr, err = p.loadPullRequest(ctx, *prModel)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	p.logger.Infow("Successfully added reviewer", "prID", prID, "reviewerID", reviewerID)
+
+	return &pr, nil
+}
+
+// RemoveReviewer снимает ревьювера без замены. Если ревьюверов стало меньше min_reviewers,
+// PR помечается need_more_reviewers. Статистика считает назначения, поэтому снятие её не уменьшает.
+func (p *pullRequestUseCase) RemoveReviewer(ctx context.Context, prID domain.PRID, reviewerID domain.UserID) (*domain.PullRequest, error) {
+	var pr domain.PullRequest
+
+	err := p.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadWrite,
+		func(ctx context.Context) error {
+			prModel, err := p.getOpenPullRequest(ctx, prID)
+			if err != nil {
+				return err
+			}
+
+			if err := p.prStorage.DeletePRReviewerInstance(ctx, prModel.ID, reviewerID); err != nil {
+				if errors.Is(err, repositoryerrs.ErrNotFound) {
+					p.logger.Errorw("Reviewer is not assigned to pull request", "prID", prID, "reviewerID", reviewerID)
+					return errs.ErrReviewerNotFoundInPullRequest
+				}
+				p.logger.Errorw("Failed to delete PR reviewer instance", "prID", prID, "reviewerID", reviewerID, "error", err)
+				return err
+			}
+
+			team, err := p.pullRequestTeam(ctx, *prModel)
+			if err != nil {
+				return err
+			}
+
+			reviewers, err := p.prStorage.GetReviewersFromPR(ctx, prModel.ID)
+			if err != nil {
+				p.logger.Errorw("Failed to get reviewers from pull request", "prID", prModel.ID, "error", err)
+				return err
+			}
+
+			if err := p.setNeedMoreReviewers(ctx, *prModel, needsMoreReviewers(*team, reviewers)); err != nil {
+				return err
+			}
+
+			if prModel, err = p.getPullRequest(ctx, prID); err != nil {
+				return err
+			}
+
+			pr, err = p.loadPullRequest(ctx, *prModel)
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	p.logger.Infow("Successfully removed reviewer", "prID", prID, "reviewerID", reviewerID)
+
+	return &pr, nil
+}
+
+func (p *pullRequestUseCase) getOpenPullRequest(ctx context.Context, prID domain.PRID) (*models.PullRequest, error) {
+	pr, err := p.getPullRequest(ctx, prID)
+	if err != nil {
+		return nil, err
+	}
+
+	if pr.Status != domain.PRStatusOpen {
+		p.logger.Errorw("Pull request is not open", "prID", prID, "status", pr.Status)
+		return nil, errs.ErrPullRequestNotOpen
+	}
+
+	return pr, nil
+}
+
+// validateReviewerCandidate проверяет, что пользователь может стать ревьювером PR:
+// существует, активен, не автор, ещё не назначен и состоит в команде PR. Возвращает команду PR.
+func (p *pullRequestUseCase) validateReviewerCandidate(ctx context.Context, pr models.PullRequest,
+	reviewers []models.User, candidateID domain.UserID) (*models.Team, error) {
+	if sameUserID(candidateID, pr.AuthorID) {
+		p.logger.Errorw("Author can not review own pull request", "prID", pr.ID, "userID", candidateID)
+		return nil, errs.ErrReviewerIsAuthor
+	}
+
+	for _, r := range reviewers {
+		if sameUserID(r.ID, candidateID) {
+			p.logger.Errorw("Reviewer is already assigned", "prID", pr.ID, "userID", candidateID)
+			return nil, errs.ErrReviewerAlreadyAssigned
+		}
+	}
+
+	candidate, err := p.userStorage.GetUserByID(ctx, candidateID)
+	if err != nil {
+		if errors.Is(err, repositoryerrs.ErrNotFound) {
+			p.logger.Errorw("Reviewer candidate not found", "userID", candidateID)
+			return nil, errs.ErrUserNotFound
+		}
+		p.logger.Errorw("Failed to get user by ID", "userID", candidateID, "error", err)
+		return nil, err
+	}
+
+	if !candidate.StatusActivity {
+		p.logger.Errorw("Reviewer candidate is inactive", "prID", pr.ID, "userID", candidateID)
+		return nil, errs.ErrReviewerInactive
+	}
+
+	unavailable, err := p.isUnavailable(ctx, candidateID)
+	if err != nil {
+		return nil, err
+	}
+	if unavailable {
+		p.logger.Errorw("Reviewer candidate is unavailable", "prID", pr.ID, "userID", candidateID)
+		return nil, errs.ErrReviewerUnavailable
+	}
+
+	team, err := p.pullRequestTeam(ctx, pr)
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := p.isTeamMember(ctx, candidateID, team.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !member {
+		p.logger.Errorw("Reviewer candidate is not in author's team", "prID", pr.ID, "userID", candidateID, "teamID", team.ID)
+		return nil, errs.ErrReviewerNotInTeam
+	}
+
+	return team, nil
+}
+
+// replaceReviewerWith снимает reviewerID с PR и назначает вместо него указанного участника команды PR.
+func (p *pullRequestUseCase) replaceReviewerWith(ctx context.Context, pr models.PullRequest,
+	reviewerID domain.UserID, newReviewerID domain.UserID) (*models.User, error) {
+	reviewers, err := p.prStorage.GetReviewersFromPR(ctx, pr.ID)
+	if err != nil {
+		p.logger.Errorw("Failed to get reviewers from pull request", "prID", pr.ID, "error", err)
+		return nil, err
+	}
+
+	var removedID domain.UserID
+	remaining := make([]models.User, 0, len(reviewers))
+	for _, r := range reviewers {
+		if sameUserID(r.ID, reviewerID) {
+			removedID = r.ID
+			continue
+		}
+		remaining = append(remaining, r)
+	}
+
+	if len(remaining) == len(reviewers) {
+		p.logger.Errorw("Reviewer is not assigned to pull request", "prID", pr.ID, "reviewerID", reviewerID)
+		return nil, errs.ErrReviewerNotFoundInPullRequest
+	}
+
+	team, err := p.validateReviewerCandidate(ctx, pr, reviewers, newReviewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.prStorage.DeletePRReviewerInstance(ctx, pr.ID, removedID); err != nil {
+		p.logger.Errorw("Failed to delete PR reviewer instance", "prID", pr.ID, "reviewerID", removedID, "error", err)
+		return nil, err
+	}
+
+	if err := p.prStorage.CreatePRReviewerInstance(ctx, pr.ID, newReviewerID, nil); err != nil {
+		p.logger.Errorw("Failed to create PR reviewer instance", "prID", pr.ID, "reviewerID", newReviewerID, "error", err)
+		return nil, err
+	}
+
+	if err := p.statsCache.IncrementAssignCountByUserID(ctx, newReviewerID); err != nil {
+		p.logger.Errorw("Failed to increment assign count in stats cache", "userID", newReviewerID, "error", err)
+		return nil, err
+	}
+
+	user := models.User{ID: newReviewerID, StatusActivity: true}
+
+	if err := p.setNeedMoreReviewers(ctx, pr, needsMoreReviewers(*team, append(remaining, user))); err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
@@ -0,0 +1,55 @@
+package pr_usecase
+
+import (
+	"app/pkg/logger"
+	"context"
+	"time"
+)
+
+// ReviewerFiller в фоне добирает ревьюверов в OPEN PR с need_more_reviewers.
+// Запускается по Notify (пользователь стал активным или вступил в команду) и, если задан interval, по таймеру.
+type ReviewerFiller struct {
+	useCase  PullRequestUseCase
+	interval time.Duration
+	trigger  chan struct{}
+	logger   logger.Logger
+}
+
+func NewReviewerFiller(useCase PullRequestUseCase, interval time.Duration, logger logger.Logger) *ReviewerFiller {
+	return &ReviewerFiller{
+		useCase:  useCase,
+		interval: interval,
+		trigger:  make(chan struct{}, 1),
+		logger:   logger,
+	}
+}
+
+// Notify не блокирует: повторные сигналы до очередного прохода схлопываются в один.
+func (f *ReviewerFiller) Notify() {
+	select {
+	case f.trigger <- struct{}{}:
+	default:
+	}
+}
+
+func (f *ReviewerFiller) Run(ctx context.Context) {
+	var tick <-chan time.Time
+	if f.interval > 0 {
+		ticker := time.NewTicker(f.interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-f.trigger:
+		case <-tick:
+		}
+
+		if _, err := f.useCase.FillReviewers(ctx); err != nil {
+			f.logger.Errorw("Failed to fill reviewers", "error", err)
+		}
+	}
+}
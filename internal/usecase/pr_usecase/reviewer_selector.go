@@ -0,0 +1,280 @@
+package pr_usecase
+
+import (
+	"app/internal/domain"
+	"app/internal/repository/models"
+	"context"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ReviewerSelector выбирает не более count ревьюверов из уже отфильтрованных кандидатов.
+type ReviewerSelector interface {
+	Select(ctx context.Context, candidates []models.User, count int) (Selection, error)
+}
+
+type Selection struct {
+	Reviewers []models.User
+	// Loads — число OPEN ревью у каждого кандидата на момент выбора; nil, если стратегия не учитывает нагрузку.
+	Loads map[domain.UserID]int
+}
+
+// ReviewLoadFunc возвращает число OPEN PR, на которые назначен каждый пользователь.
+type ReviewLoadFunc func(ctx context.Context, userIDs []domain.UserID) (map[domain.UserID]int, error)
+
+type SelectionConfig struct {
+	Strategy       domain.ReviewerStrategy
+	TeamStrategies map[string]domain.ReviewerStrategy
+	Weights        map[domain.UserID]int
+	Seed           int64
+}
+
+type randomSelector struct {
+	rng *rand.Rand
+}
+
+func NewRandomSelector(rng *rand.Rand) ReviewerSelector {
+	return &randomSelector{rng: rng}
+}
+
+func (s *randomSelector) Select(_ context.Context, candidates []models.User, count int) (Selection, error) {
+	if count <= 0 || len(candidates) == 0 {
+		return Selection{}, nil
+	}
+
+	shuffled := make([]models.User, len(candidates))
+	copy(shuffled, candidates)
+	s.rng.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+
+	return Selection{Reviewers: shuffled[:min(count, len(shuffled))]}, nil
+}
+
+type roundRobinSelector struct {
+	mu   sync.Mutex
+	last domain.UserID
+}
+
+func NewRoundRobinSelector() ReviewerSelector {
+	return &roundRobinSelector{}
+}
+
+func (s *roundRobinSelector) Select(_ context.Context, candidates []models.User, count int) (Selection, error) {
+	if count <= 0 || len(candidates) == 0 {
+		return Selection{}, nil
+	}
+
+	sorted := make([]models.User, len(candidates))
+	copy(sorted, candidates)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ID < sorted[j].ID })
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Продолжаем с первого кандидата после последнего назначенного, чтобы состав команды мог меняться между вызовами.
+	start := sort.Search(len(sorted), func(i int) bool { return sorted[i].ID > s.last })
+
+	n := min(count, len(sorted))
+	selected := make([]models.User, 0, n)
+	for i := 0; i < n; i++ {
+		selected = append(selected, sorted[(start+i)%len(sorted)])
+	}
+
+	s.last = selected[n-1].ID
+
+	return Selection{Reviewers: selected}, nil
+}
+
+type leastLoadedSelector struct {
+	rng  *rand.Rand
+	load ReviewLoadFunc
+}
+
+func NewLeastLoadedSelector(rng *rand.Rand, load ReviewLoadFunc) ReviewerSelector {
+	return &leastLoadedSelector{rng: rng, load: load}
+}
+
+func (s *leastLoadedSelector) Select(ctx context.Context, candidates []models.User, count int) (Selection, error) {
+	if count <= 0 || len(candidates) == 0 {
+		return Selection{}, nil
+	}
+
+	ids := make([]domain.UserID, 0, len(candidates))
+	for _, c := range candidates {
+		ids = append(ids, c.ID)
+	}
+
+	loads, err := s.load(ctx, ids)
+	if err != nil {
+		return Selection{}, err
+	}
+
+	// Перемешиваем до стабильной сортировки, чтобы равная нагрузка распределялась случайно.
+	shuffled := make([]models.User, len(candidates))
+	copy(shuffled, candidates)
+	s.rng.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+	sort.SliceStable(shuffled, func(i, j int) bool { return loads[shuffled[i].ID] < loads[shuffled[j].ID] })
+
+	return Selection{Reviewers: shuffled[:min(count, len(shuffled))], Loads: loads}, nil
+}
+
+type weightedSelector struct {
+	rng     *rand.Rand
+	weights map[domain.UserID]int
+}
+
+func NewWeightedSelector(rng *rand.Rand, weights map[domain.UserID]int) ReviewerSelector {
+	return &weightedSelector{rng: rng, weights: weights}
+}
+
+func (s *weightedSelector) weight(userID domain.UserID) int {
+	if w, ok := s.weights[userID]; ok && w > 0 {
+		return w
+	}
+	return 1
+}
+
+func (s *weightedSelector) Select(_ context.Context, candidates []models.User, count int) (Selection, error) {
+	if count <= 0 || len(candidates) == 0 {
+		return Selection{}, nil
+	}
+
+	pool := make([]models.User, len(candidates))
+	copy(pool, candidates)
+
+	selected := make([]models.User, 0, min(count, len(pool)))
+	for len(selected) < count && len(pool) > 0 {
+		total := 0
+		for _, u := range pool {
+			total += s.weight(u.ID)
+		}
+
+		r := s.rng.Intn(total)
+		idx := 0
+		for i, u := range pool {
+			r -= s.weight(u.ID)
+			if r < 0 {
+				idx = i
+				break
+			}
+		}
+
+		selected = append(selected, pool[idx])
+		pool = append(pool[:idx], pool[idx+1:]...)
+	}
+
+	return Selection{Reviewers: selected}, nil
+}
+
+type reviewerSelectors struct {
+	cfg         SelectionConfig
+	random      ReviewerSelector
+	leastLoaded ReviewerSelector
+	weighted    ReviewerSelector
+
+	mu         sync.Mutex
+	roundRobin map[domain.TeamID]ReviewerSelector
+}
+
+func newReviewerSelectors(cfg SelectionConfig, load ReviewLoadFunc) *reviewerSelectors {
+	seed := cfg.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	rng := rand.New(&lockedSource{src: rand.NewSource(seed).(rand.Source64)})
+
+	return &reviewerSelectors{
+		cfg:         cfg,
+		random:      NewRandomSelector(rng),
+		leastLoaded: NewLeastLoadedSelector(rng, load),
+		weighted:    NewWeightedSelector(rng, cfg.Weights),
+		roundRobin:  make(map[domain.TeamID]ReviewerSelector),
+	}
+}
+
+func (r *reviewerSelectors) strategyFor(team models.Team) domain.ReviewerStrategy {
+	if team.ReviewerStrategy != nil && team.ReviewerStrategy.IsValid() {
+		return *team.ReviewerStrategy
+	}
+	for name, strategy := range r.cfg.TeamStrategies {
+		if strings.EqualFold(name, team.TeamName) && strategy.IsValid() {
+			return strategy
+		}
+	}
+	if r.cfg.Strategy.IsValid() {
+		return r.cfg.Strategy
+	}
+	return domain.ReviewerStrategyRandom
+}
+
+func (r *reviewerSelectors) forTeam(team models.Team) ReviewerSelector {
+	switch r.strategyFor(team) {
+	case domain.ReviewerStrategyRoundRobin:
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		selector, ok := r.roundRobin[team.ID]
+		if !ok {
+			selector = NewRoundRobinSelector()
+			r.roundRobin[team.ID] = selector
+		}
+		return selector
+	case domain.ReviewerStrategyLeastLoaded:
+		return r.leastLoaded
+	case domain.ReviewerStrategyWeighted:
+		return r.weighted
+	default:
+		return r.random
+	}
+}
+
+// lockedSource делает общий *rand.Rand безопасным для конкурентных запросов.
+type lockedSource struct {
+	mu  sync.Mutex
+	src rand.Source64
+}
+
+func (s *lockedSource) Int63() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.src.Int63()
+}
+
+func (s *lockedSource) Uint64() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.src.Uint64()
+}
+
+func (s *lockedSource) Seed(seed int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.src.Seed(seed)
+}
+
+// sameUserID сравнивает идентификаторы пользователей без учёта регистра.
+func sameUserID(a, b domain.UserID) bool {
+	return strings.EqualFold(a.String(), b.String())
+}
+
+// reviewerCandidates оставляет активных пользователей, не входящих в exclude.
+func reviewerCandidates(users []models.User, exclude ...domain.UserID) []models.User {
+	candidates := make([]models.User, 0, len(users))
+	for _, u := range users {
+		if !u.StatusActivity {
+			continue
+		}
+		excluded := false
+		for _, id := range exclude {
+			if sameUserID(u.ID, id) {
+				excluded = true
+				break
+			}
+		}
+		if !excluded {
+			candidates = append(candidates, u)
+		}
+	}
+	return candidates
+}
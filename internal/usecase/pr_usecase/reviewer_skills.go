@@ -0,0 +1,97 @@
+package pr_usecase
+
+import (
+	"app/internal/domain"
+	"app/internal/repository/models"
+	"context"
+	"sort"
+)
+
+// SkillsFunc возвращает теги навыков каждого пользователя.
+type SkillsFunc func(ctx context.Context, userIDs []domain.UserID) (map[domain.UserID][]string, error)
+
+// skillRankedSelector делит кандидатов на группы по числу совпавших с requiredSkills тегов
+// и отдаёт группы стратегии команды по очереди, начиная с лучшей.
+type skillRankedSelector struct {
+	next           ReviewerSelector
+	requiredSkills []string
+	skills         SkillsFunc
+}
+
+func newSkillRankedSelector(next ReviewerSelector, requiredSkills []string, skills SkillsFunc) ReviewerSelector {
+	if len(requiredSkills) == 0 {
+		return next
+	}
+	return &skillRankedSelector{next: next, requiredSkills: requiredSkills, skills: skills}
+}
+
+func (s *skillRankedSelector) Select(ctx context.Context, candidates []models.User, count int) (Selection, error) {
+	if count <= 0 || len(candidates) == 0 {
+		return Selection{}, nil
+	}
+
+	userIDs := make([]domain.UserID, 0, len(candidates))
+	for _, c := range candidates {
+		userIDs = append(userIDs, c.ID)
+	}
+
+	skills, err := s.skills(ctx, userIDs)
+	if err != nil {
+		return Selection{}, err
+	}
+
+	required := make(map[string]bool, len(s.requiredSkills))
+	for _, skill := range s.requiredSkills {
+		required[skill] = true
+	}
+
+	tiers := make(map[int][]models.User)
+	for _, c := range candidates {
+		overlap := 0
+		for _, skill := range skills[c.ID] {
+			if required[skill] {
+				overlap++
+			}
+		}
+		tiers[overlap] = append(tiers[overlap], c)
+	}
+
+	overlaps := make([]int, 0, len(tiers))
+	for overlap := range tiers {
+		overlaps = append(overlaps, overlap)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(overlaps)))
+
+	var selection Selection
+	for _, overlap := range overlaps {
+		remaining := count - len(selection.Reviewers)
+		if remaining <= 0 {
+			break
+		}
+
+		picked, err := s.next.Select(ctx, tiers[overlap], remaining)
+		if err != nil {
+			return Selection{}, err
+		}
+
+		for userID, load := range picked.Loads {
+			if selection.Loads == nil {
+				selection.Loads = make(map[domain.UserID]int)
+			}
+			selection.Loads[userID] = load
+		}
+		selection.Reviewers = append(selection.Reviewers, picked.Reviewers...)
+	}
+
+	return selection, nil
+}
+
+// teamSelector — стратегия команды с ранжированием по навыкам, если они заданы,
+// среди кандидатов, доступных в момент выбора.
+func (p *pullRequestUseCase) teamSelector(team models.Team, requiredSkills []string) ReviewerSelector {
+	selector := newSkillRankedSelector(p.selectors.forTeam(team), requiredSkills, p.userStorage.GetSkillsByUserIDs)
+	if p.availabilityStorage == nil {
+		return selector
+	}
+	return &availableSelector{next: selector, availabilityStorage: p.availabilityStorage}
+}
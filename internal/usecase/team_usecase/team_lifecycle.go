@@ -0,0 +1,160 @@
+package team_usecase
+
+import (
+	"app/internal/domain"
+	repositoryerrs "app/internal/repository/errs"
+	"app/internal/usecase/errs"
+	"app/pkg/txmanager"
+	"context"
+	"errors"
+)
+
+const (
+	defaultPageLimit = 50
+	maxPageLimit     = 500
+)
+
+func (t *teamUseCase) ListTeams(ctx context.Context, filter domain.TeamFilter) (*domain.TeamPage, error) {
+	page := &domain.TeamPage{}
+
+	if filter.Limit == 0 {
+		filter.Limit = defaultPageLimit
+	}
+	if filter.Limit < 0 || filter.Limit > maxPageLimit {
+		t.logger.Errorw("Invalid team list limit", "limit", filter.Limit)
+		return nil, errs.ErrInvalidTeamFilter
+	}
+
+	if err := t.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadOnly,
+		func(ctx context.Context) error {
+			// Берём на одну запись больше, чтобы понять, есть ли следующая страница.
+			teamModels, err := t.teamStorage.ListTeams(ctx, filter.AfterName, filter.Limit+1)
+			if err != nil {
+				t.logger.Errorw("Failed to list teams", "error", err)
+				return err
+			}
+
+			if len(teamModels) > filter.Limit {
+				teamModels = teamModels[:filter.Limit]
+				page.NextCursor = &teamModels[len(teamModels)-1].TeamName
+			}
+
+			page.Teams = make([]domain.TeamSummary, 0, len(teamModels))
+			for _, tm := range teamModels {
+				page.Teams = append(page.Teams, domain.TeamSummary{
+					ID:          tm.ID,
+					TeamName:    tm.TeamName,
+					CreatedAt:   tm.CreatedAt,
+					MemberCount: tm.MemberCount,
+				})
+			}
+
+			return nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	t.logger.Infow("Successfully listed teams", "count", len(page.Teams))
+
+	return page, nil
+}
+
+func (t *teamUseCase) RenameTeam(ctx context.Context, teamName string, newTeamName string) (*domain.Team, error) {
+	var team *domain.Team
+
+	if len(newTeamName) == 0 {
+		t.logger.Errorw("New team name is empty", "teamName", teamName)
+		return nil, errs.ErrInvalidTeamName
+	}
+
+	if err := t.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadWrite,
+		func(ctx context.Context) error {
+			teamModel, err := t.teamStorage.GetTeamByName(ctx, teamName)
+			if err != nil {
+				if errors.Is(err, repositoryerrs.ErrNotFound) {
+					t.logger.Errorw("Team not found", "teamName", teamName)
+					return errs.ErrTeamNotFound
+				}
+				t.logger.Errorw("Failed to get team by name", "teamName", teamName, "error", err)
+				return err
+			}
+
+			if err := t.teamStorage.RenameTeam(ctx, teamModel.ID, newTeamName); err != nil {
+				if errors.Is(err, repositoryerrs.ErrAlreadyExists) {
+					t.logger.Errorw("Team already exists", "teamName", newTeamName)
+					return errs.ErrTeamAlreadyExists
+				}
+				t.logger.Errorw("Failed to rename team", "teamID", teamModel.ID, "error", err)
+				return err
+			}
+
+			teamModel.TeamName = newTeamName
+
+			team, err = t.loadTeam(ctx, teamModel)
+			return err
+		},
+	); err != nil {
+		t.logger.Errorw("Transaction failed while renaming team", "teamName", teamName, "error", err)
+		return nil, err
+	}
+
+	t.logger.Infow("Successfully renamed team", "teamName", teamName, "newTeamName", newTeamName)
+
+	return team, nil
+}
+
+// DeleteTeam удаляет команду; участники остаются в системе без команды.
+// Пока у авторов команды есть OPEN PR, удаление отклоняется, если не попросили закрыть их.
+func (t *teamUseCase) DeleteTeam(ctx context.Context, teamName string, closeOpenPRs bool) ([]domain.PRID, error) {
+	var closed []domain.PRID
+
+	if err := t.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadWrite,
+		func(ctx context.Context) error {
+			teamModel, err := t.teamStorage.GetTeamByName(ctx, teamName)
+			if err != nil {
+				if errors.Is(err, repositoryerrs.ErrNotFound) {
+					t.logger.Errorw("Team not found", "teamName", teamName)
+					return errs.ErrTeamNotFound
+				}
+				t.logger.Errorw("Failed to get team by name", "teamName", teamName, "error", err)
+				return err
+			}
+
+			openPRs, err := t.teamStorage.GetOpenPullRequestIDs(ctx, teamModel.ID)
+			if err != nil {
+				t.logger.Errorw("Failed to get open pull requests of team", "teamID", teamModel.ID, "error", err)
+				return err
+			}
+
+			if len(openPRs) > 0 {
+				if !closeOpenPRs || t.prUseCase == nil {
+					t.logger.Errorw("Team has open pull requests", "teamName", teamName, "count", len(openPRs))
+					return errs.ErrTeamHasOpenPullRequests
+				}
+
+				for _, prID := range openPRs {
+					if _, err := t.prUseCase.ClosePR(ctx, prID); err != nil {
+						t.logger.Errorw("Failed to close pull request", "prID", prID, "error", err)
+						return err
+					}
+					closed = append(closed, prID)
+				}
+			}
+
+			if err := t.teamStorage.DeleteTeam(ctx, teamModel.ID); err != nil {
+				t.logger.Errorw("Failed to delete team", "teamID", teamModel.ID, "error", err)
+				return err
+			}
+
+			return nil
+		},
+	); err != nil {
+		t.logger.Errorw("Transaction failed while deleting team", "teamName", teamName, "error", err)
+		return nil, err
+	}
+
+	t.logger.Infow("Successfully deleted team", "teamName", teamName, "closedPullRequests", len(closed))
+
+	return closed, nil
+}
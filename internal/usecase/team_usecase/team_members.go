@@ -0,0 +1,238 @@
+package team_usecase
+
+import (
+	"app/internal/domain"
+	repositoryerrs "app/internal/repository/errs"
+	"app/internal/repository/models"
+	"app/internal/usecase/errs"
+	"app/pkg/txmanager"
+	"context"
+	"errors"
+)
+
+// AddTeamMember добавляет пользователя в команду. Неизвестный пользователь создаётся,
+// существующий сохраняет остальные членства и основную команду.
+func (t *teamUseCase) AddTeamMember(ctx context.Context, teamName string, member domain.TeamUser) (*domain.Team, error) {
+	var team *domain.Team
+
+	if len(member.ID) == 0 || len(member.ID) > 255 {
+		t.logger.Errorw("Invalid user ID provided", "userID", member.ID)
+		return nil, errs.ErrInvalidUserID
+	}
+
+	if err := t.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadWrite,
+		func(ctx context.Context) error {
+			teamModel, err := t.teamStorage.GetTeamByName(ctx, teamName)
+			if err != nil {
+				if errors.Is(err, repositoryerrs.ErrNotFound) {
+					t.logger.Errorw("Team not found", "teamName", teamName)
+					return errs.ErrTeamNotFound
+				}
+				t.logger.Errorw("Failed to get team by name", "teamName", teamName, "error", err)
+				return err
+			}
+
+			_, err = t.userStorage.GetUserByID(ctx, member.ID)
+			if err != nil {
+				if !errors.Is(err, repositoryerrs.ErrNotFound) {
+					t.logger.Errorw("Failed to get user by ID", "userID", member.ID, "error", err)
+					return err
+				}
+
+				if len(member.Name) == 0 {
+					t.logger.Errorw("User name is required for a new user", "userID", member.ID)
+					return errs.ErrInvalidUserID
+				}
+
+				if _, err := t.createOrRestoreUser(ctx, member.ID, member.Name); err != nil {
+					return err
+				}
+			}
+
+			if err := t.teamStorage.CreateUserTeamInstance(ctx, teamModel.ID, member.ID); err != nil {
+				if errors.Is(err, repositoryerrs.ErrAlreadyExists) {
+					t.logger.Errorw("User is already a member of the team", "userID", member.ID, "teamName", teamName)
+					return errs.ErrUserAlreadyInTeam
+				}
+				t.logger.Errorw("Failed to create user-team instance", "teamID", teamModel.ID, "userID", member.ID, "error", err)
+				return err
+			}
+
+			team, err = t.loadTeam(ctx, teamModel)
+			return err
+		},
+	); err != nil {
+		t.logger.Errorw("Transaction failed while adding team member", "teamName", teamName, "userID", member.ID, "error", err)
+		return nil, err
+	}
+
+	t.logger.Infow("Successfully added team member", "teamName", teamName, "userID", member.ID)
+
+	if t.reviewerFiller != nil {
+		t.reviewerFiller.Notify()
+	}
+
+	return team, nil
+}
+
+// RemoveTeamMember исключает пользователя из команды. OPEN ревью в PR этой команды переназначаются
+// до удаления, ревью в PR других команд остаются за ним.
+func (t *teamUseCase) RemoveTeamMember(ctx context.Context, teamName string, userID domain.UserID) (*domain.ReassignmentReport, error) {
+	report := &domain.ReassignmentReport{}
+
+	if err := t.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadWrite,
+		func(ctx context.Context) error {
+			teamModel, err := t.teamStorage.GetTeamByName(ctx, teamName)
+			if err != nil {
+				if errors.Is(err, repositoryerrs.ErrNotFound) {
+					t.logger.Errorw("Team not found", "teamName", teamName)
+					return errs.ErrTeamNotFound
+				}
+				t.logger.Errorw("Failed to get team by name", "teamName", teamName, "error", err)
+				return err
+			}
+
+			memberships, err := t.userMemberships(ctx, userID)
+			if err != nil {
+				return err
+			}
+
+			if !hasMembership(memberships, teamModel.ID) {
+				t.logger.Errorw("User is not a member of the team", "userID", userID, "teamID", teamModel.ID)
+				return errs.ErrUserNotInTeam
+			}
+
+			if t.prUseCase != nil {
+				report, err = t.prUseCase.ReassignTeamReviews(ctx, userID, teamModel.ID)
+				if err != nil {
+					t.logger.Errorw("Failed to reassign team reviews", "userID", userID, "teamID", teamModel.ID, "error", err)
+					return err
+				}
+			}
+
+			if err := t.teamStorage.DeleteUserTeamInstance(ctx, teamModel.ID, userID); err != nil {
+				t.logger.Errorw("Failed to delete user-team instance", "teamID", teamModel.ID, "userID", userID, "error", err)
+				return err
+			}
+
+			return nil
+		},
+	); err != nil {
+		t.logger.Errorw("Transaction failed while removing team member", "teamName", teamName, "userID", userID, "error", err)
+		return nil, err
+	}
+
+	t.logger.Infow("Successfully removed team member", "teamName", teamName, "userID", userID)
+
+	return report, nil
+}
+
+// MoveTeamMember переводит пользователя из основной команды в другую, которая становится основной.
+// OPEN ревью в PR прежней команды переназначаются внутри неё.
+func (t *teamUseCase) MoveTeamMember(ctx context.Context, userID domain.UserID, toTeamName string) (*domain.Team, *domain.ReassignmentReport, error) {
+	var team *domain.Team
+	report := &domain.ReassignmentReport{}
+
+	if err := t.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadWrite,
+		func(ctx context.Context) error {
+			toTeam, err := t.teamStorage.GetTeamByName(ctx, toTeamName)
+			if err != nil {
+				if errors.Is(err, repositoryerrs.ErrNotFound) {
+					t.logger.Errorw("Team not found", "teamName", toTeamName)
+					return errs.ErrTeamNotFound
+				}
+				t.logger.Errorw("Failed to get team by name", "teamName", toTeamName, "error", err)
+				return err
+			}
+
+			memberships, err := t.userMemberships(ctx, userID)
+			if err != nil {
+				return err
+			}
+
+			if len(memberships) == 0 {
+				t.logger.Errorw("User has no team", "userID", userID)
+				return errs.ErrUserHasNoTeam
+			}
+
+			if hasMembership(memberships, toTeam.ID) {
+				t.logger.Errorw("User is already a member of the team", "userID", userID, "teamName", toTeamName)
+				return errs.ErrUserAlreadyInTeam
+			}
+
+			// Переводится основная команда, она всегда первая.
+			fromTeamID := memberships[0].TeamID
+
+			if t.prUseCase != nil {
+				report, err = t.prUseCase.ReassignTeamReviews(ctx, userID, fromTeamID)
+				if err != nil {
+					t.logger.Errorw("Failed to reassign team reviews", "userID", userID, "teamID", fromTeamID, "error", err)
+					return err
+				}
+			}
+
+			if err := t.teamStorage.DeleteUserTeamInstance(ctx, fromTeamID, userID); err != nil {
+				t.logger.Errorw("Failed to delete user-team instance", "teamID", fromTeamID, "userID", userID, "error", err)
+				return err
+			}
+
+			if err := t.teamStorage.CreateUserTeamInstance(ctx, toTeam.ID, userID); err != nil {
+				t.logger.Errorw("Failed to create user-team instance", "teamID", toTeam.ID, "userID", userID, "error", err)
+				return err
+			}
+
+			if err := t.teamStorage.SetPrimaryTeam(ctx, userID, toTeam.ID); err != nil {
+				t.logger.Errorw("Failed to set primary team", "teamID", toTeam.ID, "userID", userID, "error", err)
+				return err
+			}
+
+			team, err = t.loadTeam(ctx, toTeam)
+			return err
+		},
+	); err != nil {
+		t.logger.Errorw("Transaction failed while moving team member", "teamName", toTeamName, "userID", userID, "error", err)
+		return nil, nil, err
+	}
+
+	t.logger.Infow("Successfully moved team member", "teamName", toTeamName, "userID", userID)
+
+	if t.reviewerFiller != nil {
+		t.reviewerFiller.Notify()
+	}
+
+	return team, report, nil
+}
+
+// createOrRestoreUser создаёт пользователя. GetUserByID не видит мягко удалённых, поэтому удалённый
+// пользователь с тем же id восстанавливается активным, с новым именем и без прежних команд.
+func (t *teamUseCase) createOrRestoreUser(ctx context.Context, userID domain.UserID, name string) (*models.User, error) {
+	user, err := t.userStorage.CreateOrRestoreUser(ctx, userID, name)
+	if err != nil {
+		if errors.Is(err, repositoryerrs.ErrAlreadyExists) {
+			t.logger.Errorw("User already exists", "userID", userID)
+			return nil, errs.ErrUserAlreadyExists
+		}
+		t.logger.Errorw("Failed to create or restore user", "userID", userID, "error", err)
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (t *teamUseCase) userMemberships(ctx context.Context, userID domain.UserID) ([]models.TeamMembership, error) {
+	memberships, err := t.teamStorage.GetTeamsByUserID(ctx, userID)
+	if err != nil {
+		t.logger.Errorw("Failed to get teams by user ID", "userID", userID, "error", err)
+		return nil, err
+	}
+	return memberships, nil
+}
+
+func hasMembership(memberships []models.TeamMembership, teamID domain.TeamID) bool {
+	for _, membership := range memberships {
+		if membership.TeamID == teamID {
+			return true
+		}
+	}
+	return false
+}
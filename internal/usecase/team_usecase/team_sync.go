@@ -0,0 +1,286 @@
+package team_usecase
+
+import (
+	"app/internal/domain"
+	repositoryerrs "app/internal/repository/errs"
+	"app/internal/repository/models"
+	"app/internal/usecase/errs"
+	"app/pkg/txmanager"
+	"context"
+	"errors"
+)
+
+// syncMember — участник из желаемого состава, которого нужно добавить в команду или обновить.
+type syncMember struct {
+	user domain.User
+	// exists — пользователь уже заведён в системе.
+	exists          bool
+	nameChanged     bool
+	activityChanged bool
+}
+
+type teamSyncPlan struct {
+	added   []syncMember
+	updated []syncMember
+	removed []domain.UserID
+}
+
+// SyncTeam приводит состав команды к members одной транзакцией; отсутствующая команда создаётся.
+// При dryRun изменения только вычисляются.
+func (t *teamUseCase) SyncTeam(ctx context.Context, teamName string, members []domain.User, dryRun bool) (*domain.TeamSyncReport, error) {
+	report := &domain.TeamSyncReport{TeamName: teamName, DryRun: dryRun}
+
+	if len(teamName) == 0 {
+		t.logger.Errorw("Team name is empty")
+		return nil, errs.ErrInvalidTeamName
+	}
+
+	if len(members) == 0 {
+		t.logger.Errorw("No users provided for team sync", "teamName", teamName)
+		return nil, errs.ErrNoUsersProvided
+	}
+
+	seen := make(map[domain.UserID]bool, len(members))
+	for _, member := range members {
+		if len(member.ID) == 0 || len(member.ID) > 255 || len(member.Name) == 0 {
+			t.logger.Errorw("Invalid user ID provided", "userID", member.ID)
+			return nil, errs.ErrInvalidUserID
+		}
+		if seen[member.ID] {
+			t.logger.Errorw("Duplicate team member", "teamName", teamName, "userID", member.ID)
+			return nil, errs.ErrDuplicateTeamMember
+		}
+		seen[member.ID] = true
+	}
+
+	if err := t.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadWrite,
+		func(ctx context.Context) error {
+			var current []models.User
+
+			teamModel, err := t.teamStorage.GetTeamByName(ctx, teamName)
+			if err != nil {
+				if !errors.Is(err, repositoryerrs.ErrNotFound) {
+					t.logger.Errorw("Failed to get team by name", "teamName", teamName, "error", err)
+					return err
+				}
+				report.TeamCreated = true
+			} else {
+				current, err = t.teamStorage.GetUsersByTeam(ctx, teamModel.ID)
+				if err != nil {
+					t.logger.Errorw("Failed to get users by team", "teamID", teamModel.ID, "error", err)
+					return err
+				}
+			}
+
+			plan, err := t.planTeamSync(ctx, current, members)
+			if err != nil {
+				return err
+			}
+
+			for _, m := range plan.added {
+				report.Added = append(report.Added, m.user.ID)
+			}
+			for _, m := range plan.updated {
+				report.Updated = append(report.Updated, m.user.ID)
+			}
+			report.Removed = plan.removed
+
+			if dryRun {
+				return nil
+			}
+
+			if report.TeamCreated {
+				teamModel, err = t.teamStorage.CreateTeam(ctx, teamName)
+				if err != nil {
+					t.logger.Errorw("Failed to create team", "teamName", teamName, "error", err)
+					return err
+				}
+			}
+
+			return t.applyTeamSync(ctx, teamModel.ID, plan, &report.Reassignment)
+		},
+	); err != nil {
+		t.logger.Errorw("Transaction failed while syncing team", "teamName", teamName, "error", err)
+		return nil, err
+	}
+
+	t.logger.Infow("Successfully synced team", "teamName", teamName, "dryRun", dryRun,
+		"added", len(report.Added), "updated", len(report.Updated), "removed", len(report.Removed))
+
+	if !dryRun && t.reviewerFiller != nil && (len(report.Added) > 0 || len(report.Updated) > 0) {
+		t.reviewerFiller.Notify()
+	}
+
+	return report, nil
+}
+
+// planTeamSync вычисляет разницу между текущим и желаемым составом, ничего не меняя.
+func (t *teamUseCase) planTeamSync(ctx context.Context, current []models.User, members []domain.User) (*teamSyncPlan, error) {
+	plan := &teamSyncPlan{}
+
+	currentByID := make(map[domain.UserID]models.User, len(current))
+	for _, user := range current {
+		currentByID[user.ID] = user
+	}
+
+	desired := make(map[domain.UserID]bool, len(members))
+	for _, member := range members {
+		desired[member.ID] = true
+
+		if user, ok := currentByID[member.ID]; ok {
+			m := syncMember{
+				user:            member,
+				exists:          true,
+				nameChanged:     user.Name != member.Name,
+				activityChanged: user.StatusActivity != member.IsActive.IsActive(),
+			}
+			if m.nameChanged || m.activityChanged {
+				plan.updated = append(plan.updated, m)
+			}
+			continue
+		}
+
+		userModel, err := t.userStorage.GetUserByID(ctx, member.ID)
+		if err != nil {
+			if !errors.Is(err, repositoryerrs.ErrNotFound) {
+				t.logger.Errorw("Failed to get user by ID", "userID", member.ID, "error", err)
+				return nil, err
+			}
+			// Новый пользователь создаётся активным.
+			plan.added = append(plan.added, syncMember{user: member, activityChanged: !member.IsActive.IsActive()})
+			continue
+		}
+
+		// Участник других команд сохраняет их: членство в этой добавляется к остальным.
+		plan.added = append(plan.added, syncMember{
+			user:            member,
+			exists:          true,
+			nameChanged:     userModel.Name != member.Name,
+			activityChanged: userModel.StatusActivity != member.IsActive.IsActive(),
+		})
+	}
+
+	for _, user := range current {
+		if !desired[user.ID] {
+			plan.removed = append(plan.removed, user.ID)
+		}
+	}
+
+	return plan, nil
+}
+
+// applyTeamSync применяет план. Сначала меняется состав и активность, затем переназначаются ревью,
+// чтобы они не ушли к тем, кого синхронизация исключает или выключает.
+func (t *teamUseCase) applyTeamSync(ctx context.Context, teamID domain.TeamID, plan *teamSyncPlan, reassignment *domain.ReassignmentReport) error {
+	for _, m := range plan.added {
+		if !m.exists {
+			if _, err := t.createOrRestoreUser(ctx, m.user.ID, m.user.Name); err != nil {
+				return err
+			}
+		}
+
+		if err := t.teamStorage.CreateUserTeamInstance(ctx, teamID, m.user.ID); err != nil {
+			t.logger.Errorw("Failed to create user-team instance", "teamID", teamID, "userID", m.user.ID, "error", err)
+			return err
+		}
+
+		if err := t.updateSyncMember(ctx, m); err != nil {
+			return err
+		}
+	}
+
+	for _, m := range plan.updated {
+		if err := t.updateSyncMember(ctx, m); err != nil {
+			return err
+		}
+	}
+
+	for _, userID := range plan.removed {
+		// Как и в RemoveTeamMember, переназначаются только ревью в PR этой команды.
+		if err := t.reassignTeamReviews(ctx, userID, teamID, reassignment); err != nil {
+			return err
+		}
+		if err := t.teamStorage.DeleteUserTeamInstance(ctx, teamID, userID); err != nil {
+			t.logger.Errorw("Failed to delete user-team instance", "teamID", teamID, "userID", userID, "error", err)
+			return err
+		}
+	}
+
+	if t.prUseCase == nil {
+		return nil
+	}
+
+	for _, group := range [][]syncMember{plan.added, plan.updated} {
+		for _, m := range group {
+			if !m.exists || !m.activityChanged {
+				continue
+			}
+
+			if !m.user.IsActive.IsActive() {
+				if err := t.reassignOpenReviews(ctx, m.user.ID, reassignment); err != nil {
+					return err
+				}
+				continue
+			}
+
+			if err := t.prUseCase.RefreshNeedMoreReviewers(ctx, m.user.ID); err != nil {
+				t.logger.Errorw("Failed to refresh need more reviewers", "userID", m.user.ID, "error", err)
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (t *teamUseCase) updateSyncMember(ctx context.Context, m syncMember) error {
+	if m.exists && m.nameChanged {
+		if err := t.userStorage.UpdateName(ctx, m.user.ID, m.user.Name); err != nil {
+			t.logger.Errorw("Failed to update user name", "userID", m.user.ID, "error", err)
+			return err
+		}
+	}
+
+	if m.activityChanged {
+		if err := t.userStorage.UpdateActivity(ctx, m.user.ID, m.user.IsActive); err != nil {
+			t.logger.Errorw("Failed to update user activity", "userID", m.user.ID, "error", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (t *teamUseCase) reassignOpenReviews(ctx context.Context, userID domain.UserID, reassignment *domain.ReassignmentReport) error {
+	if t.prUseCase == nil {
+		return nil
+	}
+
+	report, err := t.prUseCase.ReassignOpenReviews(ctx, userID)
+	if err != nil {
+		t.logger.Errorw("Failed to reassign open reviews", "userID", userID, "error", err)
+		return err
+	}
+
+	reassignment.Reassigned = append(reassignment.Reassigned, report.Reassigned...)
+	reassignment.Unfilled = append(reassignment.Unfilled, report.Unfilled...)
+
+	return nil
+}
+
+func (t *teamUseCase) reassignTeamReviews(ctx context.Context, userID domain.UserID, teamID domain.TeamID, reassignment *domain.ReassignmentReport) error {
+	if t.prUseCase == nil {
+		return nil
+	}
+
+	report, err := t.prUseCase.ReassignTeamReviews(ctx, userID, teamID)
+	if err != nil {
+		t.logger.Errorw("Failed to reassign team reviews", "userID", userID, "teamID", teamID, "error", err)
+		return err
+	}
+
+	reassignment.Reassigned = append(reassignment.Reassigned, report.Reassigned...)
+	reassignment.Unfilled = append(reassignment.Unfilled, report.Unfilled...)
+
+	return nil
+}
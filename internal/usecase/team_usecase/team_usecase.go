@@ -4,8 +4,10 @@ import (
 	"app/internal/domain"
 	"app/internal/mapper"
 	repositoryerrs "app/internal/repository/errs"
+	"app/internal/repository/models"
 	"app/internal/repository/storage"
 	"app/internal/usecase/errs"
+	"app/internal/usecase/pr_usecase"
 	"app/pkg/logger"
 	"app/pkg/txmanager"
 	"context"
@@ -16,23 +18,56 @@ import (
 type TeamUseCase interface {
 	CreateTeam(ctx context.Context, teamName string, users []domain.TeamUser) (*domain.Team, error)
 	GetTeamByName(ctx context.Context, teamName string) (*domain.Team, error)
+	UpdateTeamSettings(ctx context.Context, teamName string, update domain.TeamSettingsUpdate) (*domain.Team, error)
+	AddTeamMember(ctx context.Context, teamName string, member domain.TeamUser) (*domain.Team, error)
+	RemoveTeamMember(ctx context.Context, teamName string, userID domain.UserID) (*domain.ReassignmentReport, error)
+	MoveTeamMember(ctx context.Context, userID domain.UserID, toTeamName string) (*domain.Team, *domain.ReassignmentReport, error)
+	ListTeams(ctx context.Context, filter domain.TeamFilter) (*domain.TeamPage, error)
+	RenameTeam(ctx context.Context, teamName string, newTeamName string) (*domain.Team, error)
+	DeleteTeam(ctx context.Context, teamName string, closeOpenPRs bool) ([]domain.PRID, error)
+	SyncTeam(ctx context.Context, teamName string, members []domain.User, dryRun bool) (*domain.TeamSyncReport, error)
 }
 
 type teamUseCase struct {
-	teamStorage storage.TeamStorage
-	userStorage storage.UserStorage
-	txmanager   txmanager.TxManager
-	logger      logger.Logger
+	teamStorage    storage.TeamStorage
+	userStorage    storage.UserStorage
+	txmanager      txmanager.TxManager
+	logger         logger.Logger
+	reviewerFiller *pr_usecase.ReviewerFiller
+	prUseCase      pr_usecase.PullRequestUseCase
+}
+
+type Option func(t *teamUseCase)
+
+// WithReviewerFiller будит добор ревьюверов, когда в команде появляются участники.
+func WithReviewerFiller(filler *pr_usecase.ReviewerFiller) Option {
+	return func(t *teamUseCase) {
+		t.reviewerFiller = filler
+	}
+}
+
+// WithPullRequestUseCase включает переназначение OPEN ревью участников, которые покидают команду,
+// и закрытие OPEN PR при удалении команды.
+func WithPullRequestUseCase(prUseCase pr_usecase.PullRequestUseCase) Option {
+	return func(t *teamUseCase) {
+		t.prUseCase = prUseCase
+	}
 }
 
 func NewTeamUseCase(teamStorage storage.TeamStorage, userStorage storage.UserStorage,
-	txmanager txmanager.TxManager, logger logger.Logger) TeamUseCase {
-	return &teamUseCase{
+	txmanager txmanager.TxManager, logger logger.Logger, opts ...Option) TeamUseCase {
+	t := &teamUseCase{
 		teamStorage: teamStorage,
 		userStorage: userStorage,
 		txmanager:   txmanager,
 		logger:      logger,
 	}
+
+	for _, opt := range opts {
+		opt(t)
+	}
+
+	return t
 }
 
 func (t *teamUseCase) GetTeamByName(ctx context.Context, teamName string) (*domain.Team, error) {
@@ -50,19 +85,8 @@ func (t *teamUseCase) GetTeamByName(ctx context.Context, teamName string) (*doma
 				return err
 			}
 
-			userModels, err := t.teamStorage.GetUsersByTeam(ctx, teamModel.ID)
-			if err != nil {
-				t.logger.Errorw("Failed to get users by team", "teamID", teamModel.ID, "error", err)
-				return err
-			}
-
-			team = &domain.Team{
-				ID:       teamModel.ID,
-				TeamName: teamModel.TeamName,
-				Users:    mapper.ModelsToDomainUsers(userModels),
-			}
-
-			return nil
+			team, err = t.loadTeam(ctx, teamModel)
+			return err
 		},
 	); err != nil {
 		return nil, err
@@ -73,6 +97,33 @@ func (t *teamUseCase) GetTeamByName(ctx context.Context, teamName string) (*doma
 	return team, nil
 }
 
+// loadTeam собирает доменную команду: участников, настройки и резервные команды.
+func (t *teamUseCase) loadTeam(ctx context.Context, teamModel *models.Team) (*domain.Team, error) {
+	userModels, err := t.teamStorage.GetUsersByTeam(ctx, teamModel.ID)
+	if err != nil {
+		t.logger.Errorw("Failed to get users by team", "teamID", teamModel.ID, "error", err)
+		return nil, err
+	}
+
+	fallbackTeams, err := t.teamStorage.GetFallbackTeams(ctx, teamModel.ID)
+	if err != nil {
+		t.logger.Errorw("Failed to get fallback teams", "teamID", teamModel.ID, "error", err)
+		return nil, err
+	}
+
+	settings := mapper.ModelToDomainTeamSettings(teamModel.TeamSettings)
+	for _, fallback := range fallbackTeams {
+		settings.FallbackTeams = append(settings.FallbackTeams, fallback.TeamName)
+	}
+
+	return &domain.Team{
+		ID:       teamModel.ID,
+		TeamName: teamModel.TeamName,
+		Users:    mapper.ModelsToDomainUsers(userModels),
+		Settings: settings,
+	}, nil
+}
+
 func (t *teamUseCase) CreateTeam(ctx context.Context, teamName string, users []domain.TeamUser) (*domain.Team, error) {
 	var team *domain.Team
 
@@ -106,17 +157,22 @@ func (t *teamUseCase) CreateTeam(ctx context.Context, teamName string, users []d
 				return err
 			}
 
+			var domainUsers []domain.User
 			for _, user := range users {
-
-				_, err := t.userStorage.CreateUser(ctx, user.ID, user.Name)
+				// Существующий пользователь вступает в новую команду, сохраняя остальные и своё имя.
+				userModel, err := t.userStorage.GetUserByID(ctx, user.ID)
 				if err != nil {
-					if errors.Is(err, repositoryerrs.ErrAlreadyExists) {
-						t.logger.Errorw("Failed to create team", "teamName", teamName, "error", err)
-						return errs.ErrUserAlreadyHasTeam
+					if !errors.Is(err, repositoryerrs.ErrNotFound) {
+						t.logger.Errorw("Failed to get user by ID", "userID", user.ID, "error", err)
+						return err
+					}
+
+					userModel, err = t.createOrRestoreUser(ctx, user.ID, user.Name)
+					if err != nil {
+						return err
 					}
-					t.logger.Errorw("Failed to create user", "userID", user.ID, "error", err)
-					return err
 				}
+				domainUsers = append(domainUsers, mapper.ModelToDomainUser(*userModel))
 
 				err = t.teamStorage.CreateUserTeamInstance(ctx, teamModel.ID, user.ID)
 				if err != nil {
@@ -125,11 +181,6 @@ func (t *teamUseCase) CreateTeam(ctx context.Context, teamName string, users []d
 				}
 			}
 
-			var domainUsers []domain.User
-			for _, user := range users {
-				domainUsers = append(domainUsers, domain.User{ID: user.ID, Name: user.Name})
-			}
-
 			team = &domain.Team{
 				ID:       teamModel.ID,
 				TeamName: teamModel.TeamName,
@@ -145,5 +196,137 @@ func (t *teamUseCase) CreateTeam(ctx context.Context, teamName string, users []d
 
 	t.logger.Infow("Successfully created team", "teamName", teamName)
 
+	if t.reviewerFiller != nil {
+		t.reviewerFiller.Notify()
+	}
+
 	return team, nil
 }
+
+func (t *teamUseCase) UpdateTeamSettings(ctx context.Context, teamName string, update domain.TeamSettingsUpdate) (*domain.Team, error) {
+	var team *domain.Team
+
+	if len(teamName) == 0 {
+		t.logger.Errorw("Team name is empty")
+		return nil, errs.ErrInvalidTeamName
+	}
+
+	if update.MinReviewers < 0 || update.MaxReviewers < 1 || update.MaxReviewers < update.MinReviewers {
+		t.logger.Errorw("Invalid reviewers range", "teamName", teamName,
+			"minReviewers", update.MinReviewers, "maxReviewers", update.MaxReviewers)
+		return nil, errs.ErrInvalidTeamSettings
+	}
+
+	if update.MergePolicy != nil && !isValidMergePolicy(*update.MergePolicy, update.MaxReviewers) {
+		t.logger.Errorw("Invalid merge policy", "teamName", teamName,
+			"minApprovals", update.MergePolicy.MinApprovals, "minAge", update.MergePolicy.MinAge)
+		return nil, errs.ErrInvalidTeamSettings
+	}
+
+	if update.ReviewerStrategy != nil && !update.ReviewerStrategy.IsValid() {
+		t.logger.Errorw("Unknown reviewer strategy", "teamName", teamName, "strategy", *update.ReviewerStrategy)
+		return nil, errs.ErrInvalidTeamSettings
+	}
+
+	if update.FallbackTeams != nil {
+		seen := make(map[string]bool, len(*update.FallbackTeams))
+		for _, fallback := range *update.FallbackTeams {
+			if fallback == teamName || seen[fallback] {
+				t.logger.Errorw("Invalid fallback team", "teamName", teamName, "fallbackTeam", fallback)
+				return nil, errs.ErrInvalidTeamSettings
+			}
+			seen[fallback] = true
+		}
+	}
+
+	if err := t.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadWrite,
+		func(ctx context.Context) error {
+			teamModel, err := t.teamStorage.GetTeamByName(ctx, teamName)
+			if err != nil {
+				if errors.Is(err, repositoryerrs.ErrNotFound) {
+					t.logger.Errorw("Team not found", "teamName", teamName)
+					return errs.ErrTeamNotFound
+				}
+				t.logger.Errorw("Failed to get team by name", "teamName", teamName, "error", err)
+				return err
+			}
+
+			settings := mapper.ModelToDomainTeamSettings(teamModel.TeamSettings)
+			settings.MinReviewers = update.MinReviewers
+			settings.MaxReviewers = update.MaxReviewers
+			if update.ReviewerStrategy != nil {
+				settings.ReviewerStrategy = *update.ReviewerStrategy
+			}
+
+			if update.MergePolicy != nil {
+				settings.MergePolicy = *update.MergePolicy
+			} else if !isValidMergePolicy(settings.MergePolicy, settings.MaxReviewers) {
+				// Сохранённая политика должна оставаться выполнимой при новом MaxReviewers.
+				t.logger.Errorw("Stored merge policy conflicts with new reviewers range", "teamName", teamName,
+					"minApprovals", settings.MergePolicy.MinApprovals, "maxReviewers", settings.MaxReviewers)
+				return errs.ErrInvalidTeamSettings
+			}
+
+			if err := t.teamStorage.UpdateTeamSettings(ctx, teamModel.ID, mapper.DomainToModelTeamSettings(settings)); err != nil {
+				if errors.Is(err, repositoryerrs.ErrInvalidInput) {
+					t.logger.Errorw("Team settings rejected by storage", "teamName", teamName)
+					return errs.ErrInvalidTeamSettings
+				}
+				t.logger.Errorw("Failed to update team settings", "teamID", teamModel.ID, "error", err)
+				return err
+			}
+
+			if update.FallbackTeams == nil {
+				fallbackTeams, err := t.teamStorage.GetFallbackTeams(ctx, teamModel.ID)
+				if err != nil {
+					t.logger.Errorw("Failed to get fallback teams", "teamID", teamModel.ID, "error", err)
+					return err
+				}
+				for _, fallback := range fallbackTeams {
+					settings.FallbackTeams = append(settings.FallbackTeams, fallback.TeamName)
+				}
+			} else {
+				settings.FallbackTeams = *update.FallbackTeams
+
+				fallbackTeamIDs := make([]domain.TeamID, 0, len(settings.FallbackTeams))
+				for _, fallback := range settings.FallbackTeams {
+					fallbackModel, err := t.teamStorage.GetTeamByName(ctx, fallback)
+					if err != nil {
+						if errors.Is(err, repositoryerrs.ErrNotFound) {
+							t.logger.Errorw("Fallback team not found", "teamName", teamName, "fallbackTeam", fallback)
+							return errs.ErrFallbackTeamNotFound
+						}
+						t.logger.Errorw("Failed to get team by name", "teamName", fallback, "error", err)
+						return err
+					}
+					fallbackTeamIDs = append(fallbackTeamIDs, fallbackModel.ID)
+				}
+
+				if err := t.teamStorage.ReplaceFallbackTeams(ctx, teamModel.ID, fallbackTeamIDs); err != nil {
+					t.logger.Errorw("Failed to replace fallback teams", "teamID", teamModel.ID, "error", err)
+					return err
+				}
+			}
+
+			team = &domain.Team{
+				ID:        teamModel.ID,
+				TeamName:  teamModel.TeamName,
+				CreatedAt: teamModel.CreatedAt,
+				Settings:  settings,
+			}
+
+			return nil
+		},
+	); err != nil {
+		t.logger.Errorw("Transaction failed while updating team settings", "teamName", teamName, "error", err)
+		return nil, err
+	}
+
+	t.logger.Infow("Successfully updated team settings", "teamName", teamName)
+
+	return team, nil
+}
+
+func isValidMergePolicy(policy domain.MergePolicy, maxReviewers int) bool {
+	return policy.MinApprovals >= 0 && policy.MinApprovals <= maxReviewers && policy.MinAge >= 0
+}
@@ -47,7 +47,11 @@ func TestTeamUseCase_CreateTeam_Success(t *testing.T) {
             Return(&models.Team{ID: teamID, TeamName: "Alpha"}, nil)
 
         mockUser.EXPECT().
-            CreateUser(ctx, userID, "User One").
+            GetUserByID(ctx, userID).
+            Return(nil, repoerrors.ErrNotFound)
+
+        mockUser.EXPECT().
+            CreateOrRestoreUser(ctx, userID, "User One").
             Return(&models.User{ID: userID}, nil).
             AnyTimes()
 
@@ -99,8 +103,8 @@ func TestTeamUseCase_CreateTeam_AlreadyExists(t *testing.T) {
     })
 }
 
-func TestTeamUseCase_CreateTeam_UserAlreadyHasTeam(t *testing.T) {
-    Convey("User already has team", t, func() {
+func TestTeamUseCase_CreateTeam_UserFromAnotherTeam(t *testing.T) {
+    Convey("User from another team joins the new team and keeps the old one", t, func() {
         ctrl := gomock.NewController(t)
         defer ctrl.Finish()
 
@@ -110,11 +114,12 @@ func TestTeamUseCase_CreateTeam_UserAlreadyHasTeam(t *testing.T) {
         mockLog := loggermock.NewMockLogger(ctrl)
 
         mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+        mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
 
         uc := NewTeamUseCase(mockTeam, mockUser, mockTx, mockLog)
         ctx := context.Background()
 
-        teamID := domain.TeamID(1)
+        teamID := domain.TeamID(2)
         userID := domain.UserID("1")
 
         mockTx.EXPECT().
@@ -124,21 +129,27 @@ func TestTeamUseCase_CreateTeam_UserAlreadyHasTeam(t *testing.T) {
             })
 
         mockTeam.EXPECT().
-            CreateTeam(ctx, "A").
-            Return(&models.Team{ID: teamID, TeamName: "A"}, nil)
+            CreateTeam(ctx, "B").
+            Return(&models.Team{ID: teamID, TeamName: "B"}, nil)
 
+        // Пользователь уже состоит в команде A: он не пересоздаётся и не переименовывается.
         mockUser.EXPECT().
-            CreateUser(ctx, userID, "User One").
-            Return(nil, repoerrors.ErrAlreadyExists)
+            GetUserByID(ctx, userID).
+            Return(&models.User{ID: userID, Name: "User One", StatusActivity: true}, nil)
 
-        team, err := uc.CreateTeam(ctx, "A", []domain.TeamUser{{ID: userID, Name: "User One"}})
+        mockTeam.EXPECT().
+            CreateUserTeamInstance(ctx, teamID, userID).
+            Return(nil)
 
-        So(team, ShouldBeNil)
-        So(errors.Is(err, errs.ErrUserAlreadyHasTeam), ShouldBeTrue)
+        team, err := uc.CreateTeam(ctx, "B", []domain.TeamUser{{ID: userID, Name: "Renamed"}})
+
+        So(err, ShouldBeNil)
+        So(team.Users, ShouldHaveLength, 1)
+        So(team.Users[0].Name, ShouldEqual, "User One")
+        So(team.Users[0].IsActive, ShouldEqual, domain.UserStatusActive)
     })
 }
 
-
 func TestTeamUseCase_CreateTeam_InvalidTeamName(t *testing.T) {
     Convey("CreateTeam invalid team name", t, func() {
         ctrl := gomock.NewController(t)
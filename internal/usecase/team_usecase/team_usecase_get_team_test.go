@@ -54,12 +54,17 @@ func TestTeamUseCase_GetTeamByName_Success(t *testing.T) {
                 {ID: userID2},
             }, nil)
 
+        mockTeam.EXPECT().
+            GetFallbackTeams(ctx, teamID).
+            Return([]models.Team{{ID: 2, TeamName: "beta"}}, nil)
+
         team, err := uc.GetTeamByName(ctx, "alpha")
 
         So(err, ShouldBeNil)
         So(team, ShouldNotBeNil)
         So(team.ID.Int64(), ShouldEqual, int64(1))
         So(len(team.Users), ShouldEqual, 2)
+        So(team.Settings.FallbackTeams, ShouldResemble, []string{"beta"})
     })
 }
 
@@ -0,0 +1,171 @@
+package team_usecase
+
+import (
+	"app/internal/domain"
+	repoerrors "app/internal/repository/errs"
+	"app/internal/repository/models"
+	"app/internal/repository/storage/mock"
+	"app/internal/usecase/errs"
+	prmock "app/internal/usecase/pr_usecase/mock"
+	loggermock "app/pkg/logger/mock"
+	txmock "app/pkg/txmanager/mock"
+	"context"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTeamUseCase_ListTeams_NextCursor(t *testing.T) {
+	Convey("ListTeams returns cursor when there are more teams", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLogger := loggermock.NewMockLogger(ctrl)
+		mockLogger.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLogger.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		mockTeam := mock.NewMockTeamStorage(ctrl)
+		mockTx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewTeamUseCase(mockTeam, mock.NewMockUserStorage(ctrl), mockTx, mockLogger)
+		ctx := context.Background()
+
+		mockTx.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		after := "alpha"
+		mockTeam.EXPECT().
+			ListTeams(ctx, &after, 3).
+			Return([]models.TeamSummary{
+				{ID: 2, TeamName: "beta", MemberCount: 2},
+				{ID: 3, TeamName: "gamma", MemberCount: 0},
+				{ID: 4, TeamName: "delta", MemberCount: 5},
+			}, nil)
+
+		page, err := uc.ListTeams(ctx, domain.TeamFilter{AfterName: &after, Limit: 2})
+
+		So(err, ShouldBeNil)
+		So(page.Teams, ShouldHaveLength, 2)
+		So(page.Teams[0].MemberCount, ShouldEqual, 2)
+		So(*page.NextCursor, ShouldEqual, "gamma")
+	})
+}
+
+func TestTeamUseCase_ListTeams_InvalidLimit(t *testing.T) {
+	Convey("ListTeams rejects limit above maximum", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLogger := loggermock.NewMockLogger(ctrl)
+		mockLogger.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		uc := NewTeamUseCase(mock.NewMockTeamStorage(ctrl), mock.NewMockUserStorage(ctrl), txmock.NewMockTxManager(ctrl), mockLogger)
+
+		page, err := uc.ListTeams(context.Background(), domain.TeamFilter{Limit: maxPageLimit + 1})
+
+		So(page, ShouldBeNil)
+		So(err, ShouldEqual, errs.ErrInvalidTeamFilter)
+	})
+}
+
+func TestTeamUseCase_RenameTeam_AlreadyExists(t *testing.T) {
+	Convey("RenameTeam rejects name of another team", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLogger := loggermock.NewMockLogger(ctrl)
+		mockLogger.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		mockTeam := mock.NewMockTeamStorage(ctrl)
+		mockTx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewTeamUseCase(mockTeam, mock.NewMockUserStorage(ctrl), mockTx, mockLogger)
+		ctx := context.Background()
+
+		mockTx.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		mockTeam.EXPECT().GetTeamByName(ctx, "alpha").Return(&models.Team{ID: 1, TeamName: "alpha"}, nil)
+		mockTeam.EXPECT().RenameTeam(ctx, domain.TeamID(1), "beta").Return(repoerrors.ErrAlreadyExists)
+
+		team, err := uc.RenameTeam(ctx, "alpha", "beta")
+
+		So(team, ShouldBeNil)
+		So(err, ShouldEqual, errs.ErrTeamAlreadyExists)
+	})
+}
+
+func TestTeamUseCase_DeleteTeam_RefusesWithOpenPRs(t *testing.T) {
+	Convey("DeleteTeam refuses while team authors have open pull requests", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLogger := loggermock.NewMockLogger(ctrl)
+		mockLogger.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		mockTeam := mock.NewMockTeamStorage(ctrl)
+		mockTx := txmock.NewMockTxManager(ctrl)
+		prUseCase := prmock.NewMockPullRequestUseCase(ctrl)
+
+		uc := NewTeamUseCase(mockTeam, mock.NewMockUserStorage(ctrl), mockTx, mockLogger, WithPullRequestUseCase(prUseCase))
+		ctx := context.Background()
+
+		mockTx.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		mockTeam.EXPECT().GetTeamByName(ctx, "alpha").Return(&models.Team{ID: 1, TeamName: "alpha"}, nil)
+		mockTeam.EXPECT().GetOpenPullRequestIDs(ctx, domain.TeamID(1)).Return([]domain.PRID{"p1"}, nil)
+
+		closed, err := uc.DeleteTeam(ctx, "alpha", false)
+
+		So(closed, ShouldBeNil)
+		So(err, ShouldEqual, errs.ErrTeamHasOpenPullRequests)
+	})
+}
+
+func TestTeamUseCase_DeleteTeam_ClosesOpenPRs(t *testing.T) {
+	Convey("DeleteTeam closes open pull requests before deleting the team", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLogger := loggermock.NewMockLogger(ctrl)
+		mockLogger.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLogger.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		mockTeam := mock.NewMockTeamStorage(ctrl)
+		mockTx := txmock.NewMockTxManager(ctrl)
+		prUseCase := prmock.NewMockPullRequestUseCase(ctrl)
+
+		uc := NewTeamUseCase(mockTeam, mock.NewMockUserStorage(ctrl), mockTx, mockLogger, WithPullRequestUseCase(prUseCase))
+		ctx := context.Background()
+
+		mockTx.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		mockTeam.EXPECT().GetTeamByName(ctx, "alpha").Return(&models.Team{ID: 1, TeamName: "alpha"}, nil)
+		mockTeam.EXPECT().GetOpenPullRequestIDs(ctx, domain.TeamID(1)).Return([]domain.PRID{"p1", "p2"}, nil)
+		gomock.InOrder(
+			prUseCase.EXPECT().ClosePR(ctx, domain.PRID("p1")).Return(&domain.PullRequest{}, nil),
+			prUseCase.EXPECT().ClosePR(ctx, domain.PRID("p2")).Return(&domain.PullRequest{}, nil),
+			mockTeam.EXPECT().DeleteTeam(ctx, domain.TeamID(1)).Return(nil),
+		)
+
+		closed, err := uc.DeleteTeam(ctx, "alpha", true)
+
+		So(err, ShouldBeNil)
+		So(closed, ShouldResemble, []domain.PRID{"p1", "p2"})
+	})
+}
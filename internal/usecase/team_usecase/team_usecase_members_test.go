@@ -0,0 +1,415 @@
+package team_usecase
+
+import (
+	"app/internal/domain"
+	repoerrors "app/internal/repository/errs"
+	"app/internal/repository/models"
+	"app/internal/repository/storage/mock"
+	"app/internal/usecase/errs"
+	prmock "app/internal/usecase/pr_usecase/mock"
+	loggermock "app/pkg/logger/mock"
+	txmock "app/pkg/txmanager/mock"
+	"context"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTeamUseCase_AddTeamMember_CreatesUser(t *testing.T) {
+	Convey("AddTeamMember creates unknown user and joins the team", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLogger := loggermock.NewMockLogger(ctrl)
+		mockLogger.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLogger.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		mockTeam := mock.NewMockTeamStorage(ctrl)
+		mockUser := mock.NewMockUserStorage(ctrl)
+		mockTx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewTeamUseCase(mockTeam, mockUser, mockTx, mockLogger)
+		ctx := context.Background()
+
+		mockTx.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		mockTeam.EXPECT().GetTeamByName(ctx, "alpha").Return(&models.Team{ID: 1, TeamName: "alpha"}, nil)
+		mockUser.EXPECT().GetUserByID(ctx, domain.UserID("u3")).Return(nil, repoerrors.ErrNotFound)
+		mockUser.EXPECT().CreateOrRestoreUser(ctx, domain.UserID("u3"), "Carol").Return(&models.User{ID: "u3", Name: "Carol"}, nil)
+		mockTeam.EXPECT().CreateUserTeamInstance(ctx, domain.TeamID(1), domain.UserID("u3")).Return(nil)
+		mockTeam.EXPECT().
+			GetUsersByTeam(ctx, domain.TeamID(1)).
+			Return([]models.User{{ID: "u1", Name: "Alice"}, {ID: "u3", Name: "Carol"}}, nil)
+		mockTeam.EXPECT().GetFallbackTeams(ctx, domain.TeamID(1)).Return(nil, nil)
+
+		team, err := uc.AddTeamMember(ctx, "alpha", domain.TeamUser{ID: "u3", Name: "Carol"})
+
+		So(err, ShouldBeNil)
+		So(team.Users, ShouldHaveLength, 2)
+	})
+}
+
+func TestTeamUseCase_AddTeamMember_RestoresDeletedUser(t *testing.T) {
+	Convey("AddTeamMember restores soft-deleted user", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLogger := loggermock.NewMockLogger(ctrl)
+		mockLogger.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLogger.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		mockTeam := mock.NewMockTeamStorage(ctrl)
+		mockUser := mock.NewMockUserStorage(ctrl)
+		mockTx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewTeamUseCase(mockTeam, mockUser, mockTx, mockLogger)
+		ctx := context.Background()
+
+		mockTx.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		mockTeam.EXPECT().GetTeamByName(ctx, "alpha").Return(&models.Team{ID: 1, TeamName: "alpha"}, nil)
+
+		gomock.InOrder(
+			// Удалённый пользователь не находится по id, но его строка остаётся.
+			mockUser.EXPECT().GetUserByID(ctx, domain.UserID("u3")).Return(nil, repoerrors.ErrNotFound),
+			mockUser.EXPECT().
+				CreateOrRestoreUser(ctx, domain.UserID("u3"), "Carol").
+				Return(&models.User{ID: "u3", Name: "Carol", StatusActivity: true}, nil),
+			mockTeam.EXPECT().CreateUserTeamInstance(ctx, domain.TeamID(1), domain.UserID("u3")).Return(nil),
+		)
+		mockTeam.EXPECT().
+			GetUsersByTeam(ctx, domain.TeamID(1)).
+			Return([]models.User{{ID: "u1", Name: "Alice"}, {ID: "u3", Name: "Carol"}}, nil)
+		mockTeam.EXPECT().GetFallbackTeams(ctx, domain.TeamID(1)).Return(nil, nil)
+
+		team, err := uc.AddTeamMember(ctx, "alpha", domain.TeamUser{ID: "u3", Name: "Carol"})
+
+		So(err, ShouldBeNil)
+		So(team.Users, ShouldHaveLength, 2)
+	})
+}
+
+func TestTeamUseCase_AddTeamMember_UserCreatedConcurrently(t *testing.T) {
+	Convey("AddTeamMember reports a live user that appeared after the lookup", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLogger := loggermock.NewMockLogger(ctrl)
+		mockLogger.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		mockTeam := mock.NewMockTeamStorage(ctrl)
+		mockUser := mock.NewMockUserStorage(ctrl)
+		mockTx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewTeamUseCase(mockTeam, mockUser, mockTx, mockLogger)
+		ctx := context.Background()
+
+		mockTx.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		mockTeam.EXPECT().GetTeamByName(ctx, "alpha").Return(&models.Team{ID: 1, TeamName: "alpha"}, nil)
+		mockUser.EXPECT().GetUserByID(ctx, domain.UserID("u3")).Return(nil, repoerrors.ErrNotFound)
+		mockUser.EXPECT().CreateOrRestoreUser(ctx, domain.UserID("u3"), "Carol").Return(nil, repoerrors.ErrAlreadyExists)
+
+		team, err := uc.AddTeamMember(ctx, "alpha", domain.TeamUser{ID: "u3", Name: "Carol"})
+
+		So(team, ShouldBeNil)
+		So(err, ShouldEqual, errs.ErrUserAlreadyExists)
+	})
+}
+
+func TestTeamUseCase_AddTeamMember_UserHasOtherTeam(t *testing.T) {
+	Convey("AddTeamMember keeps memberships of user from another team", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLogger := loggermock.NewMockLogger(ctrl)
+		mockLogger.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLogger.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		mockTeam := mock.NewMockTeamStorage(ctrl)
+		mockUser := mock.NewMockUserStorage(ctrl)
+		mockTx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewTeamUseCase(mockTeam, mockUser, mockTx, mockLogger)
+		ctx := context.Background()
+
+		mockTx.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		mockTeam.EXPECT().GetTeamByName(ctx, "alpha").Return(&models.Team{ID: 1, TeamName: "alpha"}, nil)
+		mockUser.EXPECT().GetUserByID(ctx, domain.UserID("u3")).Return(&models.User{ID: "u3"}, nil)
+		mockTeam.EXPECT().CreateUserTeamInstance(ctx, domain.TeamID(1), domain.UserID("u3")).Return(nil)
+		mockTeam.EXPECT().
+			GetUsersByTeam(ctx, domain.TeamID(1)).
+			Return([]models.User{{ID: "u1", Name: "Alice"}, {ID: "u3", Name: "Carol"}}, nil)
+		mockTeam.EXPECT().GetFallbackTeams(ctx, domain.TeamID(1)).Return(nil, nil)
+
+		team, err := uc.AddTeamMember(ctx, "alpha", domain.TeamUser{ID: "u3"})
+
+		So(err, ShouldBeNil)
+		So(team.Users, ShouldHaveLength, 2)
+	})
+}
+
+func TestTeamUseCase_AddTeamMember_AlreadyInTeam(t *testing.T) {
+	Convey("AddTeamMember rejects current member", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLogger := loggermock.NewMockLogger(ctrl)
+		mockLogger.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		mockTeam := mock.NewMockTeamStorage(ctrl)
+		mockUser := mock.NewMockUserStorage(ctrl)
+		mockTx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewTeamUseCase(mockTeam, mockUser, mockTx, mockLogger)
+		ctx := context.Background()
+
+		mockTx.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		mockTeam.EXPECT().GetTeamByName(ctx, "alpha").Return(&models.Team{ID: 1, TeamName: "alpha"}, nil)
+		mockUser.EXPECT().GetUserByID(ctx, domain.UserID("u1")).Return(&models.User{ID: "u1"}, nil)
+		mockTeam.EXPECT().CreateUserTeamInstance(ctx, domain.TeamID(1), domain.UserID("u1")).Return(repoerrors.ErrAlreadyExists)
+
+		team, err := uc.AddTeamMember(ctx, "alpha", domain.TeamUser{ID: "u1"})
+
+		So(team, ShouldBeNil)
+		So(err, ShouldEqual, errs.ErrUserAlreadyInTeam)
+	})
+}
+
+func TestTeamUseCase_RemoveTeamMember_ReassignsOpenReviews(t *testing.T) {
+	Convey("RemoveTeamMember reassigns open reviews before leaving the team", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLogger := loggermock.NewMockLogger(ctrl)
+		mockLogger.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLogger.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		mockTeam := mock.NewMockTeamStorage(ctrl)
+		mockUser := mock.NewMockUserStorage(ctrl)
+		mockTx := txmock.NewMockTxManager(ctrl)
+		prUseCase := prmock.NewMockPullRequestUseCase(ctrl)
+
+		uc := NewTeamUseCase(mockTeam, mockUser, mockTx, mockLogger, WithPullRequestUseCase(prUseCase))
+		ctx := context.Background()
+
+		mockTx.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		newReviewer := domain.UserID("u2")
+
+		mockTeam.EXPECT().GetTeamByName(ctx, "alpha").Return(&models.Team{ID: 1, TeamName: "alpha"}, nil)
+		mockTeam.EXPECT().
+			GetTeamsByUserID(ctx, domain.UserID("u1")).
+			Return([]models.TeamMembership{{TeamID: 1, TeamName: "alpha", IsPrimary: true}}, nil)
+		gomock.InOrder(
+			prUseCase.EXPECT().
+				ReassignTeamReviews(ctx, domain.UserID("u1"), domain.TeamID(1)).
+				Return(&domain.ReassignmentReport{
+					Reassigned: []domain.ReviewerReassignment{{PullRequestID: "p1", OldReviewerID: "u1", NewReviewerID: &newReviewer}},
+				}, nil),
+			mockTeam.EXPECT().DeleteUserTeamInstance(ctx, domain.TeamID(1), domain.UserID("u1")).Return(nil),
+		)
+
+		report, err := uc.RemoveTeamMember(ctx, "alpha", "u1")
+
+		So(err, ShouldBeNil)
+		So(report.Reassigned, ShouldHaveLength, 1)
+		So(report.Unfilled, ShouldBeEmpty)
+	})
+}
+
+func TestTeamUseCase_RemoveTeamMember_NotMember(t *testing.T) {
+	Convey("RemoveTeamMember rejects user from another team", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLogger := loggermock.NewMockLogger(ctrl)
+		mockLogger.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		mockTeam := mock.NewMockTeamStorage(ctrl)
+		mockUser := mock.NewMockUserStorage(ctrl)
+		mockTx := txmock.NewMockTxManager(ctrl)
+		prUseCase := prmock.NewMockPullRequestUseCase(ctrl)
+
+		uc := NewTeamUseCase(mockTeam, mockUser, mockTx, mockLogger, WithPullRequestUseCase(prUseCase))
+		ctx := context.Background()
+
+		mockTx.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		mockTeam.EXPECT().GetTeamByName(ctx, "alpha").Return(&models.Team{ID: 1, TeamName: "alpha"}, nil)
+		mockTeam.EXPECT().
+			GetTeamsByUserID(ctx, domain.UserID("u1")).
+			Return([]models.TeamMembership{{TeamID: 2, TeamName: "beta", IsPrimary: true}}, nil)
+
+		report, err := uc.RemoveTeamMember(ctx, "alpha", "u1")
+
+		So(report, ShouldBeNil)
+		So(err, ShouldEqual, errs.ErrUserNotInTeam)
+	})
+}
+
+func TestTeamUseCase_MoveTeamMember_Success(t *testing.T) {
+	Convey("MoveTeamMember reassigns reviews inside the old team and joins the new one", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLogger := loggermock.NewMockLogger(ctrl)
+		mockLogger.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLogger.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		mockTeam := mock.NewMockTeamStorage(ctrl)
+		mockUser := mock.NewMockUserStorage(ctrl)
+		mockTx := txmock.NewMockTxManager(ctrl)
+		prUseCase := prmock.NewMockPullRequestUseCase(ctrl)
+
+		uc := NewTeamUseCase(mockTeam, mockUser, mockTx, mockLogger, WithPullRequestUseCase(prUseCase))
+		ctx := context.Background()
+
+		mockTx.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		mockTeam.EXPECT().GetTeamByName(ctx, "beta").Return(&models.Team{ID: 2, TeamName: "beta"}, nil)
+		mockTeam.EXPECT().
+			GetTeamsByUserID(ctx, domain.UserID("u1")).
+			Return([]models.TeamMembership{{TeamID: 1, TeamName: "alpha", IsPrimary: true}}, nil)
+		gomock.InOrder(
+			prUseCase.EXPECT().
+				ReassignTeamReviews(ctx, domain.UserID("u1"), domain.TeamID(1)).
+				Return(&domain.ReassignmentReport{
+					Unfilled: []domain.ReviewerReassignment{{PullRequestID: "p1", OldReviewerID: "u1"}},
+				}, nil),
+			mockTeam.EXPECT().DeleteUserTeamInstance(ctx, domain.TeamID(1), domain.UserID("u1")).Return(nil),
+			mockTeam.EXPECT().CreateUserTeamInstance(ctx, domain.TeamID(2), domain.UserID("u1")).Return(nil),
+			mockTeam.EXPECT().SetPrimaryTeam(ctx, domain.UserID("u1"), domain.TeamID(2)).Return(nil),
+		)
+		mockTeam.EXPECT().
+			GetUsersByTeam(ctx, domain.TeamID(2)).
+			Return([]models.User{{ID: "u1", Name: "Alice"}}, nil)
+		mockTeam.EXPECT().GetFallbackTeams(ctx, domain.TeamID(2)).Return(nil, nil)
+
+		team, report, err := uc.MoveTeamMember(ctx, "u1", "beta")
+
+		So(err, ShouldBeNil)
+		So(team.TeamName, ShouldEqual, "beta")
+		So(report.Unfilled, ShouldHaveLength, 1)
+	})
+}
+
+func TestTeamUseCase_MoveTeamMember_SameTeam(t *testing.T) {
+	Convey("MoveTeamMember rejects move into a team the user is already in", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLogger := loggermock.NewMockLogger(ctrl)
+		mockLogger.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		mockTeam := mock.NewMockTeamStorage(ctrl)
+		mockUser := mock.NewMockUserStorage(ctrl)
+		mockTx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewTeamUseCase(mockTeam, mockUser, mockTx, mockLogger)
+		ctx := context.Background()
+
+		mockTx.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		mockTeam.EXPECT().GetTeamByName(ctx, "alpha").Return(&models.Team{ID: 1, TeamName: "alpha"}, nil)
+		mockTeam.EXPECT().
+			GetTeamsByUserID(ctx, domain.UserID("u1")).
+			Return([]models.TeamMembership{
+				{TeamID: 2, TeamName: "beta", IsPrimary: true},
+				{TeamID: 1, TeamName: "alpha"},
+			}, nil)
+
+		team, report, err := uc.MoveTeamMember(ctx, "u1", "alpha")
+
+		So(team, ShouldBeNil)
+		So(report, ShouldBeNil)
+		So(err, ShouldEqual, errs.ErrUserAlreadyInTeam)
+	})
+}
+
+func TestTeamUseCase_RemoveTeamMember_KeepsReviewsOfMultiTeamMember(t *testing.T) {
+	Convey("RemoveTeamMember reassigns only reviews of the left team for user who stays in other teams", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLogger := loggermock.NewMockLogger(ctrl)
+		mockLogger.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLogger.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		mockTeam := mock.NewMockTeamStorage(ctrl)
+		mockUser := mock.NewMockUserStorage(ctrl)
+		mockTx := txmock.NewMockTxManager(ctrl)
+		prUseCase := prmock.NewMockPullRequestUseCase(ctrl)
+
+		uc := NewTeamUseCase(mockTeam, mockUser, mockTx, mockLogger, WithPullRequestUseCase(prUseCase))
+		ctx := context.Background()
+
+		mockTx.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		mockTeam.EXPECT().GetTeamByName(ctx, "alpha").Return(&models.Team{ID: 1, TeamName: "alpha"}, nil)
+		mockTeam.EXPECT().
+			GetTeamsByUserID(ctx, domain.UserID("u1")).
+			Return([]models.TeamMembership{
+				{TeamID: 1, TeamName: "alpha", IsPrimary: true},
+				{TeamID: 2, TeamName: "beta"},
+			}, nil)
+		gomock.InOrder(
+			// Ревью в PR команды beta остаются за u1: ReassignTeamReviews их не трогает.
+			prUseCase.EXPECT().
+				ReassignTeamReviews(ctx, domain.UserID("u1"), domain.TeamID(1)).
+				Return(&domain.ReassignmentReport{
+					Unfilled: []domain.ReviewerReassignment{{PullRequestID: "p1", OldReviewerID: "u1"}},
+				}, nil),
+			mockTeam.EXPECT().DeleteUserTeamInstance(ctx, domain.TeamID(1), domain.UserID("u1")).Return(nil),
+		)
+
+		report, err := uc.RemoveTeamMember(ctx, "alpha", "u1")
+
+		So(err, ShouldBeNil)
+		So(report.Reassigned, ShouldBeEmpty)
+		So(report.Unfilled, ShouldHaveLength, 1)
+	})
+}
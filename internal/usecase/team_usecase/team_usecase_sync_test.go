@@ -0,0 +1,234 @@
+package team_usecase
+
+import (
+	"app/internal/domain"
+	repoerrors "app/internal/repository/errs"
+	"app/internal/repository/models"
+	"app/internal/repository/storage/mock"
+	"app/internal/usecase/errs"
+	prmock "app/internal/usecase/pr_usecase/mock"
+	loggermock "app/pkg/logger/mock"
+	txmock "app/pkg/txmanager/mock"
+	"context"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTeamUseCase_SyncTeam_DryRun(t *testing.T) {
+	Convey("SyncTeam dry run computes diff without writes", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLogger := loggermock.NewMockLogger(ctrl)
+		mockLogger.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLogger.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		mockTeam := mock.NewMockTeamStorage(ctrl)
+		mockUser := mock.NewMockUserStorage(ctrl)
+		mockTx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewTeamUseCase(mockTeam, mockUser, mockTx, mockLogger)
+		ctx := context.Background()
+
+		mockTx.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		mockTeam.EXPECT().GetTeamByName(ctx, "alpha").Return(&models.Team{ID: 1, TeamName: "alpha"}, nil)
+		mockTeam.EXPECT().
+			GetUsersByTeam(ctx, domain.TeamID(1)).
+			Return([]models.User{
+				{ID: "u1", Name: "Alice", StatusActivity: true},
+				{ID: "u2", Name: "Bob", StatusActivity: true},
+				{ID: "u3", Name: "Carol", StatusActivity: true},
+			}, nil)
+		mockUser.EXPECT().GetUserByID(ctx, domain.UserID("u4")).Return(nil, repoerrors.ErrNotFound)
+
+		report, err := uc.SyncTeam(ctx, "alpha", []domain.User{
+			{ID: "u1", Name: "Alice", IsActive: domain.UserStatusActive},
+			{ID: "u2", Name: "Bobby", IsActive: domain.UserStatusActive},
+			{ID: "u4", Name: "Dave", IsActive: domain.UserStatusActive},
+		}, true)
+
+		So(err, ShouldBeNil)
+		So(report.DryRun, ShouldBeTrue)
+		So(report.TeamCreated, ShouldBeFalse)
+		So(report.Added, ShouldResemble, []domain.UserID{"u4"})
+		So(report.Updated, ShouldResemble, []domain.UserID{"u2"})
+		So(report.Removed, ShouldResemble, []domain.UserID{"u3"})
+	})
+}
+
+func TestTeamUseCase_SyncTeam_CreatesTeam(t *testing.T) {
+	Convey("SyncTeam creates missing team with its members", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLogger := loggermock.NewMockLogger(ctrl)
+		mockLogger.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLogger.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		mockTeam := mock.NewMockTeamStorage(ctrl)
+		mockUser := mock.NewMockUserStorage(ctrl)
+		mockTx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewTeamUseCase(mockTeam, mockUser, mockTx, mockLogger)
+		ctx := context.Background()
+
+		mockTx.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		mockTeam.EXPECT().GetTeamByName(ctx, "alpha").Return(nil, repoerrors.ErrNotFound)
+		mockUser.EXPECT().GetUserByID(ctx, domain.UserID("u1")).Return(nil, repoerrors.ErrNotFound)
+		mockTeam.EXPECT().CreateTeam(ctx, "alpha").Return(&models.Team{ID: 7, TeamName: "alpha"}, nil)
+		mockUser.EXPECT().CreateOrRestoreUser(ctx, domain.UserID("u1"), "Alice").Return(&models.User{ID: "u1"}, nil)
+		mockTeam.EXPECT().CreateUserTeamInstance(ctx, domain.TeamID(7), domain.UserID("u1")).Return(nil)
+		// Новый пользователь создаётся активным, поэтому выключаем его отдельно.
+		mockUser.EXPECT().UpdateActivity(ctx, domain.UserID("u1"), domain.UserStatusInactive).Return(nil)
+
+		report, err := uc.SyncTeam(ctx, "alpha", []domain.User{
+			{ID: "u1", Name: "Alice", IsActive: domain.UserStatusInactive},
+		}, false)
+
+		So(err, ShouldBeNil)
+		So(report.TeamCreated, ShouldBeTrue)
+		So(report.Added, ShouldResemble, []domain.UserID{"u1"})
+		So(report.Removed, ShouldBeEmpty)
+	})
+}
+
+func TestTeamUseCase_SyncTeam_AppliesDiff(t *testing.T) {
+	Convey("SyncTeam adds, deactivates and removes members, then reassigns their reviews", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLogger := loggermock.NewMockLogger(ctrl)
+		mockLogger.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLogger.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		mockTeam := mock.NewMockTeamStorage(ctrl)
+		mockUser := mock.NewMockUserStorage(ctrl)
+		mockTx := txmock.NewMockTxManager(ctrl)
+		prUseCase := prmock.NewMockPullRequestUseCase(ctrl)
+
+		uc := NewTeamUseCase(mockTeam, mockUser, mockTx, mockLogger, WithPullRequestUseCase(prUseCase))
+		ctx := context.Background()
+
+		mockTx.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		mockTeam.EXPECT().GetTeamByName(ctx, "alpha").Return(&models.Team{ID: 1, TeamName: "alpha"}, nil)
+		mockTeam.EXPECT().
+			GetUsersByTeam(ctx, domain.TeamID(1)).
+			Return([]models.User{
+				{ID: "u1", Name: "Alice", StatusActivity: true},
+				{ID: "u2", Name: "Bob", StatusActivity: true},
+			}, nil)
+		mockUser.EXPECT().GetUserByID(ctx, domain.UserID("u5")).Return(&models.User{ID: "u5", Name: "Eve", StatusActivity: true}, nil)
+
+		gomock.InOrder(
+			// u5 остаётся в своей команде и дополнительно вступает в alpha.
+			mockTeam.EXPECT().CreateUserTeamInstance(ctx, domain.TeamID(1), domain.UserID("u5")).Return(nil),
+			mockUser.EXPECT().UpdateActivity(ctx, domain.UserID("u1"), domain.UserStatusInactive).Return(nil),
+			prUseCase.EXPECT().
+				ReassignTeamReviews(ctx, domain.UserID("u2"), domain.TeamID(1)).
+				Return(&domain.ReassignmentReport{
+					Unfilled: []domain.ReviewerReassignment{{PullRequestID: "p1", OldReviewerID: "u2"}},
+				}, nil),
+			mockTeam.EXPECT().DeleteUserTeamInstance(ctx, domain.TeamID(1), domain.UserID("u2")).Return(nil),
+			prUseCase.EXPECT().
+				ReassignOpenReviews(ctx, domain.UserID("u1")).
+				Return(&domain.ReassignmentReport{
+					Unfilled: []domain.ReviewerReassignment{{PullRequestID: "p2", OldReviewerID: "u1"}},
+				}, nil),
+		)
+
+		report, err := uc.SyncTeam(ctx, "alpha", []domain.User{
+			{ID: "u1", Name: "Alice", IsActive: domain.UserStatusInactive},
+			{ID: "u5", Name: "Eve", IsActive: domain.UserStatusActive},
+		}, false)
+
+		So(err, ShouldBeNil)
+		So(report.Added, ShouldResemble, []domain.UserID{"u5"})
+		So(report.Updated, ShouldResemble, []domain.UserID{"u1"})
+		So(report.Removed, ShouldResemble, []domain.UserID{"u2"})
+		So(report.Reassignment.Unfilled, ShouldHaveLength, 2)
+	})
+}
+
+func TestTeamUseCase_SyncTeam_RestoresDeletedUser(t *testing.T) {
+	Convey("SyncTeam restores soft-deleted member", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLogger := loggermock.NewMockLogger(ctrl)
+		mockLogger.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLogger.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		mockTeam := mock.NewMockTeamStorage(ctrl)
+		mockUser := mock.NewMockUserStorage(ctrl)
+		mockTx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewTeamUseCase(mockTeam, mockUser, mockTx, mockLogger)
+		ctx := context.Background()
+
+		mockTx.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		mockTeam.EXPECT().GetTeamByName(ctx, "alpha").Return(&models.Team{ID: 1, TeamName: "alpha"}, nil)
+		mockTeam.EXPECT().
+			GetUsersByTeam(ctx, domain.TeamID(1)).
+			Return([]models.User{{ID: "u1", Name: "Alice", StatusActivity: true}}, nil)
+		mockUser.EXPECT().GetUserByID(ctx, domain.UserID("u4")).Return(nil, repoerrors.ErrNotFound)
+
+		gomock.InOrder(
+			// Удалённый пользователь не находится по id; его строка восстанавливается тем же запросом, что и создаёт.
+			mockUser.EXPECT().
+				CreateOrRestoreUser(ctx, domain.UserID("u4"), "Dave").
+				Return(&models.User{ID: "u4", Name: "Dave", StatusActivity: true}, nil),
+			mockTeam.EXPECT().CreateUserTeamInstance(ctx, domain.TeamID(1), domain.UserID("u4")).Return(nil),
+		)
+
+		report, err := uc.SyncTeam(ctx, "alpha", []domain.User{
+			{ID: "u1", Name: "Alice", IsActive: domain.UserStatusActive},
+			{ID: "u4", Name: "Dave", IsActive: domain.UserStatusActive},
+		}, false)
+
+		So(err, ShouldBeNil)
+		So(report.Added, ShouldResemble, []domain.UserID{"u4"})
+		So(report.Removed, ShouldBeEmpty)
+	})
+}
+
+func TestTeamUseCase_SyncTeam_DuplicateMember(t *testing.T) {
+	Convey("SyncTeam rejects duplicate members", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLogger := loggermock.NewMockLogger(ctrl)
+		mockLogger.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		uc := NewTeamUseCase(mock.NewMockTeamStorage(ctrl), mock.NewMockUserStorage(ctrl), txmock.NewMockTxManager(ctrl), mockLogger)
+
+		report, err := uc.SyncTeam(context.Background(), "alpha", []domain.User{
+			{ID: "u1", Name: "Alice"},
+			{ID: "u1", Name: "Alice"},
+		}, false)
+
+		So(report, ShouldBeNil)
+		So(err, ShouldEqual, errs.ErrDuplicateTeamMember)
+	})
+}
@@ -0,0 +1,383 @@
+package team_usecase
+
+import (
+	"app/internal/domain"
+	"app/internal/mapper"
+	repoerrors "app/internal/repository/errs"
+	"app/internal/repository/models"
+	"app/internal/repository/storage/mock"
+	"app/internal/usecase/errs"
+	loggermock "app/pkg/logger/mock"
+	txmock "app/pkg/txmanager/mock"
+	"context"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTeamUseCase_UpdateTeamSettings_Success(t *testing.T) {
+	Convey("UpdateTeamSettings success", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLogger := loggermock.NewMockLogger(ctrl)
+		mockLogger.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLogger.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		mockTeam := mock.NewMockTeamStorage(ctrl)
+		mockUser := mock.NewMockUserStorage(ctrl)
+		mockTx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewTeamUseCase(mockTeam, mockUser, mockTx, mockLogger)
+		ctx := context.Background()
+
+		strategy := domain.ReviewerStrategyRoundRobin
+		policy := domain.MergePolicy{MinApprovals: 2, ForbidSelfMerge: true}
+		update := domain.TeamSettingsUpdate{MinReviewers: 1, MaxReviewers: 3, ReviewerStrategy: &strategy, MergePolicy: &policy}
+
+		mockTx.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		mockTeam.EXPECT().
+			GetTeamByName(ctx, "alpha").
+			Return(&models.Team{ID: 1, TeamName: "alpha"}, nil)
+
+		mockTeam.EXPECT().
+			UpdateTeamSettings(ctx, domain.TeamID(1), models.TeamSettings{
+				MinReviewers:     1,
+				MaxReviewers:     3,
+				ReviewerStrategy: &strategy,
+				MergePolicy:      models.MergePolicy{MinApprovals: 2, ForbidSelfMerge: true},
+			}).
+			Return(nil)
+
+		mockTeam.EXPECT().
+			GetFallbackTeams(ctx, domain.TeamID(1)).
+			Return(nil, nil)
+
+		team, err := uc.UpdateTeamSettings(ctx, "alpha", update)
+
+		So(err, ShouldBeNil)
+		So(team.Settings, ShouldResemble, domain.TeamSettings{
+			MinReviewers:     1,
+			MaxReviewers:     3,
+			ReviewerStrategy: strategy,
+			MergePolicy:      policy,
+		})
+	})
+}
+
+func TestTeamUseCase_UpdateTeamSettings_KeepsMergePolicy(t *testing.T) {
+	Convey("UpdateTeamSettings keeps stored merge policy when it is omitted", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLogger := loggermock.NewMockLogger(ctrl)
+		mockLogger.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLogger.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		mockTeam := mock.NewMockTeamStorage(ctrl)
+		mockTx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewTeamUseCase(mockTeam, mock.NewMockUserStorage(ctrl), mockTx, mockLogger)
+		ctx := context.Background()
+
+		stored := models.MergePolicy{MinApprovals: 2, RequireAllResponded: true, MinAgeSeconds: 60}
+
+		mockTx.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			}).
+			AnyTimes()
+
+		mockTeam.EXPECT().
+			GetTeamByName(ctx, "alpha").
+			Return(&models.Team{ID: 1, TeamName: "alpha", TeamSettings: models.TeamSettings{
+				MinReviewers: 2,
+				MaxReviewers: 2,
+				MergePolicy:  stored,
+			}}, nil).
+			AnyTimes()
+
+		Convey("policy is saved unchanged", func() {
+			mockTeam.EXPECT().
+				UpdateTeamSettings(ctx, domain.TeamID(1), models.TeamSettings{MinReviewers: 1, MaxReviewers: 3, MergePolicy: stored}).
+				Return(nil)
+			mockTeam.EXPECT().GetFallbackTeams(ctx, domain.TeamID(1)).Return(nil, nil)
+
+			team, err := uc.UpdateTeamSettings(ctx, "alpha", domain.TeamSettingsUpdate{MinReviewers: 1, MaxReviewers: 3})
+
+			So(err, ShouldBeNil)
+			So(team.Settings.MergePolicy, ShouldResemble, mapper.ModelToDomainMergePolicy(stored))
+		})
+
+		Convey("stored policy must stay reachable with the new max", func() {
+			team, err := uc.UpdateTeamSettings(ctx, "alpha", domain.TeamSettingsUpdate{MinReviewers: 1, MaxReviewers: 1})
+
+			So(team, ShouldBeNil)
+			So(err, ShouldEqual, errs.ErrInvalidTeamSettings)
+		})
+	})
+}
+
+func TestTeamUseCase_UpdateTeamSettings_InvalidRange(t *testing.T) {
+	Convey("UpdateTeamSettings rejects max below min", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLogger := loggermock.NewMockLogger(ctrl)
+		mockLogger.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		uc := NewTeamUseCase(mock.NewMockTeamStorage(ctrl), mock.NewMockUserStorage(ctrl), txmock.NewMockTxManager(ctrl), mockLogger)
+
+		team, err := uc.UpdateTeamSettings(context.Background(), "alpha", domain.TeamSettingsUpdate{MinReviewers: 3, MaxReviewers: 2})
+
+		So(team, ShouldBeNil)
+		So(err, ShouldEqual, errs.ErrInvalidTeamSettings)
+	})
+}
+
+func TestTeamUseCase_UpdateTeamSettings_InvalidMergePolicy(t *testing.T) {
+	Convey("UpdateTeamSettings rejects unreachable approvals count", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLogger := loggermock.NewMockLogger(ctrl)
+		mockLogger.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		uc := NewTeamUseCase(mock.NewMockTeamStorage(ctrl), mock.NewMockUserStorage(ctrl), txmock.NewMockTxManager(ctrl), mockLogger)
+
+		team, err := uc.UpdateTeamSettings(context.Background(), "alpha", domain.TeamSettingsUpdate{
+			MinReviewers: 1,
+			MaxReviewers: 2,
+			MergePolicy:  &domain.MergePolicy{MinApprovals: 3},
+		})
+
+		So(team, ShouldBeNil)
+		So(err, ShouldEqual, errs.ErrInvalidTeamSettings)
+	})
+}
+
+func TestTeamUseCase_UpdateTeamSettings_UnknownStrategy(t *testing.T) {
+	Convey("UpdateTeamSettings rejects unknown strategy", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLogger := loggermock.NewMockLogger(ctrl)
+		mockLogger.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		uc := NewTeamUseCase(mock.NewMockTeamStorage(ctrl), mock.NewMockUserStorage(ctrl), txmock.NewMockTxManager(ctrl), mockLogger)
+
+		strategy := domain.ReviewerStrategy("fastest")
+		_, err := uc.UpdateTeamSettings(context.Background(), "alpha",
+			domain.TeamSettingsUpdate{MinReviewers: 1, MaxReviewers: 2, ReviewerStrategy: &strategy})
+
+		So(err, ShouldEqual, errs.ErrInvalidTeamSettings)
+	})
+}
+
+func TestTeamUseCase_UpdateTeamSettings_KeepsReviewerStrategy(t *testing.T) {
+	Convey("UpdateTeamSettings keeps stored strategy when it is omitted", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLogger := loggermock.NewMockLogger(ctrl)
+		mockLogger.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLogger.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		mockTeam := mock.NewMockTeamStorage(ctrl)
+		mockTx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewTeamUseCase(mockTeam, mock.NewMockUserStorage(ctrl), mockTx, mockLogger)
+		ctx := context.Background()
+
+		strategy := domain.ReviewerStrategyWeighted
+
+		mockTx.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		mockTeam.EXPECT().
+			GetTeamByName(ctx, "alpha").
+			Return(&models.Team{ID: 1, TeamName: "alpha", TeamSettings: models.TeamSettings{
+				MinReviewers:     2,
+				MaxReviewers:     2,
+				ReviewerStrategy: &strategy,
+			}}, nil)
+
+		mockTeam.EXPECT().
+			UpdateTeamSettings(ctx, domain.TeamID(1), models.TeamSettings{MinReviewers: 1, MaxReviewers: 3, ReviewerStrategy: &strategy}).
+			Return(nil)
+
+		mockTeam.EXPECT().GetFallbackTeams(ctx, domain.TeamID(1)).Return(nil, nil)
+
+		team, err := uc.UpdateTeamSettings(ctx, "alpha", domain.TeamSettingsUpdate{MinReviewers: 1, MaxReviewers: 3})
+
+		So(err, ShouldBeNil)
+		So(team.Settings.ReviewerStrategy, ShouldEqual, strategy)
+	})
+}
+
+func TestTeamUseCase_UpdateTeamSettings_TeamNotFound(t *testing.T) {
+	Convey("UpdateTeamSettings team not found", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLogger := loggermock.NewMockLogger(ctrl)
+		mockLogger.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		mockTeam := mock.NewMockTeamStorage(ctrl)
+		mockTx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewTeamUseCase(mockTeam, mock.NewMockUserStorage(ctrl), mockTx, mockLogger)
+		ctx := context.Background()
+
+		mockTx.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		mockTeam.EXPECT().
+			GetTeamByName(ctx, "ghost").
+			Return(nil, repoerrors.ErrNotFound)
+
+		_, err := uc.UpdateTeamSettings(ctx, "ghost", domain.TeamSettingsUpdate{MinReviewers: 1, MaxReviewers: 2})
+
+		So(err, ShouldEqual, errs.ErrTeamNotFound)
+	})
+}
+
+func TestTeamUseCase_UpdateTeamSettings_FallbackTeams(t *testing.T) {
+	Convey("UpdateTeamSettings stores fallback teams in priority order", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLogger := loggermock.NewMockLogger(ctrl)
+		mockLogger.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLogger.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		mockTeam := mock.NewMockTeamStorage(ctrl)
+		mockTx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewTeamUseCase(mockTeam, mock.NewMockUserStorage(ctrl), mockTx, mockLogger)
+		ctx := context.Background()
+
+		settings := domain.TeamSettingsUpdate{MinReviewers: 2, MaxReviewers: 2, FallbackTeams: &[]string{"gamma", "beta"}}
+
+		mockTx.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		mockTeam.EXPECT().GetTeamByName(ctx, "alpha").Return(&models.Team{ID: 1, TeamName: "alpha"}, nil)
+		mockTeam.EXPECT().UpdateTeamSettings(ctx, domain.TeamID(1), gomock.Any()).Return(nil)
+		mockTeam.EXPECT().GetTeamByName(ctx, "gamma").Return(&models.Team{ID: 3, TeamName: "gamma"}, nil)
+		mockTeam.EXPECT().GetTeamByName(ctx, "beta").Return(&models.Team{ID: 2, TeamName: "beta"}, nil)
+		mockTeam.EXPECT().ReplaceFallbackTeams(ctx, domain.TeamID(1), []domain.TeamID{3, 2}).Return(nil)
+
+		team, err := uc.UpdateTeamSettings(ctx, "alpha", settings)
+
+		So(err, ShouldBeNil)
+		So(team.Settings.FallbackTeams, ShouldResemble, []string{"gamma", "beta"})
+	})
+}
+
+func TestTeamUseCase_UpdateTeamSettings_KeepsFallbackTeams(t *testing.T) {
+	Convey("UpdateTeamSettings replaces fallback teams only when they are given", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLogger := loggermock.NewMockLogger(ctrl)
+		mockLogger.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLogger.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		mockTeam := mock.NewMockTeamStorage(ctrl)
+		mockTx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewTeamUseCase(mockTeam, mock.NewMockUserStorage(ctrl), mockTx, mockLogger)
+		ctx := context.Background()
+
+		mockTx.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		mockTeam.EXPECT().GetTeamByName(ctx, "alpha").Return(&models.Team{ID: 1, TeamName: "alpha"}, nil)
+		mockTeam.EXPECT().UpdateTeamSettings(ctx, domain.TeamID(1), gomock.Any()).Return(nil)
+
+		Convey("omitted list keeps stored fallback teams", func() {
+			mockTeam.EXPECT().
+				GetFallbackTeams(ctx, domain.TeamID(1)).
+				Return([]models.Team{{ID: 2, TeamName: "beta"}}, nil)
+
+			team, err := uc.UpdateTeamSettings(ctx, "alpha", domain.TeamSettingsUpdate{MinReviewers: 1, MaxReviewers: 2})
+
+			So(err, ShouldBeNil)
+			So(team.Settings.FallbackTeams, ShouldResemble, []string{"beta"})
+		})
+
+		Convey("empty list clears fallback teams", func() {
+			mockTeam.EXPECT().ReplaceFallbackTeams(ctx, domain.TeamID(1), []domain.TeamID{}).Return(nil)
+
+			team, err := uc.UpdateTeamSettings(ctx, "alpha",
+				domain.TeamSettingsUpdate{MinReviewers: 1, MaxReviewers: 2, FallbackTeams: &[]string{}})
+
+			So(err, ShouldBeNil)
+			So(team.Settings.FallbackTeams, ShouldBeEmpty)
+		})
+	})
+}
+
+func TestTeamUseCase_UpdateTeamSettings_InvalidFallbackTeams(t *testing.T) {
+	Convey("UpdateTeamSettings validates fallback teams", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLogger := loggermock.NewMockLogger(ctrl)
+		mockLogger.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		mockTeam := mock.NewMockTeamStorage(ctrl)
+		mockTx := txmock.NewMockTxManager(ctrl)
+
+		uc := NewTeamUseCase(mockTeam, mock.NewMockUserStorage(ctrl), mockTx, mockLogger)
+		ctx := context.Background()
+
+		Convey("team can not fall back to itself", func() {
+			_, err := uc.UpdateTeamSettings(ctx, "alpha",
+				domain.TeamSettingsUpdate{MinReviewers: 1, MaxReviewers: 2, FallbackTeams: &[]string{"alpha"}})
+			So(err, ShouldEqual, errs.ErrInvalidTeamSettings)
+		})
+
+		Convey("fallback team listed twice", func() {
+			_, err := uc.UpdateTeamSettings(ctx, "alpha",
+				domain.TeamSettingsUpdate{MinReviewers: 1, MaxReviewers: 2, FallbackTeams: &[]string{"beta", "beta"}})
+			So(err, ShouldEqual, errs.ErrInvalidTeamSettings)
+		})
+
+		Convey("unknown fallback team", func() {
+			mockTx.EXPECT().
+				WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, iso, mode any, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+
+			mockTeam.EXPECT().GetTeamByName(ctx, "alpha").Return(&models.Team{ID: 1, TeamName: "alpha"}, nil)
+			mockTeam.EXPECT().UpdateTeamSettings(ctx, domain.TeamID(1), gomock.Any()).Return(nil)
+			mockTeam.EXPECT().GetTeamByName(ctx, "ghost").Return(nil, repoerrors.ErrNotFound)
+
+			_, err := uc.UpdateTeamSettings(ctx, "alpha",
+				domain.TeamSettingsUpdate{MinReviewers: 1, MaxReviewers: 2, FallbackTeams: &[]string{"ghost"}})
+			So(err, ShouldEqual, errs.ErrFallbackTeamNotFound)
+		})
+	})
+}
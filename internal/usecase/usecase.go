@@ -1,6 +1,8 @@
 package usecase
 
 import (
+	"app/internal/usecase/availability_usecase"
+	"app/internal/usecase/code_owner_usecase"
 	"app/internal/usecase/pr_usecase"
 	"app/internal/usecase/stats_usecase"
 	"app/internal/usecase/team_usecase"
@@ -13,6 +15,8 @@ type UseCase interface {
 	team_usecase.TeamUseCase
 	stats_usecase.StatsUseCase
 	pr_usecase.PullRequestUseCase
+	code_owner_usecase.CodeOwnerUseCase
+	availability_usecase.AvailabilityUseCase
 }
 
 
@@ -21,6 +25,8 @@ type useCase struct {
 	team_usecase.TeamUseCase
 	pr_usecase.PullRequestUseCase
 	stats_usecase.StatsUseCase
+	code_owner_usecase.CodeOwnerUseCase
+	availability_usecase.AvailabilityUseCase
 }
 
 func NewUseCase(
@@ -28,11 +34,15 @@ func NewUseCase(
 	teamUseCase team_usecase.TeamUseCase,
 	prUseCase pr_usecase.PullRequestUseCase,
 	statsUseCase stats_usecase.StatsUseCase,
+	codeOwnerUseCase code_owner_usecase.CodeOwnerUseCase,
+	availabilityUseCase availability_usecase.AvailabilityUseCase,
 ) UseCase {
 	return &useCase{
 		UserUseCase:        userUseCase,
 		TeamUseCase:        teamUseCase,
 		PullRequestUseCase: prUseCase,
 		StatsUseCase:       statsUseCase,
+		CodeOwnerUseCase:   codeOwnerUseCase,
+		AvailabilityUseCase: availabilityUseCase,
 	}
 }
\ No newline at end of file
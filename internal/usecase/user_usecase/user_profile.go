@@ -0,0 +1,159 @@
+package user_usecase
+
+import (
+	"app/internal/domain"
+	"app/internal/mapper"
+	repositoryerrs "app/internal/repository/errs"
+	"app/internal/usecase/errs"
+	"app/pkg/txmanager"
+	"context"
+	"errors"
+)
+
+const (
+	defaultPageLimit = 50
+	maxPageLimit     = 500
+)
+
+func (u *userUseCase) ListUsers(ctx context.Context, filter domain.UserFilter) (*domain.UserPage, error) {
+	page := &domain.UserPage{}
+
+	if filter.Limit == 0 {
+		filter.Limit = defaultPageLimit
+	}
+	if filter.Limit < 0 || filter.Limit > maxPageLimit {
+		u.logger.Errorw("Invalid user list limit", "limit", filter.Limit)
+		return nil, errs.ErrInvalidUserFilter
+	}
+
+	if err := u.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadOnly,
+		func(ctx context.Context) error {
+			var teamID *domain.TeamID
+			if filter.TeamName != nil {
+				team, err := u.teamStorage.GetTeamByName(ctx, *filter.TeamName)
+				if err != nil {
+					if errors.Is(err, repositoryerrs.ErrNotFound) {
+						u.logger.Errorw("Team not found", "teamName", *filter.TeamName)
+						return errs.ErrTeamNotFound
+					}
+					u.logger.Errorw("Failed to get team by name", "teamName", *filter.TeamName, "error", err)
+					return err
+				}
+				teamID = &team.ID
+			}
+
+			// Берём на одну запись больше, чтобы понять, есть ли следующая страница.
+			userModels, err := u.userStorage.ListUsers(ctx, teamID, filter.IsActive, filter.AfterID, filter.Limit+1)
+			if err != nil {
+				u.logger.Errorw("Failed to list users", "error", err)
+				return err
+			}
+
+			if len(userModels) > filter.Limit {
+				userModels = userModels[:filter.Limit]
+				page.NextCursor = &userModels[len(userModels)-1].ID
+			}
+
+			page.Users = make([]domain.User, 0, len(userModels))
+			for _, um := range userModels {
+				page.Users = append(page.Users, mapper.ModelToDomainUser(um))
+			}
+
+			return nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	u.logger.Infow("Successfully listed users", "count", len(page.Users))
+
+	return page, nil
+}
+
+func (u *userUseCase) UpdateUserName(ctx context.Context, userID domain.UserID, name string) (*domain.User, error) {
+	var user domain.User
+
+	if len(name) == 0 || len(name) > 255 {
+		u.logger.Errorw("Invalid user name", "userID", userID)
+		return nil, errs.ErrInvalidUserName
+	}
+
+	if err := u.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadWrite,
+		func(ctx context.Context) error {
+			userModel, err := u.userStorage.GetUserByID(ctx, userID)
+			if err != nil {
+				if errors.Is(err, repositoryerrs.ErrNotFound) {
+					u.logger.Errorw("User not found", "userID", userID)
+					return errs.ErrUserNotFound
+				}
+				u.logger.Errorw("Failed to get user by ID", "userID", userID, "error", err)
+				return err
+			}
+
+			if err := u.userStorage.UpdateName(ctx, userID, name); err != nil {
+				u.logger.Errorw("Failed to update user name", "userID", userID, "error", err)
+				return err
+			}
+
+			userModel.Name = name
+			user = mapper.ModelToDomainUser(*userModel)
+			return nil
+		}); err != nil {
+		u.logger.Errorw("Transaction failed while updating user name", "userID", userID, "error", err)
+		return nil, err
+	}
+
+	u.logger.Infow("Successfully updated user name", "userID", userID)
+
+	return &user, nil
+}
+
+// DeleteUser мягко удаляет пользователя: строка и его участие в PR остаются, но он выходит из всех команд,
+// перестаёт находиться по id и больше не назначается ревьювером. Его OPEN ревью переназначаются.
+func (u *userUseCase) DeleteUser(ctx context.Context, userID domain.UserID) (*domain.ReassignmentReport, error) {
+	report := &domain.ReassignmentReport{}
+
+	if err := u.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadWrite,
+		func(ctx context.Context) error {
+			if err := u.userStorage.SoftDeleteUser(ctx, userID); err != nil {
+				if errors.Is(err, repositoryerrs.ErrNotFound) {
+					u.logger.Errorw("User not found", "userID", userID)
+					return errs.ErrUserNotFound
+				}
+				u.logger.Errorw("Failed to delete user", "userID", userID, "error", err)
+				return err
+			}
+
+			// Переназначаем до выхода из команд: замена ищется в команде ревьювера.
+			if u.prUseCase != nil {
+				var err error
+				report, err = u.prUseCase.ReassignOpenReviews(ctx, userID)
+				if err != nil {
+					u.logger.Errorw("Failed to reassign open reviews", "userID", userID, "error", err)
+					return err
+				}
+			}
+
+			teams, err := u.teamStorage.GetTeamsByUserID(ctx, userID)
+			if err != nil {
+				u.logger.Errorw("Failed to get teams by user ID", "userID", userID, "error", err)
+				return err
+			}
+
+			for _, team := range teams {
+				if err := u.teamStorage.DeleteUserTeamInstance(ctx, team.TeamID, userID); err != nil {
+					u.logger.Errorw("Failed to delete user-team instance", "teamID", team.TeamID, "userID", userID, "error", err)
+					return err
+				}
+			}
+
+			return nil
+		}); err != nil {
+		u.logger.Errorw("Transaction failed while deleting user", "userID", userID, "error", err)
+		return nil, err
+	}
+
+	u.logger.Infow("Successfully deleted user", "userID", userID)
+
+	return report, nil
+}
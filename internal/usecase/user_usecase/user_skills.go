@@ -0,0 +1,115 @@
+package user_usecase
+
+import (
+	"app/internal/domain"
+	repositoryerrs "app/internal/repository/errs"
+	"app/internal/usecase/errs"
+	"app/pkg/txmanager"
+	"context"
+	"errors"
+)
+
+func (u *userUseCase) GetUserSkills(ctx context.Context, userID domain.UserID) ([]string, error) {
+	var skills []string
+
+	if err := u.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadOnly,
+		func(ctx context.Context) error {
+			var err error
+			skills, err = u.loadUserSkills(ctx, userID)
+			return err
+		}); err != nil {
+		return nil, err
+	}
+
+	u.logger.Infow("Successfully retrieved user skills", "userID", userID)
+
+	return skills, nil
+}
+
+// AddUserSkills добавляет теги к уже имеющимся и возвращает полный список навыков пользователя.
+func (u *userUseCase) AddUserSkills(ctx context.Context, userID domain.UserID, skills []string) ([]string, error) {
+	normalized, ok := domain.NormalizeSkills(skills)
+	if !ok || len(normalized) == 0 {
+		u.logger.Errorw("Invalid skill tags", "userID", userID, "skills", skills)
+		return nil, errs.ErrInvalidSkill
+	}
+
+	var result []string
+
+	if err := u.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadWrite,
+		func(ctx context.Context) error {
+			if err := u.userStorage.AddUserSkills(ctx, userID, normalized); err != nil {
+				if errors.Is(err, repositoryerrs.ErrNotFound) {
+					u.logger.Errorw("User not found", "userID", userID)
+					return errs.ErrUserNotFound
+				}
+				u.logger.Errorw("Failed to add user skills", "userID", userID, "error", err)
+				return err
+			}
+
+			var err error
+			result, err = u.loadUserSkills(ctx, userID)
+			return err
+		}); err != nil {
+		return nil, err
+	}
+
+	u.logger.Infow("Successfully added user skills", "userID", userID, "skills", normalized)
+
+	return result, nil
+}
+
+func (u *userUseCase) RemoveUserSkills(ctx context.Context, userID domain.UserID, skills []string) ([]string, error) {
+	normalized, ok := domain.NormalizeSkills(skills)
+	if !ok || len(normalized) == 0 {
+		u.logger.Errorw("Invalid skill tags", "userID", userID, "skills", skills)
+		return nil, errs.ErrInvalidSkill
+	}
+
+	var result []string
+
+	if err := u.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadWrite,
+		func(ctx context.Context) error {
+			if _, err := u.loadUserSkills(ctx, userID); err != nil {
+				return err
+			}
+
+			if err := u.userStorage.DeleteUserSkills(ctx, userID, normalized); err != nil {
+				u.logger.Errorw("Failed to delete user skills", "userID", userID, "error", err)
+				return err
+			}
+
+			var err error
+			result, err = u.loadUserSkills(ctx, userID)
+			return err
+		}); err != nil {
+		return nil, err
+	}
+
+	u.logger.Infow("Successfully removed user skills", "userID", userID, "skills", normalized)
+
+	return result, nil
+}
+
+// loadUserSkills проверяет, что пользователь существует, и читает его навыки.
+func (u *userUseCase) loadUserSkills(ctx context.Context, userID domain.UserID) ([]string, error) {
+	if _, err := u.userStorage.GetUserByID(ctx, userID); err != nil {
+		if errors.Is(err, repositoryerrs.ErrNotFound) {
+			u.logger.Errorw("User not found", "userID", userID)
+			return nil, errs.ErrUserNotFound
+		}
+		u.logger.Errorw("Failed to get user by ID", "userID", userID, "error", err)
+		return nil, err
+	}
+
+	skills, err := u.userStorage.GetSkillsByUserIDs(ctx, []domain.UserID{userID})
+	if err != nil {
+		u.logger.Errorw("Failed to get user skills", "userID", userID, "error", err)
+		return nil, err
+	}
+
+	if skills[userID] == nil {
+		return []string{}, nil
+	}
+	return skills[userID], nil
+}
@@ -0,0 +1,86 @@
+package user_usecase
+
+import (
+	"app/internal/domain"
+	"app/internal/mapper"
+	repositoryerrs "app/internal/repository/errs"
+	"app/internal/usecase/errs"
+	"app/pkg/txmanager"
+	"context"
+	"errors"
+)
+
+// GetUserTeams возвращает все команды пользователя, основная — первая.
+func (u *userUseCase) GetUserTeams(ctx context.Context, userID domain.UserID) ([]domain.TeamMembership, error) {
+	var memberships []domain.TeamMembership
+
+	if err := u.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadOnly,
+		func(ctx context.Context) error {
+			if _, err := u.userStorage.GetUserByID(ctx, userID); err != nil {
+				if errors.Is(err, repositoryerrs.ErrNotFound) {
+					u.logger.Errorw("User not found", "userID", userID)
+					return errs.ErrUserNotFound
+				}
+				u.logger.Errorw("Failed to get user by ID", "userID", userID, "error", err)
+				return err
+			}
+
+			teams, err := u.teamStorage.GetTeamsByUserID(ctx, userID)
+			if err != nil {
+				u.logger.Errorw("Failed to get teams by user ID", "userID", userID, "error", err)
+				return err
+			}
+
+			memberships = mapper.ModelsToDomainTeamMemberships(teams)
+			return nil
+		}); err != nil {
+		return nil, err
+	}
+
+	u.logger.Infow("Successfully retrieved user teams", "userID", userID, "count", len(memberships))
+
+	return memberships, nil
+}
+
+// SetPrimaryTeam делает teamName основной командой пользователя: из неё выбираются ревьюверы его PR
+// без явно указанной команды.
+func (u *userUseCase) SetPrimaryTeam(ctx context.Context, userID domain.UserID, teamName string) ([]domain.TeamMembership, error) {
+	var memberships []domain.TeamMembership
+
+	if err := u.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadWrite,
+		func(ctx context.Context) error {
+			team, err := u.teamStorage.GetTeamByName(ctx, teamName)
+			if err != nil {
+				if errors.Is(err, repositoryerrs.ErrNotFound) {
+					u.logger.Errorw("Team not found", "teamName", teamName)
+					return errs.ErrTeamNotFound
+				}
+				u.logger.Errorw("Failed to get team by name", "teamName", teamName, "error", err)
+				return err
+			}
+
+			if err := u.teamStorage.SetPrimaryTeam(ctx, userID, team.ID); err != nil {
+				if errors.Is(err, repositoryerrs.ErrNotFound) {
+					u.logger.Errorw("User is not a member of the team", "userID", userID, "teamName", teamName)
+					return errs.ErrUserNotInTeam
+				}
+				u.logger.Errorw("Failed to set primary team", "userID", userID, "teamName", teamName, "error", err)
+				return err
+			}
+
+			teams, err := u.teamStorage.GetTeamsByUserID(ctx, userID)
+			if err != nil {
+				u.logger.Errorw("Failed to get teams by user ID", "userID", userID, "error", err)
+				return err
+			}
+
+			memberships = mapper.ModelsToDomainTeamMemberships(teams)
+			return nil
+		}); err != nil {
+		return nil, err
+	}
+
+	u.logger.Infow("Successfully set primary team", "userID", userID, "teamName", teamName)
+
+	return memberships, nil
+}
@@ -6,6 +6,7 @@ import (
 	repositoryerrs "app/internal/repository/errs"
 	"app/internal/repository/storage"
 	"app/internal/usecase/errs"
+	"app/internal/usecase/pr_usecase"
 	"app/pkg/logger"
 	"app/pkg/txmanager"
 	"context"
@@ -15,18 +16,45 @@ import (
 type UserUseCase interface {
 	CreateUser(ctx context.Context, userID domain.UserID, name string) (*domain.User, error)
 	GetUserByID(ctx context.Context, userID domain.UserID) (*domain.User, error)
-	UpdateUserActivity(ctx context.Context, userID domain.UserID, isActive domain.UserActivityStatus) (*domain.User, error)
-	DeactivateUsersByTeamName(ctx context.Context, teamName string) error
+	UpdateUserActivity(ctx context.Context, userID domain.UserID, isActive domain.UserActivityStatus) (*domain.User, *domain.ReassignmentReport, error)
+	DeactivateUsersByTeamName(ctx context.Context, teamName string) (*domain.ReassignmentReport, error)
+	GetUserSkills(ctx context.Context, userID domain.UserID) ([]string, error)
+	AddUserSkills(ctx context.Context, userID domain.UserID, skills []string) ([]string, error)
+	RemoveUserSkills(ctx context.Context, userID domain.UserID, skills []string) ([]string, error)
+	GetUserTeams(ctx context.Context, userID domain.UserID) ([]domain.TeamMembership, error)
+	SetPrimaryTeam(ctx context.Context, userID domain.UserID, teamName string) ([]domain.TeamMembership, error)
+	ListUsers(ctx context.Context, filter domain.UserFilter) (*domain.UserPage, error)
+	UpdateUserName(ctx context.Context, userID domain.UserID, name string) (*domain.User, error)
+	DeleteUser(ctx context.Context, userID domain.UserID) (*domain.ReassignmentReport, error)
 }
 
 type userUseCase struct {
-	userStorage storage.UserStorage
-	teamStorage storage.TeamStorage
-	txmanager   txmanager.TxManager
-	logger      logger.Logger
+	userStorage    storage.UserStorage
+	teamStorage    storage.TeamStorage
+	txmanager      txmanager.TxManager
+	logger         logger.Logger
+	prUseCase      pr_usecase.PullRequestUseCase
+	reviewerFiller *pr_usecase.ReviewerFiller
 }
 
-func (u *userUseCase) DeactivateUsersByTeamName(ctx context.Context, teamName string) error {
+type Option func(u *userUseCase)
+
+// WithPullRequestUseCase включает переназначение OPEN ревью деактивированных пользователей
+// и пересчёт need_more_reviewers при смене активности.
+func WithPullRequestUseCase(prUseCase pr_usecase.PullRequestUseCase) Option {
+	return func(u *userUseCase) {
+		u.prUseCase = prUseCase
+	}
+}
+
+func WithReviewerFiller(filler *pr_usecase.ReviewerFiller) Option {
+	return func(u *userUseCase) {
+		u.reviewerFiller = filler
+	}
+}
+
+func (u *userUseCase) DeactivateUsersByTeamName(ctx context.Context, teamName string) (*domain.ReassignmentReport, error) {
+	report := &domain.ReassignmentReport{}
 
 	if err := u.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadWrite,
 		func(ctx context.Context) error {
@@ -59,26 +87,45 @@ func (u *userUseCase) DeactivateUsersByTeamName(ctx context.Context, teamName st
 					return err
 				}
 			}
+
+			// Переназначаем после деактивации всех, чтобы ревью не перешли к коллеге, которого тоже выключают.
+			if u.prUseCase != nil {
+				for _, usr := range user {
+					userReport, err := u.prUseCase.ReassignOpenReviews(ctx, usr.ID)
+					if err != nil {
+						u.logger.Errorw("Failed to reassign open reviews", "userID", usr.ID, "error", err)
+						return err
+					}
+					report.Reassigned = append(report.Reassigned, userReport.Reassigned...)
+					report.Unfilled = append(report.Unfilled, userReport.Unfilled...)
+				}
+			}
 			return nil
 
 		}); err != nil {
 		u.logger.Errorw("Transaction failed while deactivating users by team name", "teamName", teamName, "error", err)
-		return err
+		return nil, err
 	}
 
 	u.logger.Infow("Successfully deactivated users for the team", "teamName", teamName)
 
-	return nil
+	return report, nil
 }
 
 func NewUserUseCase(userStorage storage.UserStorage, txmanager txmanager.TxManager,
-	teamStorage storage.TeamStorage, logger logger.Logger) UserUseCase {
-	return &userUseCase{
+	teamStorage storage.TeamStorage, logger logger.Logger, opts ...Option) UserUseCase {
+	u := &userUseCase{
 		userStorage: userStorage,
 		teamStorage: teamStorage,
 		txmanager:   txmanager,
 		logger:      logger,
 	}
+
+	for _, opt := range opts {
+		opt(u)
+	}
+
+	return u
 }
 func (u *userUseCase) CreateUser(ctx context.Context, userID domain.UserID, name string) (*domain.User, error) {
 	var user domain.User
@@ -146,8 +193,9 @@ func (u *userUseCase) GetUserByID(ctx context.Context, userID domain.UserID) (*d
 	return &user, nil
 }
 
-func (u *userUseCase) UpdateUserActivity(ctx context.Context, userID domain.UserID, isActive domain.UserActivityStatus) (*domain.User, error) {
+func (u *userUseCase) UpdateUserActivity(ctx context.Context, userID domain.UserID, isActive domain.UserActivityStatus) (*domain.User, *domain.ReassignmentReport, error) {
 	var updatedUser domain.User
+	report := &domain.ReassignmentReport{}
 
 	if err := u.txmanager.WithTx(ctx, txmanager.IsolationLevelReadCommitted, txmanager.AccessModeReadWrite,
 		func(ctx context.Context) error {
@@ -167,6 +215,21 @@ func (u *userUseCase) UpdateUserActivity(ctx context.Context, userID domain.User
 				return err
 			}
 
+			if u.prUseCase != nil && !isActive.IsActive() {
+				report, err = u.prUseCase.ReassignOpenReviews(ctx, user.ID)
+				if err != nil {
+					u.logger.Errorw("Failed to reassign open reviews", "userID", userID, "error", err)
+					return err
+				}
+			}
+
+			if u.prUseCase != nil && isActive.IsActive() {
+				if err := u.prUseCase.RefreshNeedMoreReviewers(ctx, user.ID); err != nil {
+					u.logger.Errorw("Failed to refresh need more reviewers", "userID", userID, "error", err)
+					return err
+				}
+			}
+
 			newUser := user
 			newUser.StatusActivity = isActive.IsActive()
 
@@ -175,10 +238,14 @@ func (u *userUseCase) UpdateUserActivity(ctx context.Context, userID domain.User
 			return nil
 		}); err != nil {
 		u.logger.Errorw("Transaction failed while updating user activity", "userID", userID, "error", err)
-		return nil, err
+		return nil, nil, err
 	}
 
 	u.logger.Infow("Successfully updated user activity", "userID", userID, "isActive", isActive)
 
-	return &updatedUser, nil
+	if isActive.IsActive() && u.reviewerFiller != nil {
+		u.reviewerFiller.Notify()
+	}
+
+	return &updatedUser, report, nil
 }
@@ -6,6 +6,7 @@ import (
 	"app/internal/repository/models"
 	"app/internal/repository/storage/mock"
 	"app/internal/usecase/errs"
+	prmock "app/internal/usecase/pr_usecase/mock"
 	loggermock "app/pkg/logger/mock"
 	txmock "app/pkg/txmanager/mock"
 	"context"
@@ -43,7 +44,7 @@ func TestDeactivateUsers_TeamNotFound(t *testing.T) {
 			GetTeamByName(ctx, teamName).
 			Return(nil, repoerrors.ErrNotFound)
 
-		err := uc.DeactivateUsersByTeamName(ctx, teamName)
+		_, err := uc.DeactivateUsersByTeamName(ctx, teamName)
 
 		So(err, ShouldEqual, errs.ErrTeamNotFound)
 	})
@@ -81,7 +82,61 @@ func TestDeactivateUsers_NoUsersInTeam(t *testing.T) {
 			GetUsersByTeam(ctx, teamID).
 			Return([]models.User{}, nil)
 
-		err := uc.DeactivateUsersByTeamName(ctx, teamName)
+		_, err := uc.DeactivateUsersByTeamName(ctx, teamName)
 		So(err, ShouldEqual, errs.ErrNoUsersInTeam)
 	})
-}
\ No newline at end of file
+}
+
+func TestDeactivateUsers_ReassignsOpenReviews(t *testing.T) {
+	Convey("DeactivateUsers collects reassignment report for every user", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := loggermock.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		prUseCase := prmock.NewMockPullRequestUseCase(ctrl)
+		txmock := txmock.NewMockTxManager(ctrl)
+		uc := NewUserUseCase(userStorage, txmock, teamStorage, mockLog, WithPullRequestUseCase(prUseCase))
+		ctx := context.Background()
+
+		teamName := "exampleTeam"
+
+		txmock.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, a, b any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		teamStorage.EXPECT().
+			GetTeamByName(ctx, teamName).
+			Return(&models.Team{ID: 1, TeamName: teamName}, nil)
+
+		teamStorage.EXPECT().
+			GetUsersByTeam(ctx, domain.TeamID(1)).
+			Return([]models.User{{ID: "u1"}, {ID: "u2"}}, nil)
+
+		userStorage.EXPECT().UpdateActivity(ctx, domain.UserID("u1"), domain.UserStatusInactive).Return(nil)
+		userStorage.EXPECT().UpdateActivity(ctx, domain.UserID("u2"), domain.UserStatusInactive).Return(nil)
+
+		prUseCase.EXPECT().
+			ReassignOpenReviews(ctx, domain.UserID("u1")).
+			Return(&domain.ReassignmentReport{
+				Unfilled: []domain.ReviewerReassignment{{PullRequestID: "p1", OldReviewerID: "u1"}},
+			}, nil)
+		prUseCase.EXPECT().
+			ReassignOpenReviews(ctx, domain.UserID("u2")).
+			Return(&domain.ReassignmentReport{
+				Unfilled: []domain.ReviewerReassignment{{PullRequestID: "p2", OldReviewerID: "u2"}},
+			}, nil)
+
+		report, err := uc.DeactivateUsersByTeamName(ctx, teamName)
+
+		So(err, ShouldBeNil)
+		So(report.Reassigned, ShouldBeEmpty)
+		So(report.Unfilled, ShouldHaveLength, 2)
+	})
+}
@@ -0,0 +1,201 @@
+package user_usecase
+
+import (
+	"app/internal/domain"
+	repoerrors "app/internal/repository/errs"
+	"app/internal/repository/models"
+	"app/internal/repository/storage/mock"
+	"app/internal/usecase/errs"
+	prmock "app/internal/usecase/pr_usecase/mock"
+	loggermock "app/pkg/logger/mock"
+	txmock "app/pkg/txmanager/mock"
+	"context"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListUsers_Pagination(t *testing.T) {
+	Convey("ListUsers filters by team and returns cursor of the last user on a full page", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := loggermock.NewMockLogger(ctrl)
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		txmock := txmock.NewMockTxManager(ctrl)
+		uc := NewUserUseCase(userStorage, txmock, teamStorage, mockLog)
+		ctx := context.Background()
+
+		txmock.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, a, b any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		teamName := "backend"
+		isActive := true
+		teamID := domain.TeamID(1)
+
+		teamStorage.EXPECT().GetTeamByName(ctx, teamName).Return(&models.Team{ID: teamID, TeamName: teamName}, nil)
+		userStorage.EXPECT().
+			ListUsers(ctx, &teamID, &isActive, nil, 3).
+			Return([]models.User{
+				{ID: "u1", Name: "Alice", StatusActivity: true},
+				{ID: "u2", Name: "Bob", StatusActivity: true},
+				{ID: "u3", Name: "Carol", StatusActivity: true},
+			}, nil)
+
+		page, err := uc.ListUsers(ctx, domain.UserFilter{TeamName: &teamName, IsActive: &isActive, Limit: 2})
+
+		So(err, ShouldBeNil)
+		So(page.Users, ShouldHaveLength, 2)
+		So(page.Users[1].ID, ShouldEqual, domain.UserID("u2"))
+		So(page.NextCursor, ShouldNotBeNil)
+		So(*page.NextCursor, ShouldEqual, domain.UserID("u2"))
+	})
+}
+
+func TestListUsers_InvalidLimit(t *testing.T) {
+	Convey("ListUsers rejects limit above maximum", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := loggermock.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		uc := NewUserUseCase(mock.NewMockUserStorage(ctrl), txmock.NewMockTxManager(ctrl), mock.NewMockTeamStorage(ctrl), mockLog)
+
+		page, err := uc.ListUsers(context.Background(), domain.UserFilter{Limit: maxPageLimit + 1})
+
+		So(page, ShouldBeNil)
+		So(err, ShouldEqual, errs.ErrInvalidUserFilter)
+	})
+}
+
+func TestUpdateUserName(t *testing.T) {
+	Convey("UpdateUserName", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := loggermock.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		userStorage := mock.NewMockUserStorage(ctrl)
+		txmock := txmock.NewMockTxManager(ctrl)
+		uc := NewUserUseCase(userStorage, txmock, mock.NewMockTeamStorage(ctrl), mockLog)
+		ctx := context.Background()
+
+		Convey("empty name is rejected", func() {
+			user, err := uc.UpdateUserName(ctx, "u1", "")
+
+			So(user, ShouldBeNil)
+			So(err, ShouldEqual, errs.ErrInvalidUserName)
+		})
+
+		txmock.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, a, b any, fn func(context.Context) error) error {
+				return fn(ctx)
+			}).AnyTimes()
+
+		Convey("user is renamed", func() {
+			userStorage.EXPECT().GetUserByID(ctx, domain.UserID("u1")).Return(&models.User{ID: "u1", Name: "Alice", StatusActivity: true}, nil)
+			userStorage.EXPECT().UpdateName(ctx, domain.UserID("u1"), "Alice Smith").Return(nil)
+
+			user, err := uc.UpdateUserName(ctx, "u1", "Alice Smith")
+
+			So(err, ShouldBeNil)
+			So(user.Name, ShouldEqual, "Alice Smith")
+			So(user.IsActive, ShouldEqual, domain.UserStatusActive)
+		})
+
+		Convey("unknown user", func() {
+			userStorage.EXPECT().GetUserByID(ctx, domain.UserID("ghost")).Return(nil, repoerrors.ErrNotFound)
+
+			user, err := uc.UpdateUserName(ctx, "ghost", "Ghost")
+
+			So(user, ShouldBeNil)
+			So(err, ShouldEqual, errs.ErrUserNotFound)
+		})
+	})
+}
+
+func TestDeleteUser_Success(t *testing.T) {
+	Convey("DeleteUser marks user deleted, reassigns open reviews and leaves all teams", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := loggermock.NewMockLogger(ctrl)
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		txmock := txmock.NewMockTxManager(ctrl)
+		prUseCase := prmock.NewMockPullRequestUseCase(ctrl)
+		uc := NewUserUseCase(userStorage, txmock, teamStorage, mockLog, WithPullRequestUseCase(prUseCase))
+		ctx := context.Background()
+
+		txmock.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, a, b any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		newReviewer := domain.UserID("u3")
+
+		gomock.InOrder(
+			userStorage.EXPECT().SoftDeleteUser(ctx, domain.UserID("u2")).Return(nil),
+			prUseCase.EXPECT().
+				ReassignOpenReviews(ctx, domain.UserID("u2")).
+				Return(&domain.ReassignmentReport{
+					Reassigned: []domain.ReviewerReassignment{{PullRequestID: "p1", OldReviewerID: "u2", NewReviewerID: &newReviewer}},
+				}, nil),
+			teamStorage.EXPECT().
+				GetTeamsByUserID(ctx, domain.UserID("u2")).
+				Return([]models.TeamMembership{
+					{TeamID: 1, TeamName: "backend", IsPrimary: true},
+					{TeamID: 2, TeamName: "platform"},
+				}, nil),
+			teamStorage.EXPECT().DeleteUserTeamInstance(ctx, domain.TeamID(1), domain.UserID("u2")).Return(nil),
+			teamStorage.EXPECT().DeleteUserTeamInstance(ctx, domain.TeamID(2), domain.UserID("u2")).Return(nil),
+		)
+
+		report, err := uc.DeleteUser(ctx, "u2")
+
+		So(err, ShouldBeNil)
+		So(report.Reassigned, ShouldHaveLength, 1)
+	})
+}
+
+func TestDeleteUser_NotFound(t *testing.T) {
+	Convey("DeleteUser fails for unknown or already deleted user", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := loggermock.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		userStorage := mock.NewMockUserStorage(ctrl)
+		txmock := txmock.NewMockTxManager(ctrl)
+		uc := NewUserUseCase(userStorage, txmock, mock.NewMockTeamStorage(ctrl), mockLog)
+		ctx := context.Background()
+
+		txmock.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, a, b any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		userStorage.EXPECT().SoftDeleteUser(ctx, domain.UserID("ghost")).Return(repoerrors.ErrNotFound)
+
+		report, err := uc.DeleteUser(ctx, "ghost")
+
+		So(report, ShouldBeNil)
+		So(err, ShouldEqual, errs.ErrUserNotFound)
+	})
+}
@@ -0,0 +1,139 @@
+package user_usecase
+
+import (
+	"app/internal/domain"
+	repoerrors "app/internal/repository/errs"
+	"app/internal/repository/models"
+	"app/internal/repository/storage/mock"
+	"app/internal/usecase/errs"
+	loggermock "app/pkg/logger/mock"
+	txmock "app/pkg/txmanager/mock"
+	"context"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAddUserSkills_InvalidSkill(t *testing.T) {
+	Convey("AddUserSkills rejects empty and malformed tags", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := loggermock.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		txmock := txmock.NewMockTxManager(ctrl)
+		uc := NewUserUseCase(userStorage, txmock, teamStorage, mockLog)
+		ctx := context.Background()
+
+		_, err := uc.AddUserSkills(ctx, "u1", nil)
+		So(err, ShouldEqual, errs.ErrInvalidSkill)
+
+		_, err = uc.AddUserSkills(ctx, "u1", []string{"go", " "})
+		So(err, ShouldEqual, errs.ErrInvalidSkill)
+	})
+}
+
+func TestAddUserSkills_UserNotFound(t *testing.T) {
+	Convey("AddUserSkills user not found", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := loggermock.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		txmock := txmock.NewMockTxManager(ctrl)
+		uc := NewUserUseCase(userStorage, txmock, teamStorage, mockLog)
+		ctx := context.Background()
+
+		txmock.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, a, b any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		userStorage.EXPECT().
+			AddUserSkills(ctx, domain.UserID("u1"), []string{"go"}).
+			Return(repoerrors.ErrNotFound)
+
+		_, err := uc.AddUserSkills(ctx, "u1", []string{"Go"})
+
+		So(err, ShouldEqual, errs.ErrUserNotFound)
+	})
+}
+
+func TestAddUserSkills_Success(t *testing.T) {
+	Convey("AddUserSkills normalizes tags and returns the full skill list", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := loggermock.NewMockLogger(ctrl)
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		txmock := txmock.NewMockTxManager(ctrl)
+		uc := NewUserUseCase(userStorage, txmock, teamStorage, mockLog)
+		ctx := context.Background()
+
+		userID := domain.UserID("u1")
+
+		txmock.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, a, b any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		userStorage.EXPECT().
+			AddUserSkills(ctx, userID, []string{"sql", "go"}).
+			Return(nil)
+
+		userStorage.EXPECT().
+			GetUserByID(ctx, userID).
+			Return(&models.User{ID: userID}, nil)
+
+		userStorage.EXPECT().
+			GetSkillsByUserIDs(ctx, []domain.UserID{userID}).
+			Return(map[domain.UserID][]string{userID: {"frontend", "go", "sql"}}, nil)
+
+		skills, err := uc.AddUserSkills(ctx, userID, []string{" SQL", "go", "sql"})
+
+		So(err, ShouldBeNil)
+		So(skills, ShouldResemble, []string{"frontend", "go", "sql"})
+	})
+}
+
+func TestRemoveUserSkills_UserNotFound(t *testing.T) {
+	Convey("RemoveUserSkills user not found", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := loggermock.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		txmock := txmock.NewMockTxManager(ctrl)
+		uc := NewUserUseCase(userStorage, txmock, teamStorage, mockLog)
+		ctx := context.Background()
+
+		txmock.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, a, b any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		userStorage.EXPECT().
+			GetUserByID(ctx, domain.UserID("u1")).
+			Return(nil, repoerrors.ErrNotFound)
+
+		_, err := uc.RemoveUserSkills(ctx, "u1", []string{"go"})
+
+		So(err, ShouldEqual, errs.ErrUserNotFound)
+	})
+}
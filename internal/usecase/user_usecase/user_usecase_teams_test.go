@@ -0,0 +1,84 @@
+package user_usecase
+
+import (
+	"app/internal/domain"
+	repoerrors "app/internal/repository/errs"
+	"app/internal/repository/models"
+	"app/internal/repository/storage/mock"
+	"app/internal/usecase/errs"
+	loggermock "app/pkg/logger/mock"
+	txmock "app/pkg/txmanager/mock"
+	"context"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetUserTeams_Success(t *testing.T) {
+	Convey("GetUserTeams returns all memberships with primary first", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := loggermock.NewMockLogger(ctrl)
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		txmock := txmock.NewMockTxManager(ctrl)
+		uc := NewUserUseCase(userStorage, txmock, teamStorage, mockLog)
+		ctx := context.Background()
+
+		txmock.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, a, b any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		userStorage.EXPECT().GetUserByID(ctx, domain.UserID("u1")).Return(&models.User{ID: "u1"}, nil)
+		teamStorage.EXPECT().
+			GetTeamsByUserID(ctx, domain.UserID("u1")).
+			Return([]models.TeamMembership{
+				{TeamID: 1, TeamName: "backend", IsPrimary: true},
+				{TeamID: 2, TeamName: "platform"},
+			}, nil)
+
+		teams, err := uc.GetUserTeams(ctx, "u1")
+
+		So(err, ShouldBeNil)
+		So(teams, ShouldHaveLength, 2)
+		So(teams[0].TeamName, ShouldEqual, "backend")
+		So(teams[0].IsPrimary, ShouldBeTrue)
+		So(teams[1].IsPrimary, ShouldBeFalse)
+	})
+}
+
+func TestSetPrimaryTeam_NotMember(t *testing.T) {
+	Convey("SetPrimaryTeam rejects team the user is not a member of", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := loggermock.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		userStorage := mock.NewMockUserStorage(ctrl)
+		txmock := txmock.NewMockTxManager(ctrl)
+		uc := NewUserUseCase(userStorage, txmock, teamStorage, mockLog)
+		ctx := context.Background()
+
+		txmock.EXPECT().
+			WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, a, b any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		teamStorage.EXPECT().GetTeamByName(ctx, "platform").Return(&models.Team{ID: 2, TeamName: "platform"}, nil)
+		teamStorage.EXPECT().SetPrimaryTeam(ctx, domain.UserID("u1"), domain.TeamID(2)).Return(repoerrors.ErrNotFound)
+
+		teams, err := uc.SetPrimaryTeam(ctx, "u1", "platform")
+
+		So(teams, ShouldBeNil)
+		So(err, ShouldEqual, errs.ErrUserNotInTeam)
+	})
+}
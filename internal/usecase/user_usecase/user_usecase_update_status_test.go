@@ -6,6 +6,7 @@ import (
 	"app/internal/repository/models"
 	"app/internal/repository/storage/mock"
 	"app/internal/usecase/errs"
+	prmock "app/internal/usecase/pr_usecase/mock"
 	loggermock "app/pkg/logger/mock"
 	txmock "app/pkg/txmanager/mock"
 	"context"
@@ -48,7 +49,7 @@ func TestUserUseCase_UpdateUserActivity_SuccessTrue(t *testing.T) {
 			UpdateActivity(ctx, userID, userActivity).
 			Return(nil)
 
-		_, err := uc.UpdateUserActivity(ctx, userID, domain.UserStatusActive)
+		_, _, err := uc.UpdateUserActivity(ctx, userID, domain.UserStatusActive)
 
 		So(err, ShouldBeNil)
 	})
@@ -81,7 +82,7 @@ func TestUserUseCase_UpdateUserActivity_NotFound(t *testing.T) {
 			GetUserByID(ctx, userID).
 			Return(nil, repoerrors.ErrNotFound)
 
-		_, err := uc.UpdateUserActivity(ctx, userID, userActivity)
+		_, _, err := uc.UpdateUserActivity(ctx, userID, userActivity)
 
 		So(err, ShouldEqual, errs.ErrUserNotFound)
 	})
@@ -118,8 +119,140 @@ func TestUserUseCase_UpdateUserActivity_UpdateFails(t *testing.T) {
 			UpdateActivity(ctx, userID, userActivity).
 			Return(errors.New("update error"))
 
-		_, err := uc.UpdateUserActivity(ctx, userID, userActivity)
+		_, _, err := uc.UpdateUserActivity(ctx, userID, userActivity)
 
 		So(err.Error(), ShouldEqual, "update error")
 	})
 }
+
+func TestUserUseCase_UpdateUserActivity_ReassignsOpenReviews(t *testing.T) {
+	Convey("UpdateUserActivity reassigns open reviews of deactivated user", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := loggermock.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		userStorage := mock.NewMockUserStorage(ctrl)
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		prUseCase := prmock.NewMockPullRequestUseCase(ctrl)
+		tx := txmock.NewMockTxManager(ctrl)
+		uc := NewUserUseCase(userStorage, tx, teamStorage, mockLog, WithPullRequestUseCase(prUseCase))
+		ctx := context.Background()
+
+		tx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, a, b any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		userID := domain.UserID("u1")
+
+		userStorage.EXPECT().
+			GetUserByID(ctx, userID).
+			Return(&models.User{ID: userID, StatusActivity: true}, nil)
+
+		userStorage.EXPECT().
+			UpdateActivity(ctx, userID, domain.UserStatusInactive).
+			Return(nil)
+
+		newReviewerID := domain.UserID("u3")
+		prUseCase.EXPECT().
+			ReassignOpenReviews(ctx, userID).
+			Return(&domain.ReassignmentReport{
+				Reassigned: []domain.ReviewerReassignment{{PullRequestID: "p1", OldReviewerID: userID, NewReviewerID: &newReviewerID}},
+				Unfilled:   []domain.ReviewerReassignment{{PullRequestID: "p2", OldReviewerID: userID}},
+			}, nil)
+
+		user, report, err := uc.UpdateUserActivity(ctx, userID, domain.UserStatusInactive)
+
+		So(err, ShouldBeNil)
+		So(user.IsActive.IsActive(), ShouldBeFalse)
+		So(report.Reassigned, ShouldHaveLength, 1)
+		So(*report.Reassigned[0].NewReviewerID, ShouldEqual, newReviewerID)
+		So(report.Unfilled[0].PullRequestID, ShouldEqual, domain.PRID("p2"))
+	})
+}
+
+func TestUserUseCase_UpdateUserActivity_ReassignFails(t *testing.T) {
+	Convey("UpdateUserActivity fails when open reviews cannot be reassigned", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := loggermock.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+
+		userStorage := mock.NewMockUserStorage(ctrl)
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		prUseCase := prmock.NewMockPullRequestUseCase(ctrl)
+		tx := txmock.NewMockTxManager(ctrl)
+		uc := NewUserUseCase(userStorage, tx, teamStorage, mockLog, WithPullRequestUseCase(prUseCase))
+		ctx := context.Background()
+
+		tx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, a, b any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		userID := domain.UserID("u1")
+
+		userStorage.EXPECT().
+			GetUserByID(ctx, userID).
+			Return(&models.User{ID: userID, StatusActivity: true}, nil)
+
+		userStorage.EXPECT().
+			UpdateActivity(ctx, userID, domain.UserStatusInactive).
+			Return(nil)
+
+		prUseCase.EXPECT().
+			ReassignOpenReviews(ctx, userID).
+			Return(nil, errors.New("database error"))
+
+		user, _, err := uc.UpdateUserActivity(ctx, userID, domain.UserStatusInactive)
+
+		So(user, ShouldBeNil)
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func TestUserUseCase_UpdateUserActivity_ActivationRefreshesNeedMoreReviewers(t *testing.T) {
+	Convey("UpdateUserActivity refreshes need_more_reviewers when user comes back", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockLog := loggermock.NewMockLogger(ctrl)
+		mockLog.EXPECT().Errorw(gomock.Any(), gomock.Any()).AnyTimes()
+		mockLog.EXPECT().Infow(gomock.Any(), gomock.Any()).AnyTimes()
+
+		userStorage := mock.NewMockUserStorage(ctrl)
+		teamStorage := mock.NewMockTeamStorage(ctrl)
+		prUseCase := prmock.NewMockPullRequestUseCase(ctrl)
+		tx := txmock.NewMockTxManager(ctrl)
+		uc := NewUserUseCase(userStorage, tx, teamStorage, mockLog, WithPullRequestUseCase(prUseCase))
+		ctx := context.Background()
+
+		tx.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, a, b any, fn func(context.Context) error) error {
+				return fn(ctx)
+			})
+
+		userID := domain.UserID("u1")
+
+		userStorage.EXPECT().
+			GetUserByID(ctx, userID).
+			Return(&models.User{ID: userID}, nil)
+
+		userStorage.EXPECT().
+			UpdateActivity(ctx, userID, domain.UserStatusActive).
+			Return(nil)
+
+		prUseCase.EXPECT().
+			RefreshNeedMoreReviewers(ctx, userID).
+			Return(nil)
+
+		_, report, err := uc.UpdateUserActivity(ctx, userID, domain.UserStatusActive)
+
+		So(err, ShouldBeNil)
+		So(report.Reassigned, ShouldBeEmpty)
+	})
+}
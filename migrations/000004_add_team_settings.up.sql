@@ -0,0 +1,5 @@
+ALTER TABLE teams
+    ADD COLUMN min_reviewers INT NOT NULL DEFAULT 2,
+    ADD COLUMN max_reviewers INT NOT NULL DEFAULT 2,
+    ADD COLUMN reviewer_strategy VARCHAR(32),
+    ADD CONSTRAINT chk_teams_reviewers_range CHECK (min_reviewers >= 0 AND max_reviewers >= 1 AND max_reviewers >= min_reviewers);
@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS idx_pr_author ON pull_requests (author_id, created_at, id);
@@ -0,0 +1,3 @@
+ALTER TABLE pull_requests
+    DROP COLUMN IF EXISTS merged_by,
+    ALTER COLUMN merged_at SET DEFAULT CURRENT_TIMESTAMP;
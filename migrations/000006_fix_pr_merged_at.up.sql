@@ -0,0 +1,5 @@
+ALTER TABLE pull_requests
+    ALTER COLUMN merged_at DROP DEFAULT,
+    ADD COLUMN merged_by VARCHAR(255) REFERENCES users(id) ON DELETE SET NULL;
+
+UPDATE pull_requests SET merged_at = NULL WHERE status = 'OPEN';
@@ -0,0 +1,14 @@
+UPDATE pull_requests SET status = 'OPEN' WHERE status = 'DRAFT';
+
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS ready_at;
+
+ALTER TYPE pr_status RENAME TO pr_status_old;
+
+CREATE TYPE pr_status AS ENUM ('OPEN', 'MERGED', 'CLOSED');
+
+ALTER TABLE pull_requests
+    ALTER COLUMN status DROP DEFAULT,
+    ALTER COLUMN status TYPE pr_status USING status::text::pr_status,
+    ALTER COLUMN status SET DEFAULT 'OPEN';
+
+DROP TYPE pr_status_old;
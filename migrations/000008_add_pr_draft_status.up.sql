@@ -0,0 +1,5 @@
+ALTER TYPE pr_status ADD VALUE IF NOT EXISTS 'DRAFT';
+
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS ready_at TIMESTAMP NULL;
+
+UPDATE pull_requests SET ready_at = created_at WHERE ready_at IS NULL;
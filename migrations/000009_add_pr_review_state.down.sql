@@ -0,0 +1,5 @@
+ALTER TABLE pr_reviewers
+    DROP COLUMN IF EXISTS reviewed_at,
+    DROP COLUMN IF EXISTS review_state;
+
+DROP TYPE IF EXISTS review_state;
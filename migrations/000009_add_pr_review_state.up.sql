@@ -0,0 +1,5 @@
+CREATE TYPE review_state AS ENUM ('PENDING', 'APPROVED', 'CHANGES_REQUESTED');
+
+ALTER TABLE pr_reviewers
+    ADD COLUMN review_state review_state NOT NULL DEFAULT 'PENDING',
+    ADD COLUMN reviewed_at TIMESTAMP NULL;
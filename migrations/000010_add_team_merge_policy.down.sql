@@ -0,0 +1,6 @@
+ALTER TABLE teams
+    DROP CONSTRAINT IF EXISTS chk_teams_merge_policy,
+    DROP COLUMN IF EXISTS min_pr_age_seconds,
+    DROP COLUMN IF EXISTS forbid_self_merge,
+    DROP COLUMN IF EXISTS require_all_responded,
+    DROP COLUMN IF EXISTS min_approvals;
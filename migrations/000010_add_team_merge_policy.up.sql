@@ -0,0 +1,6 @@
+ALTER TABLE teams
+    ADD COLUMN min_approvals INT NOT NULL DEFAULT 0,
+    ADD COLUMN require_all_responded BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN forbid_self_merge BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN min_pr_age_seconds INT NOT NULL DEFAULT 0,
+    ADD CONSTRAINT chk_teams_merge_policy CHECK (min_approvals >= 0 AND min_pr_age_seconds >= 0);
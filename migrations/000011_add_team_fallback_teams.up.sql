@@ -0,0 +1,7 @@
+CREATE TABLE team_fallback_teams (
+    team_id BIGINT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    fallback_team_id BIGINT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    priority INT NOT NULL,
+    PRIMARY KEY (team_id, fallback_team_id),
+    CONSTRAINT chk_team_fallback_not_self CHECK (team_id <> fallback_team_id)
+);
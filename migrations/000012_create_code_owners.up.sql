@@ -0,0 +1,11 @@
+CREATE TABLE code_owners (
+    id BIGSERIAL PRIMARY KEY,
+    pattern VARCHAR(255) NOT NULL,
+    owner_user_id VARCHAR(255) REFERENCES users(id) ON DELETE CASCADE,
+    owner_team_id BIGINT REFERENCES teams(id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_code_owners_single_owner CHECK ((owner_user_id IS NULL) <> (owner_team_id IS NULL))
+);
+
+CREATE UNIQUE INDEX uq_code_owners_pattern_user ON code_owners (pattern, owner_user_id) WHERE owner_user_id IS NOT NULL;
+CREATE UNIQUE INDEX uq_code_owners_pattern_team ON code_owners (pattern, owner_team_id) WHERE owner_team_id IS NOT NULL;
@@ -0,0 +1,7 @@
+CREATE TABLE user_skills (
+    user_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    skill VARCHAR(64) NOT NULL,
+    PRIMARY KEY (user_id, skill)
+);
+
+CREATE INDEX idx_user_skills_skill ON user_skills(skill);
@@ -0,0 +1,9 @@
+ALTER TABLE pull_requests
+    DROP COLUMN IF EXISTS team_id;
+
+DROP INDEX IF EXISTS idx_user_teams_user_id;
+
+DROP INDEX IF EXISTS ux_user_teams_primary;
+
+ALTER TABLE user_teams
+    DROP COLUMN IF EXISTS is_primary;
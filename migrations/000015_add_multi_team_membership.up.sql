@@ -0,0 +1,19 @@
+ALTER TABLE user_teams
+    ADD COLUMN is_primary BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Основной становится самая ранняя команда пользователя.
+UPDATE user_teams ut
+SET is_primary = TRUE
+FROM (
+    SELECT DISTINCT ON (user_id) user_id, team_id
+    FROM user_teams
+    ORDER BY user_id, joined_at, team_id
+) earliest
+WHERE ut.user_id = earliest.user_id AND ut.team_id = earliest.team_id;
+
+CREATE UNIQUE INDEX ux_user_teams_primary ON user_teams(user_id) WHERE is_primary;
+
+CREATE INDEX idx_user_teams_user_id ON user_teams(user_id);
+
+ALTER TABLE pull_requests
+    ADD COLUMN team_id BIGINT REFERENCES teams(id) ON DELETE SET NULL;
@@ -0,0 +1,2 @@
+ALTER TABLE pr_reviewers
+    ADD COLUMN source_team_id BIGINT NULL REFERENCES teams(id) ON DELETE SET NULL;
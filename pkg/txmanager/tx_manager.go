@@ -27,6 +27,9 @@ var (
 	AccessModeReadOnly  = pgx.ReadOnly
 )
 
+// ErrReadWriteInReadOnlyTx возвращается, если внутри read-only транзакции запрошена пишущая.
+var ErrReadWriteInReadOnlyTx = errors.New("read-write transaction requested inside read-only transaction")
+
 type txKey struct{}
 
 type Transactor struct {
@@ -73,6 +76,18 @@ func extractTx(ctx context.Context) pgx.Tx {
 }
 
 func (t *Transactor) WithTx(ctx context.Context, isoLevel pgx.TxIsoLevel, accessMode pgx.TxAccessMode, fn func(ctx context.Context) error) (err error) {
+	// Вложенный вызов выполняется во внешней транзакции, чтобы юзкейсы можно было комбинировать.
+	// Уровень изоляции наследуется от внешней, а запись внутри read-only транзакции запрещена.
+	if extractTx(ctx) != nil {
+		if mode, ok := extractAccessMode(ctx); ok && mode == AccessModeReadOnly && accessMode != AccessModeReadOnly {
+			t.logger.Errorw("Read-write transaction requested inside read-only transaction",
+				"isoLevel", isoLevel,
+			)
+			return ErrReadWriteInReadOnlyTx
+		}
+		return fn(ctx)
+	}
+
 	opts := pgx.TxOptions{
 		IsoLevel:   isoLevel,
 		AccessMode: accessMode,
@@ -100,7 +115,7 @@ func (t *Transactor) WithTx(ctx context.Context, isoLevel pgx.TxIsoLevel, access
 		}
 	}()
 
-	ctx = injectTx(ctx, tx)
+	ctx = injectAccessMode(injectTx(ctx, tx), accessMode)
 
 	if err = fn(ctx); err != nil {
 		return err
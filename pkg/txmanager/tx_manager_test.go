@@ -0,0 +1,62 @@
+package txmanager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// fakeTx подставляется как уже открытая внешняя транзакция; вложенный WithTx не должен её трогать.
+type fakeTx struct {
+	pgx.Tx
+}
+
+func TestWithTx_Nested(t *testing.T) {
+	Convey("Nested WithTx runs inside the outer transaction", t, func() {
+		transactor := NewTransactor(nil, nil)
+		outer := &fakeTx{}
+
+		nested := func(outerMode, innerMode pgx.TxAccessMode) (bool, error) {
+			ctx := injectAccessMode(injectTx(context.Background(), outer), outerMode)
+
+			called := false
+			err := transactor.WithTx(ctx, IsolationLevelReadCommitted, innerMode, func(ctx context.Context) error {
+				called = true
+				So(extractTx(ctx), ShouldEqual, outer)
+				So(transactor.GetExecutor(ctx), ShouldEqual, outer)
+				return nil
+			})
+			return called, err
+		}
+
+		Convey("read-write inside read-only is rejected", func() {
+			called, err := nested(AccessModeReadOnly, AccessModeReadWrite)
+
+			So(err, ShouldEqual, ErrReadWriteInReadOnlyTx)
+			So(called, ShouldBeFalse)
+		})
+
+		Convey("read-only inside read-only reuses the transaction", func() {
+			called, err := nested(AccessModeReadOnly, AccessModeReadOnly)
+
+			So(err, ShouldBeNil)
+			So(called, ShouldBeTrue)
+		})
+
+		Convey("read-only inside read-write reuses the transaction", func() {
+			called, err := nested(AccessModeReadWrite, AccessModeReadOnly)
+
+			So(err, ShouldBeNil)
+			So(called, ShouldBeTrue)
+		})
+
+		Convey("read-write inside read-write reuses the transaction", func() {
+			called, err := nested(AccessModeReadWrite, AccessModeReadWrite)
+
+			So(err, ShouldBeNil)
+			So(called, ShouldBeTrue)
+		})
+	})
+}
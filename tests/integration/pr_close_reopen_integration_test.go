@@ -0,0 +1,55 @@
+package integration_test
+
+import (
+	"app/internal/domain"
+	"app/internal/usecase/errs"
+	"context"
+)
+
+func (s *TestSuite) Test_CloseReopenPR_Integration() {
+	ctx := context.TODO()
+	authorID := domain.UserID("close-author")
+
+	_, err := s.teamUseCase.CreateTeam(ctx, "close-team", []domain.TeamUser{
+		{ID: authorID, Name: "Author"},
+		{ID: "close-reviewer-1", Name: "Reviewer 1"},
+		{ID: "close-reviewer-2", Name: "Reviewer 2"},
+	})
+	s.Require().NoError(err)
+
+	created, err := s.prUseCase.CreatePR(ctx, authorID, "close-pr", "Close PR", domain.CreatePullRequestOptions{})
+	s.Require().NoError(err)
+	s.Require().NotEmpty(created.Reviewers)
+
+	reviewerIDs := make([]domain.UserID, 0, len(created.Reviewers))
+	for _, reviewer := range created.Reviewers {
+		reviewerIDs = append(reviewerIDs, reviewer.ID)
+	}
+
+	closed, err := s.prUseCase.ClosePR(ctx, created.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(domain.PRStatusClosed, closed.Status)
+	s.Require().Len(closed.Reviewers, len(created.Reviewers))
+
+	// Закрытый PR не учитывается в нагрузке ревьюверов
+	loads, err := s.prStorage.CountOpenReviewsByReviewerIDs(ctx, reviewerIDs)
+	s.Require().NoError(err)
+	for _, id := range reviewerIDs {
+		s.Require().Zero(loads[id])
+	}
+
+	_, err = s.prUseCase.MergePR(ctx, created.ID, nil)
+	s.Require().ErrorIs(err, errs.ErrPullRequestClosed)
+
+	reopened, err := s.prUseCase.ReopenPR(ctx, created.ID, true)
+	s.Require().NoError(err)
+	s.Require().Equal(domain.PRStatusOpen, reopened.Status)
+	s.Require().Len(reopened.Reviewers, len(created.Reviewers))
+
+	merged, err := s.prUseCase.MergePR(ctx, created.ID, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(domain.PRStatusMerged, merged.Status)
+
+	_, err = s.prUseCase.ClosePR(ctx, created.ID)
+	s.Require().ErrorIs(err, errs.ErrInvalidPullRequestTransition)
+}
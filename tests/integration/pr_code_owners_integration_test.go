@@ -0,0 +1,54 @@
+package integration_test
+
+import (
+	"app/internal/domain"
+	"app/internal/usecase/errs"
+	"context"
+)
+
+func (s *TestSuite) Test_CodeOwners_Integration() {
+	ctx := context.TODO()
+	authorID := domain.UserID("owners-author")
+	ownerID := domain.UserID("owners-search-reviewer")
+
+	_, err := s.teamUseCase.CreateTeam(ctx, "owners-home", []domain.TeamUser{
+		{ID: authorID, Name: "Author"},
+		{ID: "owners-home-reviewer-1", Name: "Home Reviewer 1"},
+		{ID: "owners-home-reviewer-2", Name: "Home Reviewer 2"},
+	})
+	s.Require().NoError(err)
+
+	_, err = s.teamUseCase.CreateTeam(ctx, "owners-search", []domain.TeamUser{
+		{ID: ownerID, Name: "Search Reviewer"},
+	})
+	s.Require().NoError(err)
+
+	missingTeam := "owners-missing"
+	_, err = s.codeOwnerUseCase.AddRule(ctx, "/internal/search/", nil, &missingTeam)
+	s.Require().ErrorIs(err, errs.ErrTeamNotFound)
+
+	searchTeam := "owners-search"
+	rule, err := s.codeOwnerUseCase.AddRule(ctx, "/internal/search/", nil, &searchTeam)
+	s.Require().NoError(err)
+
+	_, err = s.codeOwnerUseCase.AddRule(ctx, "/internal/search/", nil, &searchTeam)
+	s.Require().ErrorIs(err, errs.ErrCodeOwnerRuleAlreadyExists)
+
+	rules, err := s.codeOwnerUseCase.ListRules(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(rules, 1)
+	s.Require().Equal(searchTeam, *rules[0].OwnerTeam)
+
+	created, err := s.prUseCase.CreatePR(ctx, authorID, "owners-pr", "Owners PR", domain.CreatePullRequestOptions{ChangedFiles: []string{"internal/search/index.go"}})
+	s.Require().NoError(err)
+	s.Require().Len(created.Reviewers, 2)
+
+	var reviewerIDs []domain.UserID
+	for _, reviewer := range created.Reviewers {
+		reviewerIDs = append(reviewerIDs, reviewer.ID)
+	}
+	s.Require().Contains(reviewerIDs, ownerID)
+
+	s.Require().NoError(s.codeOwnerUseCase.RemoveRule(ctx, rule.ID))
+	s.Require().ErrorIs(s.codeOwnerUseCase.RemoveRule(ctx, rule.ID), errs.ErrCodeOwnerRuleNotFound)
+}
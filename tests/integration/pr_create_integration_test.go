@@ -23,7 +23,7 @@ func (s *TestSuite) Test_CreatePR_Integration_TransactionRollback() {
 	s.Require().True(errors.Is(err, errs.ErrInvalidUserID))
 
     prName := "rollback-pr"
-    pr, err := s.prUseCase.CreatePR(context.TODO(), authorID, "1", prName)
+    pr, err := s.prUseCase.CreatePR(context.TODO(), authorID, "1", prName, domain.CreatePullRequestOptions{})
     s.Require().Error(err)
     s.Require().Nil(pr)
 
@@ -65,7 +65,7 @@ func (s *TestSuite) Test_CreatePR_Integration_Success() {
     s.Require().NoError(err)
 
     prName := "test-pr"
-    pr, err := s.prUseCase.CreatePR(context.TODO(), authorID, "1", prName)
+    pr, err := s.prUseCase.CreatePR(context.TODO(), authorID, "1", prName, domain.CreatePullRequestOptions{})
     s.Require().NoError(err)
     s.Require().NotNil(pr)
 
@@ -119,7 +119,7 @@ func (s *TestSuite) Test_CreatePR_Integration_UserNotInTeam() {
     s.Require().NoError(err)
 
     prName := "lonely-pr"
-    pr, err := s.prUseCase.CreatePR(context.TODO(), userID, "1", prName)
+    pr, err := s.prUseCase.CreatePR(context.TODO(), userID, "1", prName, domain.CreatePullRequestOptions{})
     s.Require().Error(err)
     s.Require().Nil(pr)
 
@@ -155,11 +155,11 @@ func (s *TestSuite) Test_CreatePR_Integration_DuplicatePRName() {
     s.Require().NoError(err)
 
     prName := "duplicate-pr"
-    pr1, err := s.prUseCase.CreatePR(context.TODO(), authorID, "1", prName)
+    pr1, err := s.prUseCase.CreatePR(context.TODO(), authorID, "1", prName, domain.CreatePullRequestOptions{})
     s.Require().NoError(err)
     s.Require().NotNil(pr1)
 
-    pr2, err := s.prUseCase.CreatePR(context.TODO(), authorID, "2", prName)
+    pr2, err := s.prUseCase.CreatePR(context.TODO(), authorID, "2", prName, domain.CreatePullRequestOptions{})
     s.Require().Error(err)
     s.Require().Nil(pr2)
 
@@ -21,15 +21,10 @@ func (s *TestSuite) Test_MergePR_Integration_Success() {
     s.Require().NoError(err)
 
     prName := "merge-pr"
-    pr, err := s.prUseCase.CreatePR(context.TODO(), authorID, "1", prName)
+    pr, err := s.prUseCase.CreatePR(context.TODO(), authorID, "1", prName, domain.CreatePullRequestOptions{})
     s.Require().NoError(err)
     s.Require().NotNil(pr)
 
-    // Мержим PR
-    err = s.prUseCase.MergePR(context.TODO(), pr.ID)
-    s.Require().NoError(err)
-
-    // Проверяем в БД что статус изменился на MERGED
     db, err := sql.Open("postgres", s.psqlContainer.GetDSN())
     s.Require().NoError(err)
     defer func() {
@@ -38,14 +33,34 @@ func (s *TestSuite) Test_MergePR_Integration_Success() {
         }   
     }()
 
-    var status string
+    // До мержа merged_at не заполнен
     var mergedAt sql.NullTime
+    err = db.QueryRow(`SELECT merged_at FROM pull_requests WHERE id = $1`, pr.ID).Scan(&mergedAt)
+    s.Require().NoError(err)
+    s.Require().False(mergedAt.Valid)
+
+    // Мержим PR
+    merged, err := s.prUseCase.MergePR(context.TODO(), pr.ID, &authorID)
+    s.Require().NoError(err)
+    s.Require().NotNil(merged.MergedAt)
+    s.Require().Equal(authorID, *merged.MergedBy)
+
+    // Проверяем в БД что статус изменился на MERGED
+    var status string
+    var mergedBy sql.NullString
     err = db.QueryRow(`
-        SELECT status, merged_at 
+        SELECT status, merged_at, merged_by 
         FROM pull_requests 
         WHERE id = $1
-    `, pr.ID).Scan(&status, &mergedAt)
+    `, pr.ID).Scan(&status, &mergedAt, &mergedBy)
     s.Require().NoError(err)
     s.Require().Equal("MERGED", status)
     s.Require().True(mergedAt.Valid)
+    s.Require().Equal(authorID.String(), mergedBy.String)
+
+    // Повторный мерж не перезаписывает merged_at
+    again, err := s.prUseCase.MergePR(context.TODO(), pr.ID, nil)
+    s.Require().NoError(err)
+    s.Require().True(merged.MergedAt.Equal(*again.MergedAt))
+    s.Require().Equal(authorID, *again.MergedBy)
 }
\ No newline at end of file
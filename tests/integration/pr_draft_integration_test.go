@@ -0,0 +1,55 @@
+package integration_test
+
+import (
+	"app/internal/domain"
+	"app/internal/usecase/errs"
+	"context"
+)
+
+func (s *TestSuite) Test_DraftReadyPR_Integration() {
+	ctx := context.TODO()
+	authorID := domain.UserID("draft-author")
+
+	_, err := s.teamUseCase.CreateTeam(ctx, "draft-team", []domain.TeamUser{
+		{ID: authorID, Name: "Author"},
+		{ID: "draft-reviewer-1", Name: "Reviewer 1"},
+		{ID: "draft-reviewer-2", Name: "Reviewer 2"},
+	})
+	s.Require().NoError(err)
+
+	draft, err := s.prUseCase.CreatePR(ctx, authorID, "draft-pr", "Draft PR", domain.CreatePullRequestOptions{Draft: true})
+	s.Require().NoError(err)
+	s.Require().Equal(domain.PRStatusDraft, draft.Status)
+	s.Require().Empty(draft.Reviewers)
+
+	stored, err := s.prUseCase.GetPRByID(ctx, draft.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(domain.PRStatusDraft, stored.Status)
+	s.Require().Empty(stored.Reviewers)
+	s.Require().Nil(stored.ReadyAt)
+
+	_, err = s.prUseCase.MergePR(ctx, draft.ID, nil)
+	s.Require().ErrorIs(err, errs.ErrInvalidPullRequestTransition)
+
+	_, err = s.prUseCase.ReopenPR(ctx, draft.ID, false)
+	s.Require().ErrorIs(err, errs.ErrInvalidPullRequestTransition)
+
+	ready, err := s.prUseCase.ReadyPR(ctx, draft.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(domain.PRStatusOpen, ready.Status)
+	s.Require().NotNil(ready.ReadyAt)
+	s.Require().Len(ready.Reviewers, 2)
+
+	// Повторный вызов ничего не меняет
+	again, err := s.prUseCase.ReadyPR(ctx, draft.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(ready.ReadyAt.Unix(), again.ReadyAt.Unix())
+	s.Require().Len(again.Reviewers, 2)
+
+	merged, err := s.prUseCase.MergePR(ctx, draft.ID, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(domain.PRStatusMerged, merged.Status)
+
+	_, err = s.prUseCase.ReadyPR(ctx, draft.ID)
+	s.Require().ErrorIs(err, errs.ErrInvalidPullRequestTransition)
+}
@@ -0,0 +1,60 @@
+package integration_test
+
+import (
+	"app/internal/domain"
+	"app/internal/usecase/errs"
+	"context"
+)
+
+func (s *TestSuite) Test_FallbackTeams_Integration() {
+	ctx := context.TODO()
+	authorID := domain.UserID("fallback-author")
+
+	_, err := s.teamUseCase.CreateTeam(ctx, "fallback-home", []domain.TeamUser{
+		{ID: authorID, Name: "Author"},
+		{ID: "fallback-home-reviewer", Name: "Home Reviewer"},
+	})
+	s.Require().NoError(err)
+
+	_, err = s.teamUseCase.CreateTeam(ctx, "fallback-pool", []domain.TeamUser{
+		{ID: "fallback-pool-reviewer", Name: "Pool Reviewer"},
+	})
+	s.Require().NoError(err)
+
+	_, err = s.teamUseCase.UpdateTeamSettings(ctx, "fallback-home", domain.TeamSettingsUpdate{
+		MinReviewers:  2,
+		MaxReviewers:  2,
+		FallbackTeams: &[]string{"fallback-missing"},
+	})
+	s.Require().ErrorIs(err, errs.ErrFallbackTeamNotFound)
+
+	_, err = s.teamUseCase.UpdateTeamSettings(ctx, "fallback-home", domain.TeamSettingsUpdate{
+		MinReviewers:  2,
+		MaxReviewers:  2,
+		FallbackTeams: &[]string{"fallback-pool"},
+	})
+	s.Require().NoError(err)
+
+	stored, err := s.teamUseCase.GetTeamByName(ctx, "fallback-home")
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"fallback-pool"}, stored.Settings.FallbackTeams)
+
+	// Без fallback_teams список не меняется.
+	updated, err := s.teamUseCase.UpdateTeamSettings(ctx, "fallback-home", domain.TeamSettingsUpdate{
+		MinReviewers: 2,
+		MaxReviewers: 2,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"fallback-pool"}, updated.Settings.FallbackTeams)
+
+	created, err := s.prUseCase.CreatePR(ctx, authorID, "fallback-pr", "Fallback PR", domain.CreatePullRequestOptions{})
+	s.Require().NoError(err)
+	s.Require().Len(created.Reviewers, 2)
+	s.Require().False(created.NeedMoreReviewers)
+	s.Require().Equal(map[domain.UserID]string{"fallback-pool-reviewer": "fallback-pool"}, created.FallbackReviewers)
+
+	// Происхождение ревьювера хранится в pr_reviewers и видно при повторном чтении.
+	loaded, err := s.prUseCase.GetPRByID(ctx, "fallback-pr")
+	s.Require().NoError(err)
+	s.Require().Equal(created.FallbackReviewers, loaded.FallbackReviewers)
+}
@@ -0,0 +1,59 @@
+package integration_test
+
+import (
+	"app/internal/domain"
+	"context"
+	"fmt"
+)
+
+func (s *TestSuite) Test_GetPRByAuthorID_Pagination() {
+	ctx := context.TODO()
+	authorID := domain.UserID("authored-author")
+
+	_, err := s.teamUseCase.CreateTeam(ctx, "authored-team", []domain.TeamUser{
+		{ID: authorID, Name: "Author"},
+		{ID: "authored-other", Name: "Other"},
+	})
+	s.Require().NoError(err)
+
+	const total = 5
+	for i := 0; i < total; i++ {
+		prID := domain.PRID(fmt.Sprintf("authored-pr-%d", i))
+		_, err := s.prStorage.CreatePullRequest(ctx, prID, string(prID), authorID, domain.PRStatusOpen)
+		s.Require().NoError(err)
+	}
+	_, err = s.prStorage.CreatePullRequest(ctx, "foreign-pr", "foreign-pr", "authored-other", domain.PRStatusOpen)
+	s.Require().NoError(err)
+	_, err = s.prUseCase.MergePR(ctx, "authored-pr-0", nil)
+	s.Require().NoError(err)
+
+	// Обходим все страницы по курсору и проверяем, что PR не теряются и не повторяются
+	seen := make(map[domain.PRID]bool)
+	filter := domain.PullRequestFilter{Limit: 2}
+	pages := 0
+	for {
+		page, err := s.prUseCase.GetPRByAuthorID(ctx, authorID, filter)
+		s.Require().NoError(err)
+		pages++
+
+		for _, pr := range page.PullRequests {
+			s.Require().Equal(authorID, pr.Author.ID)
+			s.Require().False(seen[pr.ID], "duplicate PR %s", pr.ID)
+			seen[pr.ID] = true
+		}
+
+		if page.NextCursor == nil {
+			break
+		}
+		filter.Cursor = page.NextCursor
+	}
+	s.Require().Len(seen, total)
+	s.Require().Equal(3, pages)
+
+	merged := domain.PRStatusMerged
+	page, err := s.prUseCase.GetPRByAuthorID(ctx, authorID, domain.PullRequestFilter{Status: &merged})
+	s.Require().NoError(err)
+	s.Require().Len(page.PullRequests, 1)
+	s.Require().Equal(domain.PRID("authored-pr-0"), page.PullRequests[0].ID)
+	s.Require().Nil(page.NextCursor)
+}
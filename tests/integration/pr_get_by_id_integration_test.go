@@ -0,0 +1,40 @@
+package integration_test
+
+import (
+	"app/internal/domain"
+	"app/internal/usecase/errs"
+	"context"
+)
+
+func (s *TestSuite) Test_GetPRByID_Integration() {
+	ctx := context.TODO()
+	authorID := domain.UserID("detail-author")
+
+	_, err := s.teamUseCase.CreateTeam(ctx, "detail-team", []domain.TeamUser{
+		{ID: authorID, Name: "Author"},
+		{ID: "detail-reviewer-1", Name: "Reviewer 1"},
+		{ID: "detail-reviewer-2", Name: "Reviewer 2"},
+	})
+	s.Require().NoError(err)
+
+	created, err := s.prUseCase.CreatePR(ctx, authorID, "detail-pr", "Detail PR", domain.CreatePullRequestOptions{})
+	s.Require().NoError(err)
+
+	pr, err := s.prUseCase.GetPRByID(ctx, created.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(authorID, pr.Author.ID)
+	s.Require().Equal("Author", pr.Author.Name)
+	s.Require().Equal(domain.PRStatusOpen, pr.Status)
+	s.Require().False(pr.CreatedAt.IsZero())
+	s.Require().Len(pr.Reviewers, len(created.Reviewers))
+
+	// У каждого ревьювера должен быть момент назначения из pr_reviewers
+	for _, reviewer := range pr.Reviewers {
+		assignedAt, ok := pr.ReviewersAssignedAt[reviewer.ID]
+		s.Require().True(ok, "no assigned_at for %s", reviewer.ID)
+		s.Require().False(assignedAt.IsZero())
+	}
+
+	_, err = s.prUseCase.GetPRByID(ctx, "no-such-pr")
+	s.Require().ErrorIs(err, errs.ErrPullRequestNotFound)
+}
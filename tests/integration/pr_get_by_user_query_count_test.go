@@ -0,0 +1,148 @@
+package integration_test
+
+import (
+	"app/internal/domain"
+	"app/internal/mapper"
+	"app/internal/repository/models"
+	"app/internal/repository/storage"
+	"app/internal/repository/storage/postgres"
+	"app/internal/usecase/pr_usecase"
+	"app/pkg/txmanager"
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const queryCountPRCount = 200
+
+// countingTxManager считает запросы, которые хранилища отправляют через executor.
+type countingTxManager struct {
+	txmanager.TxManager
+	queries atomic.Int64
+}
+
+func (c *countingTxManager) GetExecutor(ctx context.Context) txmanager.Executor {
+	return &countingExecutor{Executor: c.TxManager.GetExecutor(ctx), queries: &c.queries}
+}
+
+type countingExecutor struct {
+	txmanager.Executor
+	queries *atomic.Int64
+}
+
+func (e *countingExecutor) Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error) {
+	e.queries.Add(1)
+	return e.Executor.Exec(ctx, sql, arguments...)
+}
+
+func (e *countingExecutor) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	e.queries.Add(1)
+	return e.Executor.Query(ctx, sql, args...)
+}
+
+func (e *countingExecutor) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	e.queries.Add(1)
+	return e.Executor.QueryRow(ctx, sql, args...)
+}
+
+// legacyGetPRByUserID повторяет прежнюю N+1 реализацию: по два запроса на каждый PR.
+func legacyGetPRByUserID(ctx context.Context, prStorage storage.PRStorage, userStorage storage.UserStorage,
+	userID domain.UserID) ([]domain.PullRequest, error) {
+	prModels, err := prStorage.GetPullRequestsByReviewerID(ctx, userID, domain.PullRequestFilter{SortOrder: domain.SortOrderDesc})
+	if err != nil {
+		return nil, err
+	}
+
+	authors := make(map[domain.UserID]models.User)
+	reviewers := make(map[domain.PRID][]models.User)
+	for _, pm := range prModels {
+		author, err := userStorage.GetUserByID(ctx, pm.AuthorID)
+		if err != nil {
+			return nil, err
+		}
+		authors[author.ID] = *author
+
+		prReviewers, err := prStorage.GetReviewersFromPR(ctx, pm.ID)
+		if err != nil {
+			return nil, err
+		}
+		reviewers[pm.ID] = prReviewers
+	}
+
+	return mapper.ModelsToDomainPullRequests(prModels, authors, reviewers), nil
+}
+
+func (s *TestSuite) Test_GetPRByUserID_QueryCount() {
+	ctx := context.TODO()
+	reviewerID := domain.UserID("bench-reviewer")
+
+	_, err := s.teamUseCase.CreateTeam(ctx, "bench-team", []domain.TeamUser{
+		{ID: "bench-author-1", Name: "Author 1"},
+		{ID: "bench-author-2", Name: "Author 2"},
+		{ID: reviewerID, Name: "Reviewer"},
+	})
+	s.Require().NoError(err)
+
+	for i := 0; i < queryCountPRCount; i++ {
+		prID := domain.PRID(fmt.Sprintf("bench-pr-%d", i))
+		authorID := domain.UserID(fmt.Sprintf("bench-author-%d", i%2+1))
+
+		_, err := s.prStorage.CreatePullRequest(ctx, prID, string(prID), authorID, domain.PRStatusOpen)
+		s.Require().NoError(err)
+		s.Require().NoError(s.prStorage.CreatePRReviewerInstance(ctx, prID, reviewerID, nil))
+	}
+
+	counter := &countingTxManager{TxManager: s.txManager}
+	prStorage := postgres.NewPRStorage(counter, s.logger)
+	userStorage := postgres.NewUserStorage(counter, s.logger)
+	prUseCase := pr_usecase.NewPRUseCase(prStorage, userStorage, s.statsCache, s.teamStorage, counter, s.logger)
+
+	filter := domain.PullRequestFilter{Limit: queryCountPRCount}
+
+	page, err := prUseCase.GetPRByUserID(ctx, reviewerID, filter)
+	s.Require().NoError(err)
+	batchedQueries := counter.queries.Swap(0)
+
+	legacy, err := legacyGetPRByUserID(ctx, prStorage, userStorage, reviewerID)
+	s.Require().NoError(err)
+	legacyQueries := counter.queries.Swap(0)
+
+	s.Require().Len(page.PullRequests, queryCountPRCount)
+	s.Require().Nil(page.NextCursor)
+	s.Require().Equal(legacy, page.PullRequests)
+
+	s.T().Logf("GetPRByUserID with %d PRs: N+1 %d queries, batched %d queries",
+		queryCountPRCount, legacyQueries, batchedQueries)
+
+	// Список PR и один запрос участников, независимо от числа PR.
+	s.Require().Equal(int64(2), batchedQueries)
+	s.Require().Equal(int64(1+2*queryCountPRCount), legacyQueries)
+
+	if testing.Short() {
+		return
+	}
+
+	// Время только логируется: на общих CI-машинах оно слишком нестабильно для проверки.
+	batchedBench := testing.Benchmark(func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := prUseCase.GetPRByUserID(ctx, reviewerID, filter); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	legacyBench := testing.Benchmark(func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := legacyGetPRByUserID(ctx, prStorage, userStorage, reviewerID); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	s.T().Logf("GetPRByUserID with %d PRs: N+1 %v/op, batched %v/op",
+		queryCountPRCount, time.Duration(legacyBench.NsPerOp()), time.Duration(batchedBench.NsPerOp()))
+}
@@ -0,0 +1,73 @@
+package integration_test
+
+import (
+	"app/internal/domain"
+	"app/internal/usecase/errs"
+	"context"
+	"errors"
+)
+
+func (s *TestSuite) Test_MergePolicy_Integration() {
+	ctx := context.TODO()
+	authorID := domain.UserID("policy-author")
+
+	_, err := s.teamUseCase.CreateTeam(ctx, "policy-team", []domain.TeamUser{
+		{ID: authorID, Name: "Author"},
+		{ID: "policy-reviewer-1", Name: "Reviewer 1"},
+		{ID: "policy-reviewer-2", Name: "Reviewer 2"},
+	})
+	s.Require().NoError(err)
+
+	team, err := s.teamUseCase.UpdateTeamSettings(ctx, "policy-team", domain.TeamSettingsUpdate{
+		MinReviewers: 2,
+		MaxReviewers: 2,
+		MergePolicy: &domain.MergePolicy{
+			MinApprovals:        1,
+			RequireAllResponded: true,
+			ForbidSelfMerge:     true,
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(1, team.Settings.MergePolicy.MinApprovals)
+
+	stored, err := s.teamUseCase.GetTeamByName(ctx, "policy-team")
+	s.Require().NoError(err)
+	s.Require().Equal(team.Settings.MergePolicy, stored.Settings.MergePolicy)
+
+	// Без merge_policy сохраняется текущая политика.
+	updated, err := s.teamUseCase.UpdateTeamSettings(ctx, "policy-team", domain.TeamSettingsUpdate{
+		MinReviewers: 2,
+		MaxReviewers: 2,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(stored.Settings.MergePolicy, updated.Settings.MergePolicy)
+
+	created, err := s.prUseCase.CreatePR(ctx, authorID, "policy-pr", "Policy PR", domain.CreatePullRequestOptions{})
+	s.Require().NoError(err)
+	s.Require().Len(created.Reviewers, 2)
+
+	_, err = s.prUseCase.MergePR(ctx, created.ID, &authorID)
+	var policyErr *errs.MergePolicyError
+	s.Require().True(errors.As(err, &policyErr))
+
+	conditions := make([]domain.MergeCondition, 0, len(policyErr.Unmet))
+	for _, c := range policyErr.Unmet {
+		conditions = append(conditions, c.Condition)
+	}
+	s.Require().Equal([]domain.MergeCondition{
+		domain.MergeConditionMinApprovals,
+		domain.MergeConditionAllReviewersResponded,
+		domain.MergeConditionNoSelfMerge,
+	}, conditions)
+
+	first, second := created.Reviewers[0].ID, created.Reviewers[1].ID
+
+	_, err = s.prUseCase.ReviewPR(ctx, created.ID, first, domain.ReviewVerdictApprove)
+	s.Require().NoError(err)
+	_, err = s.prUseCase.ReviewPR(ctx, created.ID, second, domain.ReviewVerdictComment)
+	s.Require().NoError(err)
+
+	merged, err := s.prUseCase.MergePR(ctx, created.ID, &first)
+	s.Require().NoError(err)
+	s.Require().Equal(domain.PRStatusMerged, merged.Status)
+}
@@ -0,0 +1,56 @@
+package integration_test
+
+import (
+	"app/internal/domain"
+	"app/internal/usecase/errs"
+	"context"
+)
+
+func (s *TestSuite) Test_ReviewPR_Integration() {
+	ctx := context.TODO()
+	authorID := domain.UserID("review-author")
+
+	_, err := s.teamUseCase.CreateTeam(ctx, "review-team", []domain.TeamUser{
+		{ID: authorID, Name: "Author"},
+		{ID: "review-reviewer-1", Name: "Reviewer 1"},
+		{ID: "review-reviewer-2", Name: "Reviewer 2"},
+	})
+	s.Require().NoError(err)
+
+	created, err := s.prUseCase.CreatePR(ctx, authorID, "review-pr", "Review PR", domain.CreatePullRequestOptions{})
+	s.Require().NoError(err)
+	s.Require().Len(created.Reviewers, 2)
+
+	stored, err := s.prUseCase.GetPRByID(ctx, created.ID)
+	s.Require().NoError(err)
+	for _, reviewer := range stored.Reviewers {
+		s.Require().Equal(domain.ReviewStatePending, stored.Reviews[reviewer.ID].State)
+		s.Require().Nil(stored.Reviews[reviewer.ID].ReviewedAt)
+	}
+
+	first, second := created.Reviewers[0].ID, created.Reviewers[1].ID
+
+	reviewed, err := s.prUseCase.ReviewPR(ctx, created.ID, first, domain.ReviewVerdictApprove)
+	s.Require().NoError(err)
+	s.Require().Equal(domain.ReviewStateApproved, reviewed.Reviews[first].State)
+	s.Require().NotNil(reviewed.Reviews[first].ReviewedAt)
+	s.Require().Equal(domain.ReviewStatePending, reviewed.Reviews[second].State)
+
+	reviewed, err = s.prUseCase.ReviewPR(ctx, created.ID, second, domain.ReviewVerdictRequestChanges)
+	s.Require().NoError(err)
+	s.Require().Equal(domain.ReviewStateChangesRequested, reviewed.Reviews[second].State)
+
+	// Комментарий фиксирует ответ, но не снимает запрос изменений
+	reviewed, err = s.prUseCase.ReviewPR(ctx, created.ID, second, domain.ReviewVerdictComment)
+	s.Require().NoError(err)
+	s.Require().Equal(domain.ReviewStateChangesRequested, reviewed.Reviews[second].State)
+
+	_, err = s.prUseCase.ReviewPR(ctx, created.ID, authorID, domain.ReviewVerdictApprove)
+	s.Require().ErrorIs(err, errs.ErrReviewerNotFoundInPullRequest)
+
+	_, err = s.prUseCase.MergePR(ctx, created.ID, nil)
+	s.Require().NoError(err)
+
+	_, err = s.prUseCase.ReviewPR(ctx, created.ID, first, domain.ReviewVerdictApprove)
+	s.Require().ErrorIs(err, errs.ErrPullRequestNotOpen)
+}
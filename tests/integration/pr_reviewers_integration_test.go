@@ -0,0 +1,113 @@
+package integration_test
+
+import (
+	"app/internal/domain"
+	"app/internal/usecase/errs"
+	"context"
+)
+
+func (s *TestSuite) Test_AddRemoveReviewer_Integration() {
+	ctx := context.TODO()
+	authorID := domain.UserID("manual-author")
+
+	_, err := s.teamUseCase.CreateTeam(ctx, "manual-team", []domain.TeamUser{
+		{ID: authorID, Name: "Author"},
+		{ID: "manual-reviewer-1", Name: "Reviewer 1"},
+		{ID: "manual-reviewer-2", Name: "Reviewer 2"},
+		{ID: "manual-reviewer-3", Name: "Reviewer 3"},
+	})
+	s.Require().NoError(err)
+
+	_, err = s.teamUseCase.CreateTeam(ctx, "manual-other-team", []domain.TeamUser{
+		{ID: "manual-outsider", Name: "Outsider"},
+	})
+	s.Require().NoError(err)
+
+	created, err := s.prUseCase.CreatePR(ctx, authorID, "manual-pr", "Manual PR", domain.CreatePullRequestOptions{})
+	s.Require().NoError(err)
+	s.Require().Len(created.Reviewers, 2)
+
+	assigned := map[domain.UserID]bool{}
+	for _, r := range created.Reviewers {
+		assigned[r.ID] = true
+	}
+
+	var spare domain.UserID
+	for _, id := range []domain.UserID{"manual-reviewer-1", "manual-reviewer-2", "manual-reviewer-3"} {
+		if !assigned[id] {
+			spare = id
+		}
+	}
+
+	_, err = s.prUseCase.AddReviewer(ctx, created.ID, spare)
+	s.Require().ErrorIs(err, errs.ErrTooManyReviewers)
+
+	_, err = s.prUseCase.AddReviewer(ctx, created.ID, authorID)
+	s.Require().ErrorIs(err, errs.ErrReviewerIsAuthor)
+
+	_, err = s.prUseCase.AddReviewer(ctx, created.ID, created.Reviewers[0].ID)
+	s.Require().ErrorIs(err, errs.ErrReviewerAlreadyAssigned)
+
+	removed, err := s.prUseCase.RemoveReviewer(ctx, created.ID, created.Reviewers[0].ID)
+	s.Require().NoError(err)
+	s.Require().Len(removed.Reviewers, 1)
+	s.Require().True(removed.NeedMoreReviewers)
+
+	_, err = s.prUseCase.RemoveReviewer(ctx, created.ID, created.Reviewers[0].ID)
+	s.Require().ErrorIs(err, errs.ErrReviewerNotFoundInPullRequest)
+
+	_, err = s.prUseCase.AddReviewer(ctx, created.ID, "manual-outsider")
+	s.Require().ErrorIs(err, errs.ErrReviewerNotInTeam)
+
+	added, err := s.prUseCase.AddReviewer(ctx, created.ID, spare)
+	s.Require().NoError(err)
+	s.Require().Len(added.Reviewers, 2)
+	s.Require().False(added.NeedMoreReviewers)
+}
+
+func (s *TestSuite) Test_ReassignReviewerTargeted_Integration() {
+	ctx := context.TODO()
+	authorID := domain.UserID("target-author")
+
+	_, err := s.teamUseCase.CreateTeam(ctx, "target-team", []domain.TeamUser{
+		{ID: authorID, Name: "Author"},
+		{ID: "target-reviewer-1", Name: "Reviewer 1"},
+		{ID: "target-reviewer-2", Name: "Reviewer 2"},
+		{ID: "target-reviewer-3", Name: "Reviewer 3"},
+	})
+	s.Require().NoError(err)
+
+	created, err := s.prUseCase.CreatePR(ctx, authorID, "target-pr", "Target PR", domain.CreatePullRequestOptions{})
+	s.Require().NoError(err)
+	s.Require().Len(created.Reviewers, 2)
+
+	assigned := map[domain.UserID]bool{}
+	for _, r := range created.Reviewers {
+		assigned[r.ID] = true
+	}
+
+	var spare domain.UserID
+	for _, id := range []domain.UserID{"target-reviewer-1", "target-reviewer-2", "target-reviewer-3"} {
+		if !assigned[id] {
+			spare = id
+		}
+	}
+
+	old, kept := created.Reviewers[0].ID, created.Reviewers[1].ID
+
+	_, _, err = s.prUseCase.ReassignReviewer(ctx, created.ID, old, &kept)
+	s.Require().ErrorIs(err, errs.ErrReviewerAlreadyAssigned)
+
+	_, _, err = s.prUseCase.ReassignReviewer(ctx, created.ID, old, &authorID)
+	s.Require().ErrorIs(err, errs.ErrReviewerIsAuthor)
+
+	pr, replacedBy, err := s.prUseCase.ReassignReviewer(ctx, created.ID, old, &spare)
+	s.Require().NoError(err)
+	s.Require().Equal(spare, replacedBy)
+
+	reviewerIDs := []domain.UserID{}
+	for _, r := range pr.Reviewers {
+		reviewerIDs = append(reviewerIDs, r.ID)
+	}
+	s.Require().ElementsMatch([]domain.UserID{kept, spare}, reviewerIDs)
+}
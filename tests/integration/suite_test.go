@@ -17,10 +17,13 @@ import (
 	"app/internal/repository/storage"
 	"app/internal/repository/storage/postgres"
 	"app/internal/usecase"
+	"app/internal/usecase/availability_usecase"
+	"app/internal/usecase/code_owner_usecase"
 	"app/internal/usecase/pr_usecase"
 	"app/internal/usecase/stats_usecase"
 	"app/internal/usecase/team_usecase"
 	"app/internal/usecase/user_usecase"
+	"app/pkg/logger"
 	"app/pkg/txmanager"
 	"app/tests/integration/testutil"
 )
@@ -34,12 +37,16 @@ type TestSuite struct {
 	teamUseCase 	team_usecase.TeamUseCase
 	prUseCase   	pr_usecase.PullRequestUseCase
 	statsUseCase 	stats_usecase.StatsUseCase
+	codeOwnerUseCase code_owner_usecase.CodeOwnerUseCase
+	availabilityUseCase availability_usecase.AvailabilityUseCase
 	usecase   		usecase.UseCase
 
 	userStorage 	storage.UserStorage
 	teamStorage 	storage.TeamStorage
 	prStorage   	storage.PRStorage
 	statsCache   	cache.StatsCache
+	txManager 		txmanager.TxManager
+	logger 			logger.Logger
 
 	psqlContainer 	*testutil.PostgreSQLContainer
 }
@@ -93,17 +100,24 @@ func (s *TestSuite) SetupSuite() {
 	teamStorage := postgres.NewTeamStorage(txManager, logger)
 	userStorage := postgres.NewUserStorage(txManager, logger)
 	prStorage 	:= postgres.NewPRStorage(txManager, logger)
+	codeOwnerStorage := postgres.NewCodeOwnerStorage(txManager, logger)
+	availabilityStorage := postgres.NewAvailabilityStorage(txManager, logger)
 
 	statsCache := mock.NewMockStatsCache(ctrl)
 	statsCache.EXPECT().IncrementAssignCountByUserID(gomock.Any(), gomock.Any()).AnyTimes()
 	statsCache.EXPECT().DecrementAssignCountByUserID(gomock.Any(), gomock.Any()).AnyTimes()
 
-	prUseCase 	 := pr_usecase.NewPRUseCase(prStorage, userStorage, statsCache, teamStorage, txManager, logger)
-	userUseCase  := user_usecase.NewUserUseCase(userStorage, txManager, teamStorage, logger)
-	teamUseCase  := team_usecase.NewTeamUseCase(teamStorage, userStorage, txManager, logger)
+	prUseCase 	 := pr_usecase.NewPRUseCase(prStorage, userStorage, statsCache, teamStorage, txManager, logger,
+		pr_usecase.WithCodeOwnerStorage(codeOwnerStorage), pr_usecase.WithAvailabilityStorage(availabilityStorage))
+	userUseCase  := user_usecase.NewUserUseCase(userStorage, txManager, teamStorage, logger,
+		user_usecase.WithPullRequestUseCase(prUseCase))
+	teamUseCase  := team_usecase.NewTeamUseCase(teamStorage, userStorage, txManager, logger,
+		team_usecase.WithPullRequestUseCase(prUseCase))
 	statsUseCase := stats_usecase.NewStatsUseCase(statsCache, userStorage)
+	codeOwnerUseCase := code_owner_usecase.NewCodeOwnerUseCase(codeOwnerStorage, userStorage, teamStorage, txManager, logger)
+	availabilityUseCase := availability_usecase.NewAvailabilityUseCase(availabilityStorage, userStorage, txManager, logger)
 
-	usecase := usecase.NewUseCase(userUseCase, teamUseCase, prUseCase, statsUseCase)
+	usecase := usecase.NewUseCase(userUseCase, teamUseCase, prUseCase, statsUseCase, codeOwnerUseCase, availabilityUseCase)
 
 	s.userUseCase = userUseCase
 	s.teamUseCase = teamUseCase
@@ -113,7 +127,11 @@ func (s *TestSuite) SetupSuite() {
 	s.teamStorage = teamStorage
 	s.prStorage = prStorage
 	s.statsCache = statsCache
+	s.txManager = txManager
+	s.logger = logger
 	s.statsUseCase = statsUseCase
+	s.codeOwnerUseCase = codeOwnerUseCase
+	s.availabilityUseCase = availabilityUseCase
 }
 
 func (s *TestSuite) SetupTest() {
@@ -80,7 +80,7 @@ func (s *TestSuite) Test_CreateTeam_DuplicateTeamName_Integration() {
 	s.Require().Equal(1, teamCount)
 }
 
-func (s *TestSuite) Test_CreateTeam_UserAlreadyInTeam_Integration() {
+func (s *TestSuite) Test_CreateTeam_UserFromAnotherTeam_Integration() {
 
 	team1Name := "team1-integration"
 	sharedUser := domain.UserID("shared-user-integration")
@@ -88,25 +88,19 @@ func (s *TestSuite) Test_CreateTeam_UserAlreadyInTeam_Integration() {
 	s.Require().NoError(err)
 	s.Require().NotNil(team1)
 
+	// Пользователь вступает во вторую команду, оставаясь в первой.
 	team2Name := "team2-integration"
-	team2, err := s.teamUseCase.CreateTeam(context.TODO(), team2Name, []domain.TeamUser{{ID: sharedUser, Name: "Shared User"}})
-	s.Require().Error(err)
-	s.Require().Nil(team2)
-
-	db, err := sql.Open("postgres", s.psqlContainer.GetDSN())
+	team2, err := s.teamUseCase.CreateTeam(context.TODO(), team2Name, []domain.TeamUser{{ID: sharedUser, Name: "Renamed User"}})
 	s.Require().NoError(err)
-	defer func() {
-        if err = db.Close(); err != nil {
-            s.T().Fatalf("failed to close db: %v", err)
-        }   
-    }()
+	s.Require().NotNil(team2)
+	s.Require().Equal("Shared User", team2.Users[0].Name)
 
-	var teamID int64
-	err = db.QueryRow(`
-		SELECT team_id FROM user_teams WHERE user_id = $1
-	`, sharedUser).Scan(&teamID)
+	teams, err := s.userUseCase.GetUserTeams(context.TODO(), sharedUser)
 	s.Require().NoError(err)
-	s.Require().Equal(team1.ID.Int64(), teamID)
+	s.Require().Len(teams, 2)
+	s.Require().Equal(team1Name, teams[0].TeamName)
+	s.Require().True(teams[0].IsPrimary)
+	s.Require().Equal(team2Name, teams[1].TeamName)
 }
 
 func (s *TestSuite) Test_TeamWorkflow_Integration() {
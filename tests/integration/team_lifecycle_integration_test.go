@@ -0,0 +1,70 @@
+package integration_test
+
+import (
+	"app/internal/domain"
+	"app/internal/usecase/errs"
+	"context"
+)
+
+func (s *TestSuite) Test_TeamLifecycle_Integration() {
+	ctx := context.TODO()
+	authorID := domain.UserID("lifecycle-author")
+
+	_, err := s.teamUseCase.CreateTeam(ctx, "lifecycle-tema", []domain.TeamUser{
+		{ID: authorID, Name: "Author"},
+		{ID: "lifecycle-reviewer", Name: "Reviewer"},
+	})
+	s.Require().NoError(err)
+
+	_, err = s.teamUseCase.CreateTeam(ctx, "lifecycle-other", []domain.TeamUser{
+		{ID: "lifecycle-other-1", Name: "Other"},
+	})
+	s.Require().NoError(err)
+
+	_, err = s.teamUseCase.RenameTeam(ctx, "lifecycle-tema", "lifecycle-other")
+	s.Require().ErrorIs(err, errs.ErrTeamAlreadyExists)
+
+	team, err := s.teamUseCase.RenameTeam(ctx, "lifecycle-tema", "lifecycle-team")
+	s.Require().NoError(err)
+	s.Require().Equal("lifecycle-team", team.TeamName)
+	s.Require().Len(team.Users, 2)
+
+	// Проходим весь список постранично: команды из других тестов тоже попадают в выдачу.
+	counts := map[string]int{}
+	filter := domain.TeamFilter{Limit: 1}
+	for {
+		page, err := s.teamUseCase.ListTeams(ctx, filter)
+		s.Require().NoError(err)
+		for _, t := range page.Teams {
+			counts[t.TeamName] = t.MemberCount
+		}
+		if page.NextCursor == nil {
+			break
+		}
+		filter.AfterName = page.NextCursor
+	}
+	s.Require().Equal(2, counts["lifecycle-team"])
+	s.Require().Equal(1, counts["lifecycle-other"])
+	s.Require().NotContains(counts, "lifecycle-tema")
+
+	_, err = s.prUseCase.CreatePR(ctx, authorID, "lifecycle-pr", "Lifecycle PR", domain.CreatePullRequestOptions{})
+	s.Require().NoError(err)
+
+	_, err = s.teamUseCase.DeleteTeam(ctx, "lifecycle-team", false)
+	s.Require().ErrorIs(err, errs.ErrTeamHasOpenPullRequests)
+
+	closed, err := s.teamUseCase.DeleteTeam(ctx, "lifecycle-team", true)
+	s.Require().NoError(err)
+	s.Require().Equal([]domain.PRID{"lifecycle-pr"}, closed)
+
+	pr, err := s.prUseCase.GetPRByID(ctx, "lifecycle-pr")
+	s.Require().NoError(err)
+	s.Require().Equal(domain.PRStatusClosed, pr.Status)
+
+	_, err = s.teamUseCase.GetTeamByName(ctx, "lifecycle-team")
+	s.Require().ErrorIs(err, errs.ErrTeamNotFound)
+
+	// Участники удалённой команды остаются в системе.
+	_, err = s.userUseCase.GetUserByID(ctx, authorID)
+	s.Require().NoError(err)
+}
@@ -0,0 +1,63 @@
+package integration_test
+
+import (
+	"app/internal/domain"
+	"app/internal/usecase/errs"
+	"context"
+)
+
+func (s *TestSuite) Test_TeamMembers_Integration() {
+	ctx := context.TODO()
+	authorID := domain.UserID("members-author")
+	leaverID := domain.UserID("members-leaver")
+	moverID := domain.UserID("members-mover")
+	newcomerID := domain.UserID("members-newcomer")
+
+	_, err := s.teamUseCase.CreateTeam(ctx, "members-team", []domain.TeamUser{
+		{ID: authorID, Name: "Author"},
+		{ID: leaverID, Name: "Leaver"},
+		{ID: moverID, Name: "Mover"},
+	})
+	s.Require().NoError(err)
+
+	_, err = s.teamUseCase.CreateTeam(ctx, "members-other", []domain.TeamUser{
+		{ID: "members-other-1", Name: "Other"},
+	})
+	s.Require().NoError(err)
+
+	created, err := s.prUseCase.CreatePR(ctx, authorID, "members-pr", "Members PR", domain.CreatePullRequestOptions{})
+	s.Require().NoError(err)
+	s.Require().Len(created.Reviewers, 2)
+
+	// Замены нет: в команде остались только автор и второй ревьювер.
+	report, err := s.teamUseCase.RemoveTeamMember(ctx, "members-team", leaverID)
+	s.Require().NoError(err)
+	s.Require().Empty(report.Reassigned)
+	s.Require().Len(report.Unfilled, 1)
+
+	_, err = s.teamUseCase.RemoveTeamMember(ctx, "members-team", leaverID)
+	s.Require().ErrorIs(err, errs.ErrUserNotInTeam)
+
+	pr, err := s.prUseCase.GetPRByID(ctx, "members-pr")
+	s.Require().NoError(err)
+	s.Require().True(pr.NeedMoreReviewers)
+	s.Require().Len(pr.Reviewers, 1)
+
+	team, err := s.teamUseCase.AddTeamMember(ctx, "members-team", domain.TeamUser{ID: newcomerID, Name: "Newcomer"})
+	s.Require().NoError(err)
+	s.Require().Len(team.Users, 3)
+
+	_, err = s.teamUseCase.AddTeamMember(ctx, "members-team", domain.TeamUser{ID: authorID})
+	s.Require().ErrorIs(err, errs.ErrUserAlreadyInTeam)
+
+	team, report, err = s.teamUseCase.MoveTeamMember(ctx, moverID, "members-other")
+	s.Require().NoError(err)
+	s.Require().Len(team.Users, 2)
+	s.Require().Len(report.Reassigned, 1)
+	s.Require().Equal(newcomerID, *report.Reassigned[0].NewReviewerID)
+
+	// Исключённый пользователь остаётся в системе и может вернуться в команду.
+	team, err = s.teamUseCase.AddTeamMember(ctx, "members-team", domain.TeamUser{ID: leaverID})
+	s.Require().NoError(err)
+	s.Require().Len(team.Users, 3)
+}
@@ -0,0 +1,119 @@
+package integration_test
+
+import (
+	"app/internal/domain"
+	"app/internal/usecase/errs"
+	"context"
+)
+
+func (s *TestSuite) Test_MultiTeamMembership_Integration() {
+	ctx := context.TODO()
+	devID := domain.UserID("multi-dev")
+
+	_, err := s.teamUseCase.CreateTeam(ctx, "multi-backend", []domain.TeamUser{
+		{ID: devID, Name: "Dev"},
+		{ID: "multi-b1", Name: "Backend 1"},
+		{ID: "multi-b2", Name: "Backend 2"},
+	})
+	s.Require().NoError(err)
+
+	_, err = s.teamUseCase.CreateTeam(ctx, "multi-platform", []domain.TeamUser{
+		{ID: "multi-p1", Name: "Platform 1"},
+		{ID: "multi-p2", Name: "Platform 2"},
+	})
+	s.Require().NoError(err)
+
+	_, err = s.teamUseCase.CreateTeam(ctx, "multi-foreign", []domain.TeamUser{
+		{ID: "multi-f1", Name: "Foreign"},
+	})
+	s.Require().NoError(err)
+
+	team, err := s.teamUseCase.AddTeamMember(ctx, "multi-platform", domain.TeamUser{ID: devID})
+	s.Require().NoError(err)
+	s.Require().Len(team.Users, 3)
+
+	teams, err := s.userUseCase.GetUserTeams(ctx, devID)
+	s.Require().NoError(err)
+	s.Require().Len(teams, 2)
+	s.Require().Equal("multi-backend", teams[0].TeamName)
+	s.Require().True(teams[0].IsPrimary)
+	s.Require().False(teams[1].IsPrimary)
+
+	reviewerIDs := func(pr *domain.PullRequest) []domain.UserID {
+		ids := make([]domain.UserID, 0, len(pr.Reviewers))
+		for _, r := range pr.Reviewers {
+			ids = append(ids, r.ID)
+		}
+		return ids
+	}
+
+	// Без team_name ревьюверы берутся из основной команды.
+	pr, err := s.prUseCase.CreatePR(ctx, devID, "multi-pr-1", "Multi PR 1", domain.CreatePullRequestOptions{})
+	s.Require().NoError(err)
+	s.Require().ElementsMatch([]domain.UserID{"multi-b1", "multi-b2"}, reviewerIDs(pr))
+
+	pr, err = s.prUseCase.CreatePR(ctx, devID, "multi-pr-2", "Multi PR 2", domain.CreatePullRequestOptions{TeamName: "multi-platform"})
+	s.Require().NoError(err)
+	s.Require().ElementsMatch([]domain.UserID{"multi-p1", "multi-p2"}, reviewerIDs(pr))
+
+	_, err = s.prUseCase.CreatePR(ctx, devID, "multi-pr-3", "Multi PR 3", domain.CreatePullRequestOptions{TeamName: "multi-foreign"})
+	s.Require().ErrorIs(err, errs.ErrAuthorNotInTeam)
+
+	// Вручную назначить можно только участника команды PR, а не основной команды автора.
+	_, err = s.prUseCase.RemoveReviewer(ctx, "multi-pr-2", "multi-p1")
+	s.Require().NoError(err)
+	_, err = s.prUseCase.AddReviewer(ctx, "multi-pr-2", "multi-b1")
+	s.Require().ErrorIs(err, errs.ErrReviewerNotInTeam)
+
+	teams, err = s.userUseCase.SetPrimaryTeam(ctx, devID, "multi-platform")
+	s.Require().NoError(err)
+	s.Require().Equal("multi-platform", teams[0].TeamName)
+	s.Require().True(teams[0].IsPrimary)
+
+	_, err = s.userUseCase.SetPrimaryTeam(ctx, devID, "multi-foreign")
+	s.Require().ErrorIs(err, errs.ErrUserNotInTeam)
+
+	pr, err = s.prUseCase.CreatePR(ctx, devID, "multi-pr-4", "Multi PR 4", domain.CreatePullRequestOptions{})
+	s.Require().NoError(err)
+	s.Require().ElementsMatch([]domain.UserID{"multi-p1", "multi-p2"}, reviewerIDs(pr))
+
+	backendPR, err := s.prUseCase.CreatePR(ctx, "multi-b1", "multi-pr-5", "Multi PR 5", domain.CreatePullRequestOptions{})
+	s.Require().NoError(err)
+	s.Require().Contains(reviewerIDs(backendPR), devID)
+
+	platformPR, err := s.prUseCase.CreatePR(ctx, "multi-p1", "multi-pr-6", "Multi PR 6", domain.CreatePullRequestOptions{})
+	s.Require().NoError(err)
+	s.Require().Contains(reviewerIDs(platformPR), devID)
+
+	// При выходе из команды переназначаются только ревью в её PR; замены в backend нет.
+	report, err := s.teamUseCase.RemoveTeamMember(ctx, "multi-backend", devID)
+	s.Require().NoError(err)
+	s.Require().Empty(report.Reassigned)
+	s.Require().Equal([]domain.ReviewerReassignment{{PullRequestID: "multi-pr-5", OldReviewerID: devID}}, report.Unfilled)
+
+	backendPR, err = s.prUseCase.GetPRByID(ctx, "multi-pr-5")
+	s.Require().NoError(err)
+	s.Require().NotContains(reviewerIDs(backendPR), devID)
+
+	platformPR, err = s.prUseCase.GetPRByID(ctx, "multi-pr-6")
+	s.Require().NoError(err)
+	s.Require().Contains(reviewerIDs(platformPR), devID)
+
+	teams, err = s.userUseCase.GetUserTeams(ctx, devID)
+	s.Require().NoError(err)
+	s.Require().Len(teams, 1)
+	s.Require().Equal("multi-platform", teams[0].TeamName)
+	s.Require().True(teams[0].IsPrimary)
+
+	// После выхода из основной команды основной становится оставшаяся.
+	_, err = s.teamUseCase.AddTeamMember(ctx, "multi-foreign", domain.TeamUser{ID: devID})
+	s.Require().NoError(err)
+	_, err = s.teamUseCase.RemoveTeamMember(ctx, "multi-platform", devID)
+	s.Require().NoError(err)
+
+	teams, err = s.userUseCase.GetUserTeams(ctx, devID)
+	s.Require().NoError(err)
+	s.Require().Len(teams, 1)
+	s.Require().Equal("multi-foreign", teams[0].TeamName)
+	s.Require().True(teams[0].IsPrimary)
+}
@@ -0,0 +1,69 @@
+package integration_test
+
+import (
+	"app/internal/domain"
+	"context"
+)
+
+func (s *TestSuite) Test_TeamSync_Integration() {
+	ctx := context.TODO()
+
+	desired := []domain.User{
+		{ID: "sync-1", Name: "Alice", IsActive: domain.UserStatusActive},
+		{ID: "sync-2", Name: "Bob", IsActive: domain.UserStatusActive},
+		{ID: "sync-3", Name: "Carol", IsActive: domain.UserStatusActive},
+	}
+
+	report, err := s.teamUseCase.SyncTeam(ctx, "sync-team", desired, true)
+	s.Require().NoError(err)
+	s.Require().True(report.TeamCreated)
+	s.Require().Len(report.Added, 3)
+
+	// Dry-run ничего не создаёт.
+	_, err = s.teamUseCase.GetTeamByName(ctx, "sync-team")
+	s.Require().Error(err)
+
+	report, err = s.teamUseCase.SyncTeam(ctx, "sync-team", desired, false)
+	s.Require().NoError(err)
+	s.Require().True(report.TeamCreated)
+
+	// Повторная синхронизация с тем же составом ничего не меняет.
+	report, err = s.teamUseCase.SyncTeam(ctx, "sync-team", desired, false)
+	s.Require().NoError(err)
+	s.Require().False(report.TeamCreated)
+	s.Require().Empty(report.Added)
+	s.Require().Empty(report.Updated)
+	s.Require().Empty(report.Removed)
+
+	created, err := s.prUseCase.CreatePR(ctx, "sync-1", "sync-pr", "Sync PR", domain.CreatePullRequestOptions{})
+	s.Require().NoError(err)
+	s.Require().Len(created.Reviewers, 2)
+
+	report, err = s.teamUseCase.SyncTeam(ctx, "sync-team", []domain.User{
+		{ID: "sync-1", Name: "Alice Smith", IsActive: domain.UserStatusActive},
+		{ID: "sync-2", Name: "Bob", IsActive: domain.UserStatusInactive},
+		{ID: "sync-4", Name: "Dave", IsActive: domain.UserStatusActive},
+	}, false)
+	s.Require().NoError(err)
+	s.Require().Equal([]domain.UserID{"sync-4"}, report.Added)
+	s.Require().ElementsMatch([]domain.UserID{"sync-1", "sync-2"}, report.Updated)
+	s.Require().Equal([]domain.UserID{"sync-3"}, report.Removed)
+	s.Require().Len(report.Reassignment.Reassigned, 1)
+
+	team, err := s.teamUseCase.GetTeamByName(ctx, "sync-team")
+	s.Require().NoError(err)
+	s.Require().Len(team.Users, 3)
+
+	pr, err := s.prUseCase.GetPRByID(ctx, "sync-pr")
+	s.Require().NoError(err)
+	reviewers := make([]domain.UserID, 0, len(pr.Reviewers))
+	for _, r := range pr.Reviewers {
+		reviewers = append(reviewers, r.ID)
+	}
+	s.Require().Contains(reviewers, domain.UserID("sync-4"))
+	s.Require().NotContains(reviewers, domain.UserID("sync-3"))
+
+	user, err := s.userUseCase.GetUserByID(ctx, "sync-1")
+	s.Require().NoError(err)
+	s.Require().Equal("Alice Smith", user.Name)
+}
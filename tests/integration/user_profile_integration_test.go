@@ -0,0 +1,95 @@
+package integration_test
+
+import (
+	"app/internal/domain"
+	"app/internal/usecase/errs"
+	"context"
+)
+
+func (s *TestSuite) Test_UserProfile_Integration() {
+	ctx := context.TODO()
+	authorID := domain.UserID("profile-author")
+
+	_, err := s.teamUseCase.CreateTeam(ctx, "profile-team", []domain.TeamUser{
+		{ID: authorID, Name: "Author"},
+		{ID: "profile-r1", Name: "Reviewer 1"},
+		{ID: "profile-r2", Name: "Reviewer 2"},
+		{ID: "profile-r3", Name: "Reviewer 3"},
+	})
+	s.Require().NoError(err)
+
+	user, err := s.userUseCase.UpdateUserName(ctx, "profile-r1", "Reviewer One")
+	s.Require().NoError(err)
+	s.Require().Equal("Reviewer One", user.Name)
+
+	_, err = s.userUseCase.UpdateUserName(ctx, "profile-ghost", "Ghost")
+	s.Require().ErrorIs(err, errs.ErrUserNotFound)
+
+	teamName := "profile-team"
+	page, err := s.userUseCase.ListUsers(ctx, domain.UserFilter{TeamName: &teamName, Limit: 3})
+	s.Require().NoError(err)
+	s.Require().Len(page.Users, 3)
+	s.Require().NotNil(page.NextCursor)
+
+	page, err = s.userUseCase.ListUsers(ctx, domain.UserFilter{TeamName: &teamName, AfterID: page.NextCursor, Limit: 3})
+	s.Require().NoError(err)
+	s.Require().Len(page.Users, 1)
+	s.Require().Nil(page.NextCursor)
+
+	pr, err := s.prUseCase.CreatePR(ctx, authorID, "profile-pr-1", "Profile PR 1", domain.CreatePullRequestOptions{})
+	s.Require().NoError(err)
+	s.Require().Len(pr.Reviewers, 2)
+
+	deletedID := pr.Reviewers[0].ID
+	report, err := s.userUseCase.DeleteUser(ctx, deletedID)
+	s.Require().NoError(err)
+	s.Require().Len(report.Reassigned, 1)
+
+	_, err = s.userUseCase.GetUserByID(ctx, deletedID)
+	s.Require().ErrorIs(err, errs.ErrUserNotFound)
+
+	_, err = s.userUseCase.DeleteUser(ctx, deletedID)
+	s.Require().ErrorIs(err, errs.ErrUserNotFound)
+
+	page, err = s.userUseCase.ListUsers(ctx, domain.UserFilter{TeamName: &teamName})
+	s.Require().NoError(err)
+	s.Require().Len(page.Users, 3)
+	for _, u := range page.Users {
+		s.Require().NotEqual(deletedID, u.ID)
+	}
+
+	reviewerIDs := func(pr *domain.PullRequest) []domain.UserID {
+		ids := make([]domain.UserID, 0, len(pr.Reviewers))
+		for _, r := range pr.Reviewers {
+			ids = append(ids, r.ID)
+		}
+		return ids
+	}
+
+	pr, err = s.prUseCase.GetPRByID(ctx, "profile-pr-1")
+	s.Require().NoError(err)
+	s.Require().Len(pr.Reviewers, 2)
+	s.Require().NotContains(reviewerIDs(pr), deletedID)
+
+	pr, err = s.prUseCase.CreatePR(ctx, authorID, "profile-pr-2", "Profile PR 2", domain.CreatePullRequestOptions{})
+	s.Require().NoError(err)
+	s.Require().Len(pr.Reviewers, 2)
+	s.Require().NotContains(reviewerIDs(pr), deletedID)
+
+	// История PR удалённого автора сохраняется.
+	_, err = s.userUseCase.DeleteUser(ctx, authorID)
+	s.Require().NoError(err)
+
+	pr, err = s.prUseCase.GetPRByID(ctx, "profile-pr-2")
+	s.Require().NoError(err)
+	s.Require().Equal(authorID, pr.Author.ID)
+
+	// Удалённого пользователя можно вернуть в команду: он восстанавливается активным.
+	_, err = s.teamUseCase.AddTeamMember(ctx, "profile-team", domain.TeamUser{ID: deletedID, Name: "Returned"})
+	s.Require().NoError(err)
+
+	restored, err := s.userUseCase.GetUserByID(ctx, deletedID)
+	s.Require().NoError(err)
+	s.Require().Equal("Returned", restored.Name)
+	s.Require().Equal(domain.UserStatusActive, restored.IsActive)
+}
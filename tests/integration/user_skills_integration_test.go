@@ -0,0 +1,43 @@
+package integration_test
+
+import (
+	"app/internal/domain"
+	"app/internal/usecase/errs"
+	"context"
+)
+
+func (s *TestSuite) Test_UserSkills_Integration() {
+	ctx := context.TODO()
+	authorID := domain.UserID("skills-author")
+	sqlReviewerID := domain.UserID("skills-sql")
+
+	_, err := s.teamUseCase.CreateTeam(ctx, "skills-team", []domain.TeamUser{
+		{ID: authorID, Name: "Author"},
+		{ID: "skills-frontend", Name: "Frontend"},
+		{ID: "skills-other", Name: "Other"},
+		{ID: sqlReviewerID, Name: "SQL"},
+	})
+	s.Require().NoError(err)
+
+	_, err = s.teamUseCase.UpdateTeamSettings(ctx, "skills-team", domain.TeamSettingsUpdate{MinReviewers: 1, MaxReviewers: 1})
+	s.Require().NoError(err)
+
+	_, err = s.userUseCase.AddUserSkills(ctx, "skills-missing", []string{"go"})
+	s.Require().ErrorIs(err, errs.ErrUserNotFound)
+
+	skills, err := s.userUseCase.AddUserSkills(ctx, sqlReviewerID, []string{"SQL", "go", "postgres"})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"go", "postgres", "sql"}, skills)
+
+	skills, err = s.userUseCase.RemoveUserSkills(ctx, sqlReviewerID, []string{"postgres"})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"go", "sql"}, skills)
+
+	_, err = s.userUseCase.AddUserSkills(ctx, "skills-frontend", []string{"frontend"})
+	s.Require().NoError(err)
+
+	created, err := s.prUseCase.CreatePR(ctx, authorID, "skills-pr", "Skills PR", domain.CreatePullRequestOptions{RequiredSkills: []string{"sql"}})
+	s.Require().NoError(err)
+	s.Require().Len(created.Reviewers, 1)
+	s.Require().Equal(sqlReviewerID, created.Reviewers[0].ID)
+}
@@ -0,0 +1,56 @@
+package integration_test
+
+import (
+	"app/internal/domain"
+	"app/internal/usecase/errs"
+	"context"
+	"time"
+)
+
+func (s *TestSuite) Test_Unavailability_Integration() {
+	ctx := context.TODO()
+	authorID := domain.UserID("ooo-author")
+	vacationerID := domain.UserID("ooo-vacationer")
+	expiredID := domain.UserID("ooo-expired")
+
+	_, err := s.teamUseCase.CreateTeam(ctx, "ooo-team", []domain.TeamUser{
+		{ID: authorID, Name: "Author"},
+		{ID: vacationerID, Name: "Vacationer"},
+		{ID: expiredID, Name: "Back From Vacation"},
+	})
+	s.Require().NoError(err)
+
+	now := time.Now()
+
+	window, err := s.availabilityUseCase.AddUnavailability(ctx, vacationerID, now.Add(-time.Hour), now.Add(24*time.Hour), "vacation")
+	s.Require().NoError(err)
+
+	// Закончившееся окно через API не создать, поэтому пишем его напрямую.
+	_, err = s.pool.Exec(ctx, `INSERT INTO user_unavailability (user_id, starts_at, ends_at, reason) VALUES ($1, $2, $3, '')`,
+		expiredID.String(), now.Add(-48*time.Hour), now.Add(-time.Hour))
+	s.Require().NoError(err)
+
+	windows, err := s.availabilityUseCase.ListUnavailability(ctx, expiredID)
+	s.Require().NoError(err)
+	s.Require().Empty(windows)
+
+	created, err := s.prUseCase.CreatePR(ctx, authorID, "ooo-pr", "OOO PR", domain.CreatePullRequestOptions{})
+	s.Require().NoError(err)
+	s.Require().Len(created.Reviewers, 1)
+	s.Require().Equal(expiredID, created.Reviewers[0].ID)
+	s.Require().True(created.NeedMoreReviewers)
+
+	vacationer, err := s.userUseCase.GetUserByID(ctx, vacationerID)
+	s.Require().NoError(err)
+	s.Require().Equal(domain.UserStatusActive, vacationer.IsActive)
+
+	_, err = s.prUseCase.AddReviewer(ctx, "ooo-pr", vacationerID)
+	s.Require().ErrorIs(err, errs.ErrReviewerUnavailable)
+
+	s.Require().NoError(s.availabilityUseCase.RemoveUnavailability(ctx, vacationerID, window.ID))
+	s.Require().ErrorIs(s.availabilityUseCase.RemoveUnavailability(ctx, vacationerID, window.ID), errs.ErrUnavailabilityNotFound)
+
+	pr, err := s.prUseCase.AddReviewer(ctx, "ooo-pr", vacationerID)
+	s.Require().NoError(err)
+	s.Require().Len(pr.Reviewers, 2)
+}
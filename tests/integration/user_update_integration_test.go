@@ -13,7 +13,7 @@ func (s *TestSuite) Test_UpdateUserActivity_Success_Activate() {
     _, err := s.userUseCase.CreateUser(context.TODO(), userID, name)
     s.Require().NoError(err)
 
-    _, err = s.userUseCase.UpdateUserActivity(context.TODO(), userID, domain.UserStatusInactive)
+    _, _, err = s.userUseCase.UpdateUserActivity(context.TODO(), userID, domain.UserStatusInactive)
     s.Require().NoError(err)
 
     db, err := sql.Open("postgres", s.psqlContainer.GetDSN())
@@ -33,7 +33,7 @@ func (s *TestSuite) Test_UpdateUserActivity_Success_Activate() {
     s.Require().NoError(err)
     s.Require().False(isActive)
 
-    _, err = s.userUseCase.UpdateUserActivity(context.TODO(), userID, domain.UserStatusActive)
+    _, _, err = s.userUseCase.UpdateUserActivity(context.TODO(), userID, domain.UserStatusActive)
     s.Require().NoError(err)
 
     err = db.QueryRow(`
@@ -44,3 +44,34 @@ func (s *TestSuite) Test_UpdateUserActivity_Success_Activate() {
     s.Require().NoError(err)
     s.Require().True(isActive)
 }
+
+func (s *TestSuite) Test_UpdateUserActivity_Deactivate_ReassignsOpenReviews() {
+    authorID := domain.UserID("deactivate-author")
+
+    _, err := s.teamUseCase.CreateTeam(context.TODO(), "deactivate-team", []domain.TeamUser{
+        {ID: authorID, Name: "Author"},
+        {ID: "deactivate-r1", Name: "Reviewer 1"},
+        {ID: "deactivate-r2", Name: "Reviewer 2"},
+        {ID: "deactivate-r3", Name: "Reviewer 3"},
+    })
+    s.Require().NoError(err)
+
+    pr, err := s.prUseCase.CreatePR(context.TODO(), authorID, "deactivate-pr", "deactivate-pr", domain.CreatePullRequestOptions{})
+    s.Require().NoError(err)
+    s.Require().Len(pr.Reviewers, 2)
+
+    leaving := pr.Reviewers[0].ID
+
+    _, report, err := s.userUseCase.UpdateUserActivity(context.TODO(), leaving, domain.UserStatusInactive)
+    s.Require().NoError(err)
+    s.Require().Len(report.Reassigned, 1)
+    s.Require().Empty(report.Unfilled)
+    s.Require().Equal(pr.ID, report.Reassigned[0].PullRequestID)
+
+    reviewers, err := s.prStorage.GetReviewersFromPR(context.TODO(), pr.ID)
+    s.Require().NoError(err)
+    s.Require().Len(reviewers, 2)
+    for _, r := range reviewers {
+        s.Require().NotEqual(leaving, r.ID)
+    }
+}